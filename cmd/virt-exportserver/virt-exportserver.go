@@ -20,9 +20,12 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"os"
 	"time"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/service"
@@ -40,12 +43,19 @@ func main() {
 	log.Log.Info("Starting export server")
 
 	certFile, keyFile := getCert()
+	oidcConfig, err := getOIDCConfig()
+	if err != nil {
+		log.Log.Reason(err).Error("Invalid OIDC config, only the export token is accepted")
+	}
 	config := exportServer.ExportServerConfig{
 		CertFile:   certFile,
 		KeyFile:    keyFile,
 		Deadline:   getDeadline(),
 		ListenAddr: getListenAddr(),
 		TokenFile:  getTokenFile(),
+		FIPSMode:   os.Getenv("FIPS_MODE") == "true",
+		OIDC:       oidcConfig,
+		Namespace:  os.Getenv("EXPORT_NAMESPACE"),
 		Paths:      export.CreateServerPaths(export.EnvironToMap()),
 	}
 	server := exportServer.NewExportServer(config)
@@ -70,6 +80,18 @@ func getCert() (certFile, keyFile string) {
 	return
 }
 
+func getOIDCConfig() (*v1.ExportOIDCConfiguration, error) {
+	oidcConfig := os.Getenv("OIDC_CONFIG")
+	if oidcConfig == "" {
+		return nil, nil
+	}
+	config := &v1.ExportOIDCConfiguration{}
+	if err := json.Unmarshal([]byte(oidcConfig), config); err != nil {
+		return nil, fmt.Errorf("failed to parse OIDC_CONFIG: %v", err)
+	}
+	return config, nil
+}
+
 func getListenAddr() string {
 	addr := os.Getenv("LISTEN_ADDR")
 	if addr != "" {
@@ -401,7 +401,7 @@ func main() {
 		panic(err)
 	}
 
-	// Start virtqemud, virtlogd, and establish libvirt connection
+	// Start virtqemud, virtsecretd, virtlogd, and establish libvirt connection
 	stopChan := make(chan struct{})
 
 	l := util.NewLibvirtWrapper(*runWithNonRoot)
@@ -411,6 +411,7 @@ func main() {
 	}
 
 	l.StartVirtquemud(stopChan)
+	util.StartVirtsecretd(stopChan)
 	// only single domain should be present
 	domainName := api.VMINamespaceKeyFunc(vmi)
 
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package patch
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// Diff returns the operations turning the JSON representation of from into the one of to.
+// Objects are compared field by field, arrays of the same length element by element,
+// any other differing value is replaced as a whole.
+func Diff(from, to interface{}) ([]PatchOperation, error) {
+	fromValue, err := toJSONValue(from)
+	if err != nil {
+		return nil, err
+	}
+	toValue, err := toJSONValue(to)
+	if err != nil {
+		return nil, err
+	}
+	return diffValues("", fromValue, toValue), nil
+}
+
+func toJSONValue(obj interface{}) (interface{}, error) {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func diffValues(path string, from, to interface{}) []PatchOperation {
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		if toValue, ok := to.(map[string]interface{}); ok {
+			return diffObjects(path, fromValue, toValue)
+		}
+	case []interface{}:
+		if toValue, ok := to.([]interface{}); ok && len(fromValue) == len(toValue) {
+			var patches []PatchOperation
+			for i := range fromValue {
+				patches = append(patches, diffValues(path+"/"+strconv.Itoa(i), fromValue[i], toValue[i])...)
+			}
+			return patches
+		}
+	}
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	return []PatchOperation{{Op: PatchReplaceOp, Path: path, Value: to}}
+}
+
+func diffObjects(path string, from, to map[string]interface{}) []PatchOperation {
+	keys := make([]string, 0, len(from)+len(to))
+	for key := range from {
+		keys = append(keys, key)
+	}
+	for key := range to {
+		if _, exists := from[key]; !exists {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var patches []PatchOperation
+	for _, key := range keys {
+		keyPath := path + "/" + EscapeJSONPointer(key)
+		fromValue, inFrom := from[key]
+		toValue, inTo := to[key]
+		switch {
+		case !inTo:
+			patches = append(patches, PatchOperation{Op: PatchRemoveOp, Path: keyPath})
+		case !inFrom:
+			patches = append(patches, PatchOperation{Op: PatchAddOp, Path: keyPath, Value: toValue})
+		default:
+			patches = append(patches, diffValues(keyPath, fromValue, toValue)...)
+		}
+	}
+	return patches
+}
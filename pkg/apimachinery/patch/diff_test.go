@@ -0,0 +1,65 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package patch_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+var _ = Describe("Diff", func() {
+	type nested struct {
+		Value string `json:"value,omitempty"`
+	}
+	type object struct {
+		Name   string            `json:"name,omitempty"`
+		Nested *nested           `json:"nested,omitempty"`
+		Labels map[string]string `json:"labels,omitempty"`
+		List   []nested          `json:"list,omitempty"`
+	}
+
+	DescribeTable("should generate the operations turning one object into another", func(from, to object, expected string) {
+		patches, err := patch.Diff(from, to)
+		Expect(err).ToNot(HaveOccurred())
+		if expected == "" {
+			Expect(patches).To(BeEmpty())
+			return
+		}
+		Expect(patch.GeneratePatchPayload(patches...)).To(MatchJSON(expected))
+	},
+		Entry("with equal objects", object{Name: "a"}, object{Name: "a"}, ""),
+		Entry("with a replaced field", object{Name: "a"}, object{Name: "b"},
+			`[{"op":"replace","path":"/name","value":"b"}]`),
+		Entry("with an added field", object{}, object{Nested: &nested{Value: "a"}},
+			`[{"op":"add","path":"/nested","value":{"value":"a"}}]`),
+		Entry("with a removed field", object{Name: "a", Nested: &nested{Value: "a"}}, object{Name: "a"},
+			`[{"op":"remove","path":"/nested"}]`),
+		Entry("with a field added to a nested object", object{Nested: &nested{}}, object{Nested: &nested{Value: "a"}},
+			`[{"op":"add","path":"/nested/value","value":"a"}]`),
+		Entry("with a key which has to be escaped", object{Labels: map[string]string{"a": "b"}}, object{Labels: map[string]string{"a": "b", "kubevirt.io/c": "d"}},
+			`[{"op":"add","path":"/labels/kubevirt.io~1c","value":"d"}]`),
+		Entry("with a changed list element", object{List: []nested{{Value: "a"}, {Value: "b"}}}, object{List: []nested{{Value: "a"}, {Value: "c"}}},
+			`[{"op":"replace","path":"/list/1/value","value":"c"}]`),
+		Entry("with a list of a different length", object{List: []nested{{Value: "a"}}}, object{List: []nested{{Value: "a"}, {Value: "b"}}},
+			`[{"op":"replace","path":"/list","value":[{"value":"a"},{"value":"b"}]}]`),
+	)
+})
@@ -50,10 +50,14 @@ var mountBaseDir = filepath.Join(util.VirtShareDir, "/container-disks")
 
 type SocketPathGetter func(vmi *v1.VirtualMachineInstance, volumeIndex int) (string, error)
 type KernelBootSocketPathGetter func(vmi *v1.VirtualMachineInstance) (string, error)
+type FirmwareSocketPathGetter func(vmi *v1.VirtualMachineInstance) (string, error)
 
 const KernelBootName = "kernel-boot"
 const KernelBootVolumeName = KernelBootName + "-volume"
 
+const FirmwareName = "firmware"
+const FirmwareVolumeName = FirmwareName + "-volume"
+
 const ephemeralStorageOverheadSize = "50M"
 
 var digestRegex = regexp.MustCompile(`sha256:([a-zA-Z0-9]+)`)
@@ -112,6 +116,11 @@ func GetKernelBootArtifactPathFromLauncherView(artifact string) string {
 	return filepath.Join(mountBaseDir, KernelBootName, artifactBase)
 }
 
+// GetFirmwareDirFromLauncherView returns the directory where the binaries of the pinned firmware image are visible to virt-launcher
+func GetFirmwareDirFromLauncherView() string {
+	return filepath.Join(mountBaseDir, FirmwareName)
+}
+
 // SetLocalDirectoryOnly TODO: Refactor this package. This package is used by virt-controller
 // to set proper paths on the virt-launcher template and by virt-launcher to create directories
 // at the right location. The functions have side-effects and mix path setting and creation
@@ -174,16 +183,36 @@ func NewSocketPathGetter(baseDir string) SocketPathGetter {
 // can be provided which can for instance point to /tmp.
 func NewKernelBootSocketPathGetter(baseDir string) KernelBootSocketPathGetter {
 	return func(vmi *v1.VirtualMachineInstance) (string, error) {
-		for podUID := range vmi.Status.ActivePods {
-			basePath := getContainerDiskSocketBasePath(baseDir, string(podUID))
-			socketPath := filepath.Join(basePath, KernelBootName+".sock")
-			exists, _ := diskutils.FileExists(socketPath)
-			if exists {
-				return socketPath, nil
-			}
+		socketPath, err := getNamedSocketPath(baseDir, KernelBootName, vmi)
+		if err != nil {
+			return "", fmt.Errorf("kernel boot socket path not found for vmi \"%s\"", vmi.Name)
+		}
+		return socketPath, nil
+	}
+}
+
+// NewFirmwareSocketPathGetter get the socket path of the firmware image container. For testing a baseDir
+// can be provided which can for instance point to /tmp.
+func NewFirmwareSocketPathGetter(baseDir string) FirmwareSocketPathGetter {
+	return func(vmi *v1.VirtualMachineInstance) (string, error) {
+		socketPath, err := getNamedSocketPath(baseDir, FirmwareName, vmi)
+		if err != nil {
+			return "", fmt.Errorf("firmware socket path not found for vmi \"%s\"", vmi.Name)
+		}
+		return socketPath, nil
+	}
+}
+
+func getNamedSocketPath(baseDir, name string, vmi *v1.VirtualMachineInstance) (string, error) {
+	for podUID := range vmi.Status.ActivePods {
+		basePath := getContainerDiskSocketBasePath(baseDir, string(podUID))
+		socketPath := filepath.Join(basePath, name+".sock")
+		exists, _ := diskutils.FileExists(socketPath)
+		if exists {
+			return socketPath, nil
 		}
-		return "", fmt.Errorf("kernel boot socket path not found for vmi \"%s\"", vmi.Name)
 	}
+	return "", os.ErrNotExist
 }
 
 func GetImage(root *safepath.Path, imagePath string) (*safepath.Path, error) {
@@ -256,8 +285,38 @@ func generateKernelBootContainerHelper(vmi *v1.VirtualMachineInstance, config *v
 		},
 	}
 
-	const fakeVolumeIdx = 0 // volume index makes no difference for kernel-boot container
-	return generateContainerFromVolume(vmi, config, imageIDs, podVolumeName, binVolumeName, isInit, true, &kernelBootVolume, fakeVolumeIdx)
+	return generateContainerFromVolume(vmi, config, imageIDs, podVolumeName, binVolumeName, isInit, KernelBootName, &kernelBootVolume)
+}
+
+func GenerateFirmwareContainer(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig, imageIDs map[string]string, podVolumeName string, binVolumeName string) *kubev1.Container {
+	return generateFirmwareContainerHelper(vmi, config, imageIDs, podVolumeName, binVolumeName, false)
+}
+
+func GenerateFirmwareInitContainer(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig, imageIDs map[string]string, podVolumeName string, binVolumeName string) *kubev1.Container {
+	return generateFirmwareContainerHelper(vmi, config, imageIDs, podVolumeName, binVolumeName, true)
+}
+
+// The firmware image is taken from the VMI status, where it was pinned on creation,
+// so that changes of the cluster wide configuration don't affect running VMIs and their migrations.
+func generateFirmwareContainerHelper(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig, imageIDs map[string]string, podVolumeName string, binVolumeName string, isInit bool) *kubev1.Container {
+	if !util.HasFirmwareImage(vmi) {
+		return nil
+	}
+
+	firmwareImage := vmi.Status.FirmwareImageStatus
+
+	firmwareVolume := v1.Volume{
+		Name: FirmwareVolumeName,
+		VolumeSource: v1.VolumeSource{
+			ContainerDisk: &v1.ContainerDiskSource{
+				Image:           firmwareImage.Image,
+				Path:            firmwareImage.Path,
+				ImagePullPolicy: firmwareImage.ImagePullPolicy,
+			},
+		},
+	}
+
+	return generateContainerFromVolume(vmi, config, imageIDs, podVolumeName, binVolumeName, isInit, FirmwareName, &firmwareVolume)
 }
 
 // The controller uses this function to generate the container
@@ -267,17 +326,17 @@ func generateContainersHelper(vmi *v1.VirtualMachineInstance, config *virtconfig
 
 	// Make VirtualMachineInstance Image Wrapper Containers
 	for index, volume := range vmi.Spec.Volumes {
-		if volume.Name == KernelBootVolumeName {
+		if volume.Name == KernelBootVolumeName || volume.Name == FirmwareVolumeName {
 			continue
 		}
-		if container := generateContainerFromVolume(vmi, config, imageIDs, podVolumeName, binVolumeName, isInit, false, &volume, index); container != nil {
+		if container := generateContainerFromVolume(vmi, config, imageIDs, podVolumeName, binVolumeName, isInit, "disk_"+strconv.Itoa(index), &volume); container != nil {
 			containers = append(containers, *container)
 		}
 	}
 	return containers
 }
 
-func generateContainerFromVolume(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig, imageIDs map[string]string, podVolumeName, binVolumeName string, isInit, isKernelBoot bool, volume *v1.Volume, volumeIdx int) *kubev1.Container {
+func generateContainerFromVolume(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig, imageIDs map[string]string, podVolumeName, binVolumeName string, isInit bool, mountedDiskName string, volume *v1.Volume) *kubev1.Container {
 	if volume.ContainerDisk == nil {
 		return nil
 	}
@@ -312,13 +371,6 @@ func generateContainerFromVolume(vmi *v1.VirtualMachineInstance, config *virtcon
 		resources.Limits[kubev1.ResourceMemory] = *memLimit
 	}
 
-	var mountedDiskName string
-	if isKernelBoot {
-		mountedDiskName = KernelBootName
-	} else {
-		mountedDiskName = "disk_" + strconv.Itoa(volumeIdx)
-	}
-
 	if vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed() {
 		resources.Requests[kubev1.ResourceCPU] = resources.Limits[kubev1.ResourceCPU]
 		resources.Requests[kubev1.ResourceMemory] = resources.Limits[kubev1.ResourceMemory]
@@ -414,6 +466,10 @@ func ExtractImageIDsFromSourcePod(vmi *v1.VirtualMachineInstance, sourcePod *kub
 		imageIDs[KernelBootVolumeName] = vmi.Spec.Domain.Firmware.KernelBoot.Container.Image
 	}
 
+	if util.HasFirmwareImage(vmi) {
+		imageIDs[FirmwareVolumeName] = vmi.Status.FirmwareImageStatus.Image
+	}
+
 	for _, status := range sourcePod.Status.ContainerStatuses {
 		if !isImageVolume(status.Name) {
 			continue
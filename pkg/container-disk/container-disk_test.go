@@ -390,6 +390,38 @@ var _ = Describe("ContainerDisk", func() {
 				Expect(newContainers[1].Image).To(Equal("someimage@sha256:bootcontainer"))
 			})
 
+			It("for a new migration pod with a pinned firmware image", func() {
+				clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+					SupportContainerResources: []v1.SupportContainerResources{},
+				})
+				vmi := api.NewMinimalVMI("myvmi")
+				appendContainerDisk(vmi, "disk1")
+
+				vmi.Status.FirmwareImageStatus = &v1.FirmwareImageStatus{Name: "ovmf", Image: "firmwareimage:v1", Path: "/usr/share/OVMF"}
+
+				pod := createMigrationSourcePod(vmi)
+
+				imageIDs := ExtractImageIDsFromSourcePod(vmi, pod)
+				Expect(imageIDs).To(HaveKeyWithValue("disk1", "someimage@sha256:0"))
+				Expect(imageIDs).To(HaveKeyWithValue("firmware-volume", "firmwareimage@sha256:firmware"))
+				Expect(imageIDs).To(HaveLen(2))
+
+				firmwareContainer := GenerateFirmwareContainer(vmi, clusterConfig, imageIDs, "a-name", "something")
+				Expect(firmwareContainer).ToNot(BeNil())
+				Expect(firmwareContainer.Name).To(Equal("volumefirmware-volume"))
+				Expect(firmwareContainer.Image).To(Equal("firmwareimage@sha256:firmware"))
+				Expect(firmwareContainer.Args).To(Equal([]string{"--copy-path", GetVolumeMountDirOnGuest(vmi) + "/firmware"}))
+			})
+
+			It("should not generate a firmware container without a pinned firmware image", func() {
+				clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				vmi := api.NewMinimalVMI("myvmi")
+				vmi.Spec.Domain.Firmware = &v1.Firmware{ImageName: "ovmf"}
+
+				Expect(GenerateFirmwareContainer(vmi, clusterConfig, nil, "a-name", "something")).To(BeNil())
+				Expect(GenerateFirmwareInitContainer(vmi, clusterConfig, nil, "a-name", "something")).To(BeNil())
+			})
+
 			It("should return the source image tag if it can't detect a reproducible imageID", func() {
 				vmi := api.NewMinimalVMI("myvmi")
 				appendContainerDisk(vmi, "disk1")
@@ -508,6 +540,15 @@ func createMigrationSourcePod(vmi *v1.VirtualMachineInstance) *k8sv1.Pod {
 		}
 		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, status)
 	}
+	firmwareContainer := GenerateFirmwareContainer(vmi, clusterConfig, nil, "a-name", "something")
+	if firmwareContainer != nil {
+		status := k8sv1.ContainerStatus{
+			Name:    firmwareContainer.Name,
+			Image:   firmwareContainer.Image,
+			ImageID: fmt.Sprintf("finalimg@sha256:%v", "firmware"),
+		}
+		pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, status)
+	}
 
 	return pod
 }
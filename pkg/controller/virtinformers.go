@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"sync"
 	"time"
 
@@ -315,6 +316,9 @@ type KubeInformerFactory interface {
 	// Pod returns an informer for ALL Pods in the system
 	Pod() cache.SharedIndexInformer
 
+	// Watches for pods which are scheduled to a node and did not terminate
+	ScheduledPod() cache.SharedIndexInformer
+
 	ResourceQuota() cache.SharedIndexInformer
 
 	K8SInformerFactory() informers.SharedInformerFactory
@@ -566,6 +570,34 @@ func GetVirtualMachineInformerIndexers() cache.Indexers {
 			}
 			return pvcs, nil
 		},
+		"instancetype": func(obj interface{}) ([]string, error) {
+			vm, ok := obj.(*kubev1.VirtualMachine)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			if vm.Spec.Instancetype == nil {
+				return nil, nil
+			}
+			switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+			case instancetypeapi.SingularResourceName, instancetypeapi.PluralResourceName:
+				return []string{fmt.Sprintf("%s/%s", vm.Namespace, vm.Spec.Instancetype.Name)}, nil
+			}
+			return []string{vm.Spec.Instancetype.Name}, nil
+		},
+		"preference": func(obj interface{}) ([]string, error) {
+			vm, ok := obj.(*kubev1.VirtualMachine)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+			if vm.Spec.Preference == nil {
+				return nil, nil
+			}
+			switch strings.ToLower(vm.Spec.Preference.Kind) {
+			case instancetypeapi.SingularPreferenceResourceName, instancetypeapi.PluralPreferenceResourceName:
+				return []string{fmt.Sprintf("%s/%s", vm.Namespace, vm.Spec.Preference.Name)}, nil
+			}
+			return []string{vm.Spec.Preference.Name}, nil
+		},
 	}
 }
 
@@ -1387,6 +1419,18 @@ func (f *kubeInformerFactory) Pod() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) ScheduledPod() cache.SharedIndexInformer {
+	return f.getInformer("scheduledPodInformer", func() cache.SharedIndexInformer {
+		fieldSelector := fields.AndSelectors(
+			fields.OneTermNotEqualSelector("spec.nodeName", ""),
+			fields.OneTermNotEqualSelector("status.phase", string(k8sv1.PodSucceeded)),
+			fields.OneTermNotEqualSelector("status.phase", string(k8sv1.PodFailed)),
+		)
+		lw := cache.NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "pods", k8sv1.NamespaceAll, fieldSelector)
+		return cache.NewSharedIndexInformer(lw, &k8sv1.Pod{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) ResourceQuota() cache.SharedIndexInformer {
 	return f.getInformer("resourceQuotaInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "resourcequotas", k8sv1.NamespaceAll, fields.Everything())
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path"
 	"strconv"
 
@@ -19,7 +18,13 @@ const emptyDiskBaseDir = "/var/run/libvirt/empty-disks/"
 
 type emptyDiskCreator struct {
 	emptyDiskBaseDir string
-	discCreateFunc   func(filePath string, size string) error
+	encryptionKey    *ephemeraldiskutils.EncryptionKey
+	discCreateFunc   func(key *ephemeraldiskutils.EncryptionKey, filePath string, size string) error
+}
+
+// SetEncryptionKey sets the key encrypting the disks created afterwards, nil disables the encryption
+func (c *emptyDiskCreator) SetEncryptionKey(key *ephemeraldiskutils.EncryptionKey) {
+	c.encryptionKey = key
 }
 
 func (c *emptyDiskCreator) CreateTemporaryDisks(vmi *v1.VirtualMachineInstance) error {
@@ -41,7 +46,7 @@ func (c *emptyDiskCreator) CreateTemporaryDisks(vmi *v1.VirtualMachineInstance)
 				return err
 			}
 			if _, err := os.Stat(file); errors.Is(err, os.ErrNotExist) {
-				if err := c.discCreateFunc(file, size); err != nil {
+				if err := c.discCreateFunc(c.encryptionKey, file, size); err != nil {
 					return err
 				}
 			} else if err != nil {
@@ -64,9 +69,9 @@ func filePathForVolumeName(basedir string, volumeName string) string {
 	return path.Join(basedir, volumeName+".qcow2")
 }
 
-func createQCOW(file string, size string) error {
-	// #nosec No risk for attacket injection. Parameters are predefined strings
-	return exec.Command("qemu-img", "create", "-f", "qcow2", file, size).Run()
+func createQCOW(key *ephemeraldiskutils.EncryptionKey, file string, size string) error {
+	_, err := ephemeraldiskutils.CreateQCOW2(key, file, size)
+	return err
 }
 
 func NewEmptyDiskCreator() *emptyDiskCreator {
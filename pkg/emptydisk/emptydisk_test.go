@@ -11,6 +11,8 @@ import (
 	"kubevirt.io/client-go/api"
 
 	v1 "kubevirt.io/api/core/v1"
+
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 )
 
 var _ = Describe("EmptyDisk", func() {
@@ -72,6 +74,21 @@ var _ = Describe("EmptyDisk", func() {
 		It("should generate non-conflicting volume paths per disk", func() {
 			Expect(NewEmptyDiskCreator().FilePathForVolumeName("volume1")).ToNot(Equal(NewEmptyDiskCreator().FilePathForVolumeName("volume2")))
 		})
+		It("should create the qcow2 image with the encryption key", func() {
+			key, err := ephemeraldiskutils.NewEncryptionKey("fake-uuid")
+			Expect(err).ToNot(HaveOccurred())
+			var usedKey *ephemeraldiskutils.EncryptionKey
+			creator.discCreateFunc = func(key *ephemeraldiskutils.EncryptionKey, filePath string, size string) error {
+				usedKey = key
+				return fakeCreatorFunc(key, filePath, size)
+			}
+			creator.SetEncryptionKey(key)
+
+			vmi := api.NewMinimalVMI("testvmi")
+			AppendEmptyDisk(vmi, "testdisk")
+			Expect(creator.CreateTemporaryDisks(vmi)).To(Succeed())
+			Expect(usedKey).To(Equal(key))
+		})
 		It("should leave pre-existing disks alone", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			AppendEmptyDisk(vmi, "testdisk")
@@ -87,7 +104,7 @@ var _ = Describe("EmptyDisk", func() {
 
 })
 
-func fakeCreatorFunc(filePath string, _ string) error {
+func fakeCreatorFunc(_ *ephemeraldiskutils.EncryptionKey, filePath string, _ string) error {
 	f, err := os.Create(filePath)
 	if err != nil {
 		return err
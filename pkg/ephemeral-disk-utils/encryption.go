@@ -0,0 +1,110 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ephemeraldiskutils
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"os/exec"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	encryptionKeySize = 32
+	// EncryptionFormat is the libvirt encryption format of encrypted qcow2 images
+	EncryptionFormat = "luks"
+
+	// the key is handed over to qemu-img through a pipe, which is the first extra file of the command
+	encryptionKeyFile = "/dev/fd/3"
+	encryptionKeyID   = "sec0"
+)
+
+// EncryptionKey is the key of the ephemeral images of a VMI. It is only kept in the memory
+// of virt-launcher and of its libvirt secret, and is therefore discarded once the VMI stops.
+type EncryptionKey struct {
+	SecretUUID string
+	value      []byte
+}
+
+// NewEncryptionKey generates a random key, to be stored in the libvirt secret with the given UUID.
+func NewEncryptionKey(secretUUID string) (*EncryptionKey, error) {
+	raw := make([]byte, encryptionKeySize)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, fmt.Errorf("failed to generate the ephemeral storage encryption key: %v", err)
+	}
+	value := make([]byte, hex.EncodedLen(len(raw)))
+	hex.Encode(value, raw)
+	return &EncryptionKey{
+		SecretUUID: secretUUID,
+		value:      value,
+	}, nil
+}
+
+// Value returns the passphrase of the key.
+func (k *EncryptionKey) Value() []byte {
+	return k.value
+}
+
+// IsEncryptionEnabled returns true if the ephemeral storage of the VMI has to be encrypted.
+func IsEncryptionEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.EphemeralStorage != nil && vmi.Spec.EphemeralStorage.Encrypted
+}
+
+// EncryptionSecretUUID returns the UUID of the libvirt secret holding the key of the VMI.
+// It is derived from the VMI UID so that the domain stays valid on migration targets,
+// which create their images with a key of their own.
+func EncryptionSecretUUID(vmi *v1.VirtualMachineInstance) string {
+	return string(vmi.UID)
+}
+
+// CreateQCOW2 runs qemu-img create for a qcow2 image with the given arguments.
+// If key is not nil the image is LUKS encrypted with it.
+func CreateQCOW2(key *EncryptionKey, args ...string) ([]byte, error) {
+	createArgs := []string{"create", "-f", "qcow2"}
+	if key == nil {
+		// #nosec No risk for attacket injection. Parameters are predefined strings
+		return exec.Command("qemu-img", append(createArgs, args...)...).CombinedOutput()
+	}
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	// the key is much smaller than the pipe buffer, the write does not block
+	_, err = writer.Write(key.value)
+	writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	createArgs = append(createArgs,
+		"--object", fmt.Sprintf("secret,id=%s,file=%s", encryptionKeyID, encryptionKeyFile),
+		"-o", fmt.Sprintf("encrypt.format=%s,encrypt.key-secret=%s", EncryptionFormat, encryptionKeyID),
+	)
+	// #nosec No risk for attacket injection. Parameters are predefined strings
+	cmd := exec.Command("qemu-img", append(createArgs, args...)...)
+	cmd.ExtraFiles = []*os.File{reader}
+	return cmd.CombinedOutput()
+}
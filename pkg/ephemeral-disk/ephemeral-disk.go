@@ -23,7 +23,6 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -43,6 +42,7 @@ type EphemeralDiskCreatorInterface interface {
 	CreateBackedImageForVolume(volume v1.Volume, backingFile string, backingFormat string) error
 	CreateEphemeralImages(vmi *v1.VirtualMachineInstance, domain *api.Domain) error
 	GetFilePath(volumeName string) string
+	SetEncryptionKey(key *diskutils.EncryptionKey)
 	Init() error
 }
 
@@ -50,7 +50,8 @@ type ephemeralDiskCreator struct {
 	mountBaseDir    string
 	pvcBaseDir      string
 	blockDevBaseDir string
-	discCreateFunc  func(backingFile string, backingFormat string, imagePath string) ([]byte, error)
+	encryptionKey   *diskutils.EncryptionKey
+	discCreateFunc  func(key *diskutils.EncryptionKey, backingFile string, backingFormat string, imagePath string) ([]byte, error)
 }
 
 func NewEphemeralDiskCreator(mountBaseDir string) *ephemeralDiskCreator {
@@ -66,6 +67,11 @@ func (c *ephemeralDiskCreator) Init() error {
 	return os.MkdirAll(c.mountBaseDir, 0755)
 }
 
+// SetEncryptionKey sets the key encrypting the images created afterwards, nil disables the encryption
+func (c *ephemeralDiskCreator) SetEncryptionKey(key *diskutils.EncryptionKey) {
+	c.encryptionKey = key
+}
+
 func (c *ephemeralDiskCreator) generateVolumeMountDir(volumeName string) string {
 	return filepath.Join(c.mountBaseDir, volumeName)
 }
@@ -107,7 +113,7 @@ func (c *ephemeralDiskCreator) CreateBackedImageForVolume(volume v1.Volume, back
 		return err
 	}
 
-	output, err := c.discCreateFunc(backingFile, backingFormat, imagePath)
+	output, err := c.discCreateFunc(c.encryptionKey, backingFile, backingFormat, imagePath)
 
 	// Cleanup of previous images isn't really necessary as they're all on EmptyDir.
 	if err != nil {
@@ -140,17 +146,12 @@ func (c *ephemeralDiskCreator) CreateEphemeralImages(vmi *v1.VirtualMachineInsta
 	return nil
 }
 
-func createBackingDisk(backingFile string, backingFormat string, imagePath string) ([]byte, error) {
-	// #nosec No risk for attacket injection. Parameters are predefined strings
-	cmd := exec.Command("qemu-img",
-		"create",
-		"-f",
-		"qcow2",
+func createBackingDisk(key *diskutils.EncryptionKey, backingFile string, backingFormat string, imagePath string) ([]byte, error) {
+	return diskutils.CreateQCOW2(key,
 		"-b",
 		backingFile,
 		"-F",
 		backingFormat,
 		imagePath,
 	)
-	return cmd.CombinedOutput()
 }
@@ -33,6 +33,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -148,6 +149,24 @@ var _ = Describe("ContainerDisk", func() {
 			})
 		})
 
+		Context("With an encryption key", func() {
+			It("Should create the ephemeral image with the key", func() {
+				key, err := diskutils.NewEncryptionKey("fake-uuid")
+				Expect(err).NotTo(HaveOccurred())
+				var usedKey *diskutils.EncryptionKey
+				creator.discCreateFunc = func(key *diskutils.EncryptionKey, backingFile string, backingFormat string, imagePath string) ([]byte, error) {
+					usedKey = key
+					return fakeCreateBackingDisk(key, backingFile, backingFormat, imagePath)
+				}
+				creator.SetEncryptionKey(key)
+
+				vmi := api2.NewMinimalVMI("fake-vmi")
+				AppendEphemeralPVC(vmi, "fake-disk", "fake-pvc", false)
+				Expect(creator.CreateEphemeralImages(vmi, &api.Domain{})).To(Succeed())
+				Expect(usedKey).To(Equal(key))
+			})
+		})
+
 		Context("With a block pvc backed ephemeral volume", func() {
 			It("Should create VirtualMachineInstance's ephemeral image", func() {
 				By("Creating a minimal VirtualMachineInstance object")
@@ -184,7 +203,7 @@ var _ = Describe("ContainerDisk", func() {
 	})
 })
 
-func fakeCreateBackingDisk(backingFile string, backingFormat string, imagePath string) ([]byte, error) {
+func fakeCreateBackingDisk(_ *diskutils.EncryptionKey, backingFile string, backingFormat string, imagePath string) ([]byte, error) {
 	if backingFormat != "raw" {
 		return nil, fmt.Errorf("wrong backing format")
 	}
@@ -5,6 +5,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -24,6 +25,9 @@ func (m *MockEphemeralDiskImageCreator) GetFilePath(volumeName string) string {
 	return filepath.Join(m.BaseDir, volumeName, "disk.qcow2")
 }
 
+func (m *MockEphemeralDiskImageCreator) SetEncryptionKey(_ *ephemeraldiskutils.EncryptionKey) {
+}
+
 func (m *MockEphemeralDiskImageCreator) Init() error {
 	return nil
 }
@@ -61,6 +61,10 @@ func ReplacePVCByHostDisk(vmi *v1.VirtualMachineInstance) error {
 	for i := range vmi.Spec.Domain.Devices.Filesystems {
 		passthoughFSVolumes[vmi.Spec.Domain.Devices.Filesystems[i].Name] = struct{}{}
 	}
+	// A PVC providing the kernel boot artifacts is accessed as a directory as well
+	if util.HasKernelBootVolume(vmi) {
+		passthoughFSVolumes[vmi.Spec.Domain.Firmware.KernelBoot.Volume.Name] = struct{}{}
+	}
 
 	pvcVolume := make(map[string]v1.VolumeStatus)
 	hotplugVolumes := make(map[string]bool)
@@ -494,6 +494,20 @@ var _ = Describe("HostDisk", func() {
 			),
 		)
 
+		It("in filemode should not replace a PVC providing kernel boot artifacts", func() {
+			mode := k8sv1.PersistentVolumeFilesystem
+			vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.VolumeMode = &mode
+			vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.Capacity = k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("2Gi")}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				KernelBoot: &v1.KernelBoot{
+					Volume: &v1.KernelBootVolume{Name: volumeName, KernelPath: "/vmlinuz"},
+				},
+			}
+
+			Expect(ReplacePVCByHostDisk(vmi)).To(Succeed())
+			assertNoHostDisk()
+		})
+
 		It("in filemode without capacity or requested PVC size should fail", func() {
 			mode := k8sv1.PersistentVolumeFilesystem
 			vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.VolumeMode = &mode
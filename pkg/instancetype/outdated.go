@@ -0,0 +1,129 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package instancetype
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	apiinstancetype "kubevirt.io/api/instancetype"
+)
+
+type OutdatedChecker interface {
+	IsOutdated(vm *virtv1.VirtualMachine) (bool, error)
+}
+
+// IsOutdated returns true if the instancetype or preference referenced by the VirtualMachine
+// has been updated since its ControllerRevision was captured.
+func (m *InstancetypeMethods) IsOutdated(vm *virtv1.VirtualMachine) (bool, error) {
+	instancetypeOutdated, err := m.isInstancetypeOutdated(vm)
+	if err != nil || instancetypeOutdated {
+		return instancetypeOutdated, err
+	}
+	return m.isPreferenceOutdated(vm)
+}
+
+func (m *InstancetypeMethods) isInstancetypeOutdated(vm *virtv1.VirtualMachine) (bool, error) {
+	if vm.Spec.Instancetype == nil || vm.Spec.Instancetype.RevisionName == "" {
+		return false, nil
+	}
+
+	var (
+		obj metav1.Object
+		err error
+	)
+	switch strings.ToLower(vm.Spec.Instancetype.Kind) {
+	case apiinstancetype.SingularResourceName, apiinstancetype.PluralResourceName:
+		obj, err = m.findInstancetype(vm)
+	case apiinstancetype.ClusterSingularResourceName, apiinstancetype.ClusterPluralResourceName, "":
+		obj, err = m.findClusterInstancetype(vm)
+	default:
+		return false, fmt.Errorf("got unexpected kind in InstancetypeMatcher: %s", vm.Spec.Instancetype.Kind)
+	}
+	if err != nil {
+		// Without the original object there is no newer revision to move to
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return m.isRevisionOutdated(types.NamespacedName{Namespace: vm.Namespace, Name: vm.Spec.Instancetype.RevisionName}, obj)
+}
+
+func (m *InstancetypeMethods) isPreferenceOutdated(vm *virtv1.VirtualMachine) (bool, error) {
+	if vm.Spec.Preference == nil || vm.Spec.Preference.RevisionName == "" {
+		return false, nil
+	}
+
+	var (
+		obj metav1.Object
+		err error
+	)
+	switch strings.ToLower(vm.Spec.Preference.Kind) {
+	case apiinstancetype.SingularPreferenceResourceName, apiinstancetype.PluralPreferenceResourceName:
+		obj, err = m.findPreference(vm)
+	case apiinstancetype.ClusterSingularPreferenceResourceName, apiinstancetype.ClusterPluralPreferenceResourceName:
+		obj, err = m.findClusterPreference(vm)
+	default:
+		return false, fmt.Errorf("got unexpected kind in PreferenceMatcher: %s", vm.Spec.Preference.Kind)
+	}
+	if err != nil {
+		// Without the original object there is no newer revision to move to
+		if k8serrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return m.isRevisionOutdated(types.NamespacedName{Namespace: vm.Namespace, Name: vm.Spec.Preference.RevisionName}, obj)
+}
+
+func (m *InstancetypeMethods) isRevisionOutdated(namespacedName types.NamespacedName, obj metav1.Object) (bool, error) {
+	var (
+		err      error
+		revision *appsv1.ControllerRevision
+	)
+
+	if m.ControllerRevisionStore != nil {
+		revision, err = m.getControllerRevisionByInformer(namespacedName)
+	} else {
+		revision, err = m.getControllerRevisionByClient(namespacedName)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	// ControllerRevisions created before these labels were introduced are relabeled by Upgrade
+	uid, hasUID := revision.Labels[apiinstancetype.ControllerRevisionObjectUIDLabel]
+	generation, hasGeneration := revision.Labels[apiinstancetype.ControllerRevisionObjectGenerationLabel]
+	if !hasUID || !hasGeneration {
+		return false, nil
+	}
+
+	return uid != string(obj.GetUID()) || generation != strconv.FormatInt(obj.GetGeneration(), 10), nil
+}
@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package instancetype_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypeapi "kubevirt.io/api/instancetype"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	. "kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Outdated ControllerRevisions", func() {
+	var (
+		methods *InstancetypeMethods
+		vm      *virtv1.VirtualMachine
+
+		clusterInstancetype *instancetypev1beta1.VirtualMachineClusterInstancetype
+		preference          *instancetypev1beta1.VirtualMachinePreference
+	)
+
+	BeforeEach(func() {
+		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
+		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+		preferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
+		clusterPreferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
+		controllerRevisionInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+
+		methods = &InstancetypeMethods{
+			InstancetypeStore:        instancetypeInformer.GetStore(),
+			ClusterInstancetypeStore: clusterInstancetypeInformer.GetStore(),
+			PreferenceStore:          preferenceInformer.GetStore(),
+			ClusterPreferenceStore:   clusterPreferenceInformer.GetStore(),
+			ControllerRevisionStore:  controllerRevisionInformer.GetStore(),
+		}
+
+		vm = kubecli.NewMinimalVM("testvm")
+		vm.Namespace = k8sv1.NamespaceDefault
+
+		clusterInstancetype = &instancetypev1beta1.VirtualMachineClusterInstancetype{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "instancetype",
+				UID:        types.UID("instancetype-uid"),
+				Generation: 1,
+			},
+		}
+		Expect(methods.ClusterInstancetypeStore.Add(clusterInstancetype)).To(Succeed())
+
+		preference = &instancetypev1beta1.VirtualMachinePreference{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "preference",
+				Namespace:  vm.Namespace,
+				UID:        types.UID("preference-uid"),
+				Generation: 1,
+			},
+		}
+		Expect(methods.PreferenceStore.Add(preference)).To(Succeed())
+
+		instancetypeRevision, err := CreateControllerRevision(vm, clusterInstancetype)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(methods.ControllerRevisionStore.Add(instancetypeRevision)).To(Succeed())
+
+		preferenceRevision, err := CreateControllerRevision(vm, preference)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(methods.ControllerRevisionStore.Add(preferenceRevision)).To(Succeed())
+
+		vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{
+			Name:         clusterInstancetype.Name,
+			Kind:         instancetypeapi.ClusterSingularResourceName,
+			RevisionName: instancetypeRevision.Name,
+		}
+		vm.Spec.Preference = &virtv1.PreferenceMatcher{
+			Name:         preference.Name,
+			Kind:         instancetypeapi.SingularPreferenceResourceName,
+			RevisionName: preferenceRevision.Name,
+		}
+	})
+
+	It("should not report VirtualMachines on the latest revisions", func() {
+		Expect(methods.IsOutdated(vm)).To(BeFalse())
+	})
+
+	It("should not report VirtualMachines without captured revisions", func() {
+		vm.Spec.Instancetype.RevisionName = ""
+		vm.Spec.Preference.RevisionName = ""
+		Expect(methods.IsOutdated(vm)).To(BeFalse())
+	})
+
+	It("should report VirtualMachines when the instancetype was updated", func() {
+		clusterInstancetype.Generation = 2
+		Expect(methods.ClusterInstancetypeStore.Update(clusterInstancetype)).To(Succeed())
+		Expect(methods.IsOutdated(vm)).To(BeTrue())
+	})
+
+	It("should report VirtualMachines when the preference was recreated", func() {
+		preference.UID = types.UID("recreated-preference-uid")
+		Expect(methods.PreferenceStore.Update(preference)).To(Succeed())
+		Expect(methods.IsOutdated(vm)).To(BeTrue())
+	})
+
+	It("should not report VirtualMachines on revisions without object labels", func() {
+		clusterInstancetype.Generation = 2
+		Expect(methods.ClusterInstancetypeStore.Update(clusterInstancetype)).To(Succeed())
+
+		obj, exists, err := methods.ControllerRevisionStore.GetByKey(vm.Namespace + "/" + vm.Spec.Instancetype.RevisionName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		revision := obj.(*appsv1.ControllerRevision).DeepCopy()
+		revision.Labels = nil
+		Expect(methods.ControllerRevisionStore.Update(revision)).To(Succeed())
+
+		Expect(methods.IsOutdated(vm)).To(BeFalse())
+	})
+})
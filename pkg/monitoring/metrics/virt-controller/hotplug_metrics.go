@@ -0,0 +1,201 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"sync"
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// scheduling and starting the attachment pod
+	hotplugStepAttachmentPod = "attachment_pod"
+	// mounting the volume into the virt-launcher pod
+	hotplugStepMount = "mount"
+	// attaching the volume to the domain
+	hotplugStepAttach = "attach"
+	// unplugging the volume, from its removal from the VMI spec until its status is gone
+	hotplugStepDetach = "detach"
+)
+
+var (
+	hotplugMetrics = []operatormetrics.Metric{
+		hotplugVolumeStepDuration,
+		hotplugVolumeAttachDuration,
+	}
+
+	hotplugVolumeStepDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hotplug_volume_step_duration_seconds",
+			Help: "Histogram of the duration of the steps of hotplugging and unplugging a volume in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+		[]string{
+			// attachment_pod, mount, attach or detach
+			"step",
+		},
+	)
+
+	hotplugVolumeAttachDuration = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_hotplug_volume_attach_duration_seconds",
+			Help: "Histogram of the time from hotplugging a volume until it is attached to the VMI in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: PhaseTransitionTimeBuckets(),
+		},
+	)
+
+	hotplugVolumes = newHotplugVolumeTracker()
+)
+
+// hotplugVolumeTracker remembers when the hotplugged volumes of the VMIs entered their current step.
+// The volume statuses carry no timestamps, so the steps are timed from the observed status updates.
+type hotplugVolumeTracker struct {
+	lock    sync.Mutex
+	volumes map[types.UID]map[string]*hotplugVolumeTiming
+}
+
+type hotplugVolumeTiming struct {
+	// requested and stepStarted are zero if the volume was hotplugged before this virt-controller started
+	requested   time.Time
+	stepStarted time.Time
+	detaching   bool
+}
+
+func newHotplugVolumeTracker() *hotplugVolumeTracker {
+	return &hotplugVolumeTracker{
+		volumes: map[types.UID]map[string]*hotplugVolumeTiming{},
+	}
+}
+
+func AddHotplugVolumeHandlers(informer cache.SharedIndexInformer) error {
+	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldVMI, newVMI interface{}) {
+			hotplugVolumes.update(oldVMI.(*v1.VirtualMachineInstance), newVMI.(*v1.VirtualMachineInstance), time.Now())
+		},
+		DeleteFunc: func(obj interface{}) {
+			if vmi, ok := obj.(*v1.VirtualMachineInstance); ok {
+				hotplugVolumes.forget(vmi.UID)
+			}
+		},
+	})
+	return err
+}
+
+func (t *hotplugVolumeTracker) update(oldVMI, newVMI *v1.VirtualMachineInstance, now time.Time) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	oldStatuses := map[string]*v1.VolumeStatus{}
+	for i := range oldVMI.Status.VolumeStatus {
+		oldStatuses[oldVMI.Status.VolumeStatus[i].Name] = &oldVMI.Status.VolumeStatus[i]
+	}
+	specVolumes := map[string]struct{}{}
+	for _, volume := range newVMI.Spec.Volumes {
+		specVolumes[volume.Name] = struct{}{}
+	}
+
+	timings := t.volumes[newVMI.UID]
+	if timings == nil {
+		timings = map[string]*hotplugVolumeTiming{}
+	}
+
+	newStatuses := map[string]struct{}{}
+	for i := range newVMI.Status.VolumeStatus {
+		status := &newVMI.Status.VolumeStatus[i]
+		if status.HotplugVolume == nil {
+			continue
+		}
+		newStatuses[status.Name] = struct{}{}
+
+		oldStatus, existed := oldStatuses[status.Name]
+		timing, tracked := timings[status.Name]
+		if !tracked {
+			timing = &hotplugVolumeTiming{}
+			if !existed {
+				timing.requested = now
+				timing.stepStarted = now
+			}
+			timings[status.Name] = timing
+		}
+		if !existed || oldStatus.HotplugVolume == nil {
+			oldStatus = &v1.VolumeStatus{HotplugVolume: &v1.HotplugVolumeStatus{}}
+		}
+
+		if _, inSpec := specVolumes[status.Name]; !inSpec {
+			if !timing.detaching {
+				timing.detaching = true
+				timing.stepStarted = now
+			}
+			continue
+		}
+
+		switch {
+		case status.Phase == v1.VolumeReady && oldStatus.Phase != v1.VolumeReady:
+			timing.observeStep(hotplugStepAttach, now)
+			if !timing.requested.IsZero() {
+				hotplugVolumeAttachDuration.Observe(now.Sub(timing.requested).Seconds())
+			}
+		case status.Phase == v1.HotplugVolumeMounted && oldStatus.Phase != v1.HotplugVolumeMounted:
+			timing.observeStep(hotplugStepMount, now)
+		case status.HotplugVolume.AttachPodUID != "" && oldStatus.HotplugVolume.AttachPodUID == "" && status.Phase != v1.VolumeReady:
+			timing.observeStep(hotplugStepAttachmentPod, now)
+		}
+	}
+
+	for name, timing := range timings {
+		if _, exists := newStatuses[name]; exists {
+			continue
+		}
+		if timing.detaching {
+			timing.observeStep(hotplugStepDetach, now)
+		}
+		delete(timings, name)
+	}
+
+	if len(timings) == 0 {
+		delete(t.volumes, newVMI.UID)
+	} else {
+		t.volumes[newVMI.UID] = timings
+	}
+}
+
+func (t *hotplugVolumeTracker) forget(uid types.UID) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	delete(t.volumes, uid)
+}
+
+func (t *hotplugVolumeTiming) observeStep(step string, now time.Time) {
+	if !t.stepStarted.IsZero() {
+		hotplugVolumeStepDuration.WithLabelValues(step).Observe(now.Sub(t.stepStarted).Seconds())
+	}
+	t.stepStarted = now
+}
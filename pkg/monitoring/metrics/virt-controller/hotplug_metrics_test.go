@@ -0,0 +1,132 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virt_controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	ioprometheusclient "github.com/prometheus/client_model/go"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("Hotplug volume metrics", func() {
+	const volumeName = "hotplugged"
+
+	var (
+		tracker *hotplugVolumeTracker
+		vmi     *v1.VirtualMachineInstance
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		tracker = newHotplugVolumeTracker()
+		vmi = &v1.VirtualMachineInstance{}
+		vmi.UID = "1234"
+		now = time.Now()
+	})
+
+	stepSamples := func(step string) (uint64, float64) {
+		metric := &ioprometheusclient.Metric{}
+		Expect(hotplugVolumeStepDuration.WithLabelValues(step).(prometheus.Metric).Write(metric)).To(Succeed())
+		return metric.GetHistogram().GetSampleCount(), metric.GetHistogram().GetSampleSum()
+	}
+
+	// transition moves the hotplugged volume of the VMI to the given state after the given time
+	transition := func(after time.Duration, inSpec bool, phase v1.VolumePhase, attachPodUID string) {
+		oldVMI := vmi.DeepCopy()
+		vmi.Spec.Volumes = nil
+		if inSpec {
+			vmi.Spec.Volumes = []v1.Volume{{Name: volumeName}}
+		}
+		vmi.Status.VolumeStatus = nil
+		if phase != "" {
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+				Name:          volumeName,
+				Phase:         phase,
+				HotplugVolume: &v1.HotplugVolumeStatus{AttachPodUID: types.UID(attachPodUID)},
+			}}
+		}
+		now = now.Add(after)
+		tracker.update(oldVMI, vmi, now)
+	}
+
+	It("should time every step of the hotplug of a volume", func() {
+		attachmentPodCount, attachmentPodSum := stepSamples(hotplugStepAttachmentPod)
+		mountCount, mountSum := stepSamples(hotplugStepMount)
+		attachCount, attachSum := stepSamples(hotplugStepAttach)
+
+		transition(0, true, v1.HotplugVolumeAttachedToNode, "")
+		transition(3*time.Second, true, v1.HotplugVolumeAttachedToNode, "attachment-pod")
+		transition(2*time.Second, true, v1.HotplugVolumeMounted, "attachment-pod")
+		transition(time.Second, true, v1.VolumeReady, "attachment-pod")
+
+		count, sum := stepSamples(hotplugStepAttachmentPod)
+		Expect(count - attachmentPodCount).To(BeEquivalentTo(1))
+		Expect(sum - attachmentPodSum).To(BeNumerically("~", 3))
+		count, sum = stepSamples(hotplugStepMount)
+		Expect(count - mountCount).To(BeEquivalentTo(1))
+		Expect(sum - mountSum).To(BeNumerically("~", 2))
+		count, sum = stepSamples(hotplugStepAttach)
+		Expect(count - attachCount).To(BeEquivalentTo(1))
+		Expect(sum - attachSum).To(BeNumerically("~", 1))
+	})
+
+	It("should time the unplug of a volume and forget it afterwards", func() {
+		transition(0, true, v1.VolumeReady, "attachment-pod")
+		detachCount, detachSum := stepSamples(hotplugStepDetach)
+
+		transition(time.Second, false, v1.VolumeReady, "attachment-pod")
+		transition(4*time.Second, false, v1.HotplugVolumeDetaching, "attachment-pod")
+		transition(time.Second, false, "", "")
+
+		count, sum := stepSamples(hotplugStepDetach)
+		Expect(count - detachCount).To(BeEquivalentTo(1))
+		Expect(sum - detachSum).To(BeNumerically("~", 5))
+		Expect(tracker.volumes).To(BeEmpty())
+	})
+
+	It("should not time the step of a volume hotplugged before it was tracked", func() {
+		vmi.Spec.Volumes = []v1.Volume{{Name: volumeName}}
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+			Name:          volumeName,
+			Phase:         v1.HotplugVolumeAttachedToNode,
+			HotplugVolume: &v1.HotplugVolumeStatus{},
+		}}
+		mountCount, _ := stepSamples(hotplugStepMount)
+
+		transition(time.Second, true, v1.HotplugVolumeMounted, "attachment-pod")
+
+		count, _ := stepSamples(hotplugStepMount)
+		Expect(count).To(Equal(mountCount))
+	})
+
+	It("should forget the volumes of a deleted VMI", func() {
+		transition(0, true, v1.HotplugVolumeAttachedToNode, "")
+		Expect(tracker.volumes).To(HaveKey(vmi.UID))
+
+		tracker.forget(vmi.UID)
+		Expect(tracker.volumes).To(BeEmpty())
+	})
+})
@@ -35,6 +35,7 @@ import (
 var (
 	metrics = [][]operatormetrics.Metric{
 		componentMetrics,
+		hotplugMetrics,
 		migrationMetrics,
 		perfscaleMetrics,
 		vmiMetrics,
@@ -105,6 +105,7 @@ var (
 
 	errorStatuses = []k6tv1.VirtualMachinePrintableStatus{
 		k6tv1.VirtualMachineStatusCrashLoopBackOff,
+		k6tv1.VirtualMachineStatusRestartLimitReached,
 		k6tv1.VirtualMachineStatusUnknown,
 		k6tv1.VirtualMachineStatusUnschedulable,
 		k6tv1.VirtualMachineStatusErrImagePull,
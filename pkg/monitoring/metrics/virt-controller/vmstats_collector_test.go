@@ -89,6 +89,7 @@ var _ = Describe("VM Stats Collector", func() {
 			Entry("Migrating VM", k6tv1.VirtualMachineStatusMigrating, migratingTimestamp),
 			Entry("Non running VM", k6tv1.VirtualMachineStatusStopped, nonRunningTimestamp),
 			Entry("Errored VM", k6tv1.VirtualMachineStatusCrashLoopBackOff, errorTimestamp),
+			Entry("VM reaching the restart limit", k6tv1.VirtualMachineStatusRestartLimitReached, errorTimestamp),
 		)
 	})
 })
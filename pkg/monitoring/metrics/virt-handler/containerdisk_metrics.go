@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright the KubeVirt Authors.
+ *
+ */
+
+package virt_handler
+
+import (
+	"time"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	containerDiskMetrics = []operatormetrics.Metric{
+		containerDiskFetchDuration,
+		containerDiskBootOverlap,
+	}
+
+	containerDiskFetchDuration = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_containerdisk_fetch_duration_seconds",
+			Help: "Histogram of the time needed to fetch the whole content of the lazily pulled containerDisks of a VMI in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: containerDiskFetchBuckets(),
+		},
+	)
+
+	containerDiskBootOverlap = operatormetrics.NewHistogram(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmi_containerdisk_boot_overlap_seconds",
+			Help: "Histogram of the time a VMI was already running while the content of its lazily pulled containerDisks was still being fetched in seconds.",
+		},
+		prometheus.HistogramOpts{
+			Buckets: containerDiskFetchBuckets(),
+		},
+	)
+)
+
+func containerDiskFetchBuckets() []float64 {
+	return []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600}
+}
+
+// ObserveContainerDiskFetch records the fetch of the containerDisks of a VMI, which took place
+// between started and finished. runningSince is the time the VMI started running, zero if it is not running yet.
+func ObserveContainerDiskFetch(started, finished, runningSince time.Time) {
+	containerDiskFetchDuration.Observe(finished.Sub(started).Seconds())
+
+	overlap := time.Duration(0)
+	if !runningSince.IsZero() {
+		if runningSince.Before(started) {
+			runningSince = started
+		}
+		if finished.After(runningSince) {
+			overlap = finished.Sub(runningSince)
+		}
+	}
+	containerDiskBootOverlap.Observe(overlap.Seconds())
+}
@@ -41,6 +41,10 @@ func SetupMetrics(virtShareDir, nodeName string, MaxRequestsInFlight int, vmiInf
 	}
 	SetVersionInfo()
 
+	if err := operatormetrics.RegisterMetrics(containerDiskMetrics); err != nil {
+		return err
+	}
+
 	domainstats.SetupDomainStatsCollector(virtShareDir, nodeName, MaxRequestsInFlight, vmiInformer)
 	return operatormetrics.RegisterCollector(domainstats.Collector)
 }
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package filter
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+)
+
+// StreamDrainer tracks the open streams of a server. Once draining started it refuses new
+// streams, so that the open ones can finish before the server shuts down.
+type StreamDrainer struct {
+	lock     sync.Mutex
+	draining bool
+	streams  int
+	idle     chan struct{}
+}
+
+func NewStreamDrainer() *StreamDrainer {
+	return &StreamDrainer{}
+}
+
+// Filter tracks the streams served by the routes it is added to, and rejects new
+// streams with 503 Service Unavailable once draining started.
+func (d *StreamDrainer) Filter(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+	if !d.add() {
+		resp.AddHeader("Retry-After", "1")
+		resp.WriteErrorString(http.StatusServiceUnavailable, "server is shutting down, no new streams are accepted")
+		return
+	}
+	defer d.done()
+	chain.ProcessFilter(req, resp)
+}
+
+// Drain refuses new streams and waits up to timeout for the open ones to be closed.
+// It returns the number of streams which are still open.
+func (d *StreamDrainer) Drain(timeout time.Duration) int {
+	d.lock.Lock()
+	d.draining = true
+	if d.streams == 0 || timeout <= 0 {
+		defer d.lock.Unlock()
+		return d.streams
+	}
+	if d.idle == nil {
+		d.idle = make(chan struct{})
+	}
+	idle := d.idle
+	d.lock.Unlock()
+
+	select {
+	case <-idle:
+	case <-time.After(timeout):
+	}
+	return d.OpenStreams()
+}
+
+func (d *StreamDrainer) OpenStreams() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return d.streams
+}
+
+func (d *StreamDrainer) add() bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if d.draining {
+		return false
+	}
+	d.streams++
+	return true
+}
+
+func (d *StreamDrainer) done() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.streams--
+	if d.streams == 0 && d.idle != nil {
+		close(d.idle)
+		d.idle = nil
+	}
+}
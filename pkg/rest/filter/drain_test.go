@@ -0,0 +1,117 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package filter_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/rest/filter"
+)
+
+var _ = Describe("StreamDrainer", func() {
+	var (
+		drainer   *filter.StreamDrainer
+		container *restful.Container
+		release   chan struct{}
+		started   chan struct{}
+	)
+
+	serve := func() *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		container.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/stream", nil))
+		return recorder
+	}
+
+	openStream := func() chan *httptest.ResponseRecorder {
+		done := make(chan *httptest.ResponseRecorder, 1)
+		go func() {
+			done <- serve()
+		}()
+		Eventually(started).Should(Receive())
+		return done
+	}
+
+	BeforeEach(func() {
+		drainer = filter.NewStreamDrainer()
+		release = make(chan struct{})
+		started = make(chan struct{}, 10)
+
+		ws := new(restful.WebService)
+		ws.Route(ws.GET("/stream").To(func(_ *restful.Request, resp *restful.Response) {
+			started <- struct{}{}
+			<-release
+			resp.WriteHeader(http.StatusOK)
+		}).Filter(drainer.Filter))
+		container = restful.NewContainer()
+		container.Add(ws)
+	})
+
+	It("should track the open streams", func() {
+		done := openStream()
+		Expect(drainer.OpenStreams()).To(Equal(1))
+
+		close(release)
+		Expect((<-done).Code).To(Equal(http.StatusOK))
+		Expect(drainer.OpenStreams()).To(BeZero())
+	})
+
+	It("should return right away when no stream is open", func() {
+		Expect(drainer.Drain(time.Hour)).To(BeZero())
+	})
+
+	It("should refuse new streams while draining", func() {
+		close(release)
+		Expect(drainer.Drain(0)).To(BeZero())
+
+		recorder := serve()
+		Expect(recorder.Code).To(Equal(http.StatusServiceUnavailable))
+		Expect(recorder.Header().Get("Retry-After")).To(Equal("1"))
+		Expect(started).ToNot(Receive())
+	})
+
+	It("should wait for the open streams to finish", func() {
+		done := openStream()
+
+		drained := make(chan int, 1)
+		go func() {
+			drained <- drainer.Drain(time.Hour)
+		}()
+		Consistently(drained, 100*time.Millisecond).ShouldNot(Receive())
+
+		close(release)
+		Expect((<-done).Code).To(Equal(http.StatusOK))
+		Eventually(drained).Should(Receive(BeZero()))
+	})
+
+	It("should give up on the open streams after the timeout", func() {
+		done := openStream()
+
+		Expect(drainer.Drain(10 * time.Millisecond)).To(Equal(1))
+
+		close(release)
+		Expect((<-done).Code).To(Equal(http.StatusOK))
+	})
+})
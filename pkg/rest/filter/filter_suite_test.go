@@ -0,0 +1,11 @@
+package filter_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestFilter(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -25,6 +25,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path"
+	"slices"
 	"time"
 
 	"github.com/openshift/library-go/pkg/build/naming"
@@ -923,6 +924,25 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
 	})
+	if ctrl.isFIPSModeEnabled() {
+		podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "FIPS_MODE",
+			Value: "true",
+		})
+	}
+	oidcConfig, err := ctrl.getOIDCConfig(vmExport.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if oidcConfig != "" {
+		podManifest.Spec.Containers[0].Env = append(podManifest.Spec.Containers[0].Env, corev1.EnvVar{
+			Name:  "OIDC_CONFIG",
+			Value: oidcConfig,
+		}, corev1.EnvVar{
+			Name:  "EXPORT_NAMESPACE",
+			Value: vmExport.Namespace,
+		})
+	}
 
 	tokenSecretRef := ""
 	if vmExport.Status != nil && vmExport.Status.TokenSecretRef != nil {
@@ -1222,6 +1242,38 @@ func (ctrl *VMExportController) getCertParams() (*CertParams, error) {
 	}, nil
 }
 
+func (ctrl *VMExportController) isFIPSModeEnabled() bool {
+	kv := ctrl.clusterConfig.GetConfigFromKubeVirtCR()
+	return kv != nil && kv.Spec.Configuration.TLSConfiguration != nil && kv.Spec.Configuration.TLSConfiguration.FIPSMode
+}
+
+// getOIDCConfig serializes the OIDC issuer configuration for the exporter pod of a namespace.
+// Only the group bindings of the namespace are passed on, it is empty if no group is bound to the namespace.
+func (ctrl *VMExportController) getOIDCConfig(namespace string) (string, error) {
+	kv := ctrl.clusterConfig.GetConfigFromKubeVirtCR()
+	if kv == nil || kv.Spec.Configuration.ExportOIDC == nil {
+		return "", nil
+	}
+	exportOIDC := kv.Spec.Configuration.ExportOIDC.DeepCopy()
+	exportOIDC.GroupBindings = nil
+	for _, binding := range kv.Spec.Configuration.ExportOIDC.GroupBindings {
+		if slices.Contains(binding.Namespaces, namespace) {
+			exportOIDC.GroupBindings = append(exportOIDC.GroupBindings, virtv1.ExportOIDCGroupBinding{
+				Group:      binding.Group,
+				Namespaces: []string{namespace},
+			})
+		}
+	}
+	if len(exportOIDC.GroupBindings) == 0 {
+		return "", nil
+	}
+	oidcConfig, err := json.Marshal(exportOIDC)
+	if err != nil {
+		return "", err
+	}
+	return string(oidcConfig), nil
+}
+
 func populateInitialVMExportStatus(vmExport *exportv1.VirtualMachineExport) {
 	expireAt := metav1.NewTime(getExpirationTime(vmExport))
 	vmExport.Status = &exportv1.VirtualMachineExportStatus{
@@ -930,6 +930,83 @@ var _ = Describe("Export controller", func() {
 		Entry("Snapshot", populateVmExportVMSnapshot, 4),
 	)
 
+	It("Should restrict the exporter pod to the FIPS TLS profile if FIPS mode is enabled", func() {
+		testVMExport := createPVCVMExport()
+		populateInitialVMExportStatus(testVMExport)
+		fipsModeEnv := k8sv1.EnvVar{Name: "FIPS_MODE", Value: "true"}
+
+		pod, err := controller.createExporterPodManifest(testVMExport, &k8sv1.Service{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers[0].Env).ToNot(ContainElement(fipsModeEnv))
+
+		obj, exists, err := kvInformer.GetStore().GetByKey(controller.KubevirtNamespace + "/kv")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		kv := obj.(*virtv1.KubeVirt).DeepCopy()
+		kv.Spec.Configuration.TLSConfiguration = &virtv1.TLSConfiguration{FIPSMode: true}
+		Expect(kvInformer.GetStore().Update(kv)).To(Succeed())
+
+		pod, err = controller.createExporterPodManifest(testVMExport, &k8sv1.Service{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers[0].Env).To(ContainElement(fipsModeEnv))
+	})
+
+	It("Should pass the OIDC issuer configuration to the exporter pod", func() {
+		testVMExport := createPVCVMExport()
+		populateInitialVMExportStatus(testVMExport)
+
+		pod, err := controller.createExporterPodManifest(testVMExport, &k8sv1.Service{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers[0].Env).ToNot(ContainElement(HaveField("Name", "OIDC_CONFIG")))
+
+		obj, exists, err := kvInformer.GetStore().GetByKey(controller.KubevirtNamespace + "/kv")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		kv := obj.(*virtv1.KubeVirt).DeepCopy()
+		kv.Spec.Configuration.ExportOIDC = &virtv1.ExportOIDCConfiguration{
+			IssuerURL: "https://sso.example.com",
+			ClientID:  "export",
+			GroupBindings: []virtv1.ExportOIDCGroupBinding{
+				{Group: "developers", Namespaces: []string{"other", testNamespace}},
+				{Group: "operators", Namespaces: []string{"other"}},
+			},
+		}
+		Expect(kvInformer.GetStore().Update(kv)).To(Succeed())
+
+		pod, err = controller.createExporterPodManifest(testVMExport, &k8sv1.Service{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers[0].Env).To(ContainElements(k8sv1.EnvVar{
+			Name:  "OIDC_CONFIG",
+			Value: `{"issuerURL":"https://sso.example.com","clientID":"export","groupBindings":[{"group":"developers","namespaces":["` + testNamespace + `"]}]}`,
+		}, k8sv1.EnvVar{
+			Name:  "EXPORT_NAMESPACE",
+			Value: testNamespace,
+		}))
+	})
+
+	It("Should not pass the OIDC config to the exporter pod if no group is bound to the namespace", func() {
+		testVMExport := createPVCVMExport()
+		populateInitialVMExportStatus(testVMExport)
+
+		obj, exists, err := kvInformer.GetStore().GetByKey(controller.KubevirtNamespace + "/kv")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		kv := obj.(*virtv1.KubeVirt).DeepCopy()
+		kv.Spec.Configuration.ExportOIDC = &virtv1.ExportOIDCConfiguration{
+			IssuerURL: "https://sso.example.com",
+			ClientID:  "export",
+			GroupBindings: []virtv1.ExportOIDCGroupBinding{
+				{Group: "operators", Namespaces: []string{"other"}},
+			},
+		}
+		Expect(kvInformer.GetStore().Update(kv)).To(Succeed())
+
+		pod, err := controller.createExporterPodManifest(testVMExport, &k8sv1.Service{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers[0].Env).ToNot(ContainElement(HaveField("Name", "OIDC_CONFIG")))
+		Expect(pod.Spec.Containers[0].Env).ToNot(ContainElement(HaveField("Name", "EXPORT_NAMESPACE")))
+	})
+
 	It("Should create a secret based on the vm export", func() {
 		cp := &CertParams{Duration: 24 * time.Hour, RenewBefore: 2 * time.Hour}
 		scp, err := serializeCertParams(cp)
@@ -51,6 +51,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 )
 
 const (
@@ -69,6 +70,8 @@ const (
 
 type TokenGetterFunc func() (string, error)
 
+type bearerTokenVerifierFunc func(token string) error
+
 type ExportServerConfig struct {
 	Deadline time.Time
 
@@ -78,6 +81,14 @@ type ExportServerConfig struct {
 
 	TokenFile string
 
+	// FIPSMode restricts the TLS stack to the FIPS 140 approved settings
+	FIPSMode bool
+
+	// OIDC lets the server accept the ID tokens of an OIDC issuer as bearer tokens
+	OIDC *virtv1.ExportOIDCConfiguration
+	// Namespace is the namespace of the export, only the OIDC groups bound to it are accepted
+	Namespace string
+
 	Paths *export.ServerPaths
 
 	// unit testing helpers
@@ -120,22 +131,26 @@ func (er *execReader) Close() error {
 
 func (s *exportServer) initHandler() {
 	mux := http.NewServeMux()
+	var verifyBearerToken bearerTokenVerifierFunc
+	if s.OIDC != nil {
+		verifyBearerToken = newOIDCVerifier(s.OIDC, s.Namespace, nil).verify
+	}
 	for _, vi := range s.Paths.Volumes {
 		if hasPermissions := checkVolumePermissions(vi.Path); !hasPermissions {
 			golog.Fatalf("unable to manipulate %s's contents, exiting", vi.Path)
 		}
 		for path, handler := range s.getHandlerMap(vi) {
 			log.Log.Infof("Handling path %s\n", path)
-			mux.Handle(path, tokenChecker(s.TokenGetter, handler))
+			mux.Handle(path, tokenChecker(s.TokenGetter, verifyBearerToken, handler))
 		}
 	}
 	if s.Paths.VMURI != "" {
-		mux.Handle(filepath.Join(internal, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getInternalBasePath, getInternalCAConfigMap)))
-		mux.Handle(filepath.Join(external, s.Paths.VMURI), tokenChecker(s.TokenGetter, s.VmHandler(s.Paths.Volumes, getExternalBasePath, getExternalCAConfigMap)))
+		mux.Handle(filepath.Join(internal, s.Paths.VMURI), tokenChecker(s.TokenGetter, verifyBearerToken, s.VmHandler(s.Paths.Volumes, getInternalBasePath, getInternalCAConfigMap)))
+		mux.Handle(filepath.Join(external, s.Paths.VMURI), tokenChecker(s.TokenGetter, verifyBearerToken, s.VmHandler(s.Paths.Volumes, getExternalBasePath, getExternalCAConfigMap)))
 	}
 	if s.Paths.SecretURI != "" {
-		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
-		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
+		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, verifyBearerToken, s.TokenSecretHandler(s.TokenGetter)))
+		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, verifyBearerToken, s.TokenSecretHandler(s.TokenGetter)))
 	}
 	// Readiness probe
 	mux.HandleFunc(export.ReadinessPath, s.readyHandler)
@@ -194,6 +209,9 @@ func (s *exportServer) Run() {
 		// See CVE-2023-44487
 		TLSNextProto: map[string]func(*http.Server, *tls.Conn, http.Handler){},
 	}
+	if s.FIPSMode {
+		srv.TLSConfig = kvtls.SetupFIPSTLS()
+	}
 
 	ch := make(chan error)
 
@@ -445,7 +463,15 @@ func getTokenHeader(r *http.Request) (token string) {
 	return
 }
 
-func tokenChecker(tokenGetter TokenGetterFunc, nextHandler http.Handler) http.Handler {
+func getBearerToken(r *http.Request) (token string) {
+	if tok, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); found {
+		r.Header.Del("Authorization")
+		token = strings.TrimSpace(tok)
+	}
+	return
+}
+
+func tokenChecker(tokenGetter TokenGetterFunc, verifyBearerToken bearerTokenVerifierFunc, nextHandler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		token, err := tokenGetter()
 		if err != nil {
@@ -458,6 +484,14 @@ func tokenChecker(tokenGetter TokenGetterFunc, nextHandler http.Handler) http.Ha
 				return
 			}
 		}
+		if bearerToken := getBearerToken(r); bearerToken != "" && verifyBearerToken != nil {
+			if err := verifyBearerToken(bearerToken); err != nil {
+				log.Log.Reason(err).Info("Rejected bearer token")
+			} else {
+				nextHandler.ServeHTTP(w, r)
+				return
+			}
+		}
 		w.WriteHeader(http.StatusUnauthorized)
 	})
 }
@@ -0,0 +1,173 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	oidcDefaultGroupsClaim = "groups"
+	oidcRequestTimeout     = 10 * time.Second
+)
+
+// oidcSigningAlgorithms are the signing algorithms accepted for ID tokens
+var oidcSigningAlgorithms = []string{
+	oidc.RS256, oidc.RS384, oidc.RS512,
+	oidc.PS256, oidc.PS384, oidc.PS512,
+	oidc.ES256, oidc.ES384, oidc.ES512,
+}
+
+// oidcVerifier validates OIDC ID tokens against the signing keys published by the issuer.
+// The issuer is discovered on first use, go-oidc fetches the signing keys again when a token is signed with an unknown key.
+type oidcVerifier struct {
+	config    *virtv1.ExportOIDCConfiguration
+	namespace string
+	client    *http.Client
+	now       func() time.Time
+
+	lock     sync.Mutex
+	verifier *oidc.IDTokenVerifier
+}
+
+func newOIDCVerifier(config *virtv1.ExportOIDCConfiguration, namespace string, client *http.Client) *oidcVerifier {
+	if client == nil {
+		client = &http.Client{Timeout: oidcRequestTimeout}
+	}
+	return &oidcVerifier{
+		config:    config,
+		namespace: namespace,
+		client:    client,
+		now:       time.Now,
+	}
+}
+
+// verify checks the signature, the issuer, the audience, the validity period and the groups of an ID token
+func (v *oidcVerifier) verify(rawToken string) error {
+	// go-jose splits the whole token before checking its segments, malformed tokens are rejected upfront
+	if strings.Count(rawToken, ".") != 2 {
+		return errors.New("malformed token")
+	}
+	verifier, err := v.getVerifier()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(oidc.ClientContext(context.Background(), v.client), oidcRequestTimeout)
+	defer cancel()
+	idToken, err := verifier.Verify(ctx, rawToken)
+	if err != nil {
+		return err
+	}
+	return v.verifyGroups(idToken)
+}
+
+func (v *oidcVerifier) getVerifier() (*oidc.IDTokenVerifier, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if v.verifier != nil {
+		return v.verifier, nil
+	}
+	ctx, cancel := context.WithTimeout(oidc.ClientContext(context.Background(), v.client), oidcRequestTimeout)
+	defer cancel()
+	provider, err := oidc.NewProvider(ctx, v.config.IssuerURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover the OIDC issuer: %v", err)
+	}
+	v.verifier = provider.Verifier(&oidc.Config{
+		ClientID:             v.config.ClientID,
+		SupportedSigningAlgs: oidcSigningAlgorithms,
+		Now:                  v.now,
+	})
+	return v.verifier, nil
+}
+
+// verifyGroups checks that the owner of the token is a member of one of the groups bound to the namespace of the export.
+// The groups are mandatory, a token is never accepted without them.
+func (v *oidcVerifier) verifyGroups(idToken *oidc.IDToken) error {
+	allowedGroups := v.allowedGroups()
+	if len(allowedGroups) == 0 {
+		return fmt.Errorf("no groups bound to namespace %q", v.namespace)
+	}
+	groupsClaim := v.config.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = oidcDefaultGroupsClaim
+	}
+
+	var claims map[string]json.RawMessage
+	if err := idToken.Claims(&claims); err != nil {
+		return fmt.Errorf("malformed token claims: %v", err)
+	}
+	var groups stringOrList
+	if rawGroups, exists := claims[groupsClaim]; exists {
+		if err := json.Unmarshal(rawGroups, &groups); err != nil {
+			return fmt.Errorf("malformed %s claim: %v", groupsClaim, err)
+		}
+	}
+	for _, group := range groups {
+		if slices.Contains(allowedGroups, group) {
+			return nil
+		}
+	}
+	return errors.New("token owner is not a member of an allowed group")
+}
+
+// allowedGroups returns the groups bound to the namespace of the export
+func (v *oidcVerifier) allowedGroups() []string {
+	var groups []string
+	if v.namespace == "" {
+		return groups
+	}
+	for _, binding := range v.config.GroupBindings {
+		if slices.Contains(binding.Namespaces, v.namespace) {
+			groups = append(groups, binding.Group)
+		}
+	}
+	return groups
+}
+
+// stringOrList is either a single string or a list of strings
+type stringOrList []string
+
+func (l *stringOrList) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*l = stringOrList{single}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*l = list
+	return nil
+}
@@ -0,0 +1,295 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+const testClientID = "export"
+
+type testIssuer struct {
+	server *httptest.Server
+
+	lock        sync.Mutex
+	keys        []map[string]string
+	unavailable bool
+}
+
+func newTestIssuer() *testIssuer {
+	issuer := &testIssuer{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		issuer.lock.Lock()
+		defer issuer.lock.Unlock()
+		if issuer.unavailable {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": issuer.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		issuer.lock.Lock()
+		defer issuer.lock.Unlock()
+		json.NewEncoder(w).Encode(map[string]interface{}{"keys": issuer.keys})
+	})
+	issuer.server = httptest.NewTLSServer(mux)
+	return issuer
+}
+
+func (i *testIssuer) publishKey(kid string, key crypto.PublicKey) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		i.keys = append(i.keys, map[string]string{
+			"kty": "RSA",
+			"kid": kid,
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		})
+	case *ecdsa.PublicKey:
+		i.keys = append(i.keys, map[string]string{
+			"kty": "EC",
+			"kid": kid,
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		})
+	}
+}
+
+func signToken(alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	Expect(err).ToNot(HaveOccurred())
+	payload, err := json.Marshal(claims)
+	Expect(err).ToNot(HaveOccurred())
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signed))
+
+	var signature []byte
+	switch key := key.(type) {
+	case *rsa.PrivateKey:
+		signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+		Expect(err).ToNot(HaveOccurred())
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+		Expect(err).ToNot(HaveOccurred())
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+var _ = Describe("OIDC bearer tokens", func() {
+	var (
+		issuer   *testIssuer
+		rsaKey   *rsa.PrivateKey
+		ecKey    *ecdsa.PrivateKey
+		config   *virtv1.ExportOIDCConfiguration
+		verifier *oidcVerifier
+		now      time.Time
+	)
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":    issuer.server.URL,
+			"aud":    testClientID,
+			"sub":    "user",
+			"exp":    now.Add(time.Hour).Unix(),
+			"groups": []string{"developers"},
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		rsaKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		ecKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		issuer = newTestIssuer()
+		DeferCleanup(issuer.server.Close)
+		issuer.publishKey("rsa", &rsaKey.PublicKey)
+		issuer.publishKey("ec", &ecKey.PublicKey)
+
+		now = time.Now()
+		config = &virtv1.ExportOIDCConfiguration{
+			IssuerURL: issuer.server.URL,
+			ClientID:  testClientID,
+			GroupBindings: []virtv1.ExportOIDCGroupBinding{
+				{Group: "admins", Namespaces: []string{testNamespace}},
+				{Group: "developers", Namespaces: []string{"other", testNamespace}},
+				{Group: "testers", Namespaces: []string{"other"}},
+			},
+		}
+		verifier = newOIDCVerifier(config, testNamespace, issuer.server.Client())
+		verifier.now = func() time.Time { return now }
+	})
+
+	It("should accept a valid RS256 token", func() {
+		Expect(verifier.verify(signToken("RS256", "rsa", rsaKey, validClaims()))).To(Succeed())
+	})
+
+	It("should accept a valid ES256 token", func() {
+		Expect(verifier.verify(signToken("ES256", "ec", ecKey, validClaims()))).To(Succeed())
+	})
+
+	It("should accept a token with a list of audiences", func() {
+		claims := validClaims()
+		claims["aud"] = []string{"other", testClientID}
+		Expect(verifier.verify(signToken("RS256", "rsa", rsaKey, claims))).To(Succeed())
+	})
+
+	DescribeTable("should reject a token", func(modify func(claims map[string]interface{}), expectedError string) {
+		claims := validClaims()
+		modify(claims)
+		err := verifier.verify(signToken("RS256", "rsa", rsaKey, claims))
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("of another issuer", func(claims map[string]interface{}) {
+			claims["iss"] = "https://other.example.com"
+		}, "id token issued by a different provider"),
+		Entry("for another client", func(claims map[string]interface{}) {
+			claims["aud"] = "other"
+		}, "expected audience"),
+		Entry("which expired", func(claims map[string]interface{}) {
+			claims["exp"] = now.Add(-time.Hour).Unix()
+		}, "token is expired"),
+		Entry("without expiry", func(claims map[string]interface{}) {
+			delete(claims, "exp")
+		}, "token is expired"),
+		Entry("which is not valid yet", func(claims map[string]interface{}) {
+			claims["nbf"] = now.Add(time.Hour).Unix()
+		}, "before the nbf (not before) time"),
+		Entry("of a member of a group bound to other namespaces", func(claims map[string]interface{}) {
+			claims["groups"] = []string{"testers"}
+		}, "not a member of an allowed group"),
+		Entry("of a member of an unbound group", func(claims map[string]interface{}) {
+			claims["groups"] = []string{"guests"}
+		}, "not a member of an allowed group"),
+		Entry("without groups", func(claims map[string]interface{}) {
+			delete(claims, "groups")
+		}, "not a member of an allowed group"),
+	)
+
+	It("should reject a malformed token", func() {
+		token := signToken("RS256", "rsa", rsaKey, validClaims())
+		Expect(verifier.verify(token + strings.Repeat(".", 100))).To(MatchError("malformed token"))
+	})
+
+	It("should reject a token with a forged signature", func() {
+		forgedKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(verifier.verify(signToken("RS256", "rsa", forgedKey, validClaims()))).To(MatchError(ContainSubstring("failed to verify signature")))
+	})
+
+	It("should reject an unsigned token", func() {
+		token := signToken("RS256", "rsa", rsaKey, validClaims())
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"rsa"}`))
+		token = header + token[len(base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"RS256","kid":"rsa","typ":"JWT"}`))):]
+		Expect(verifier.verify(token)).ToNot(Succeed())
+	})
+
+	It("should reject a signing algorithm not matching the key", func() {
+		Expect(verifier.verify(signToken("ES256", "rsa", ecKey, validClaims()))).To(MatchError(ContainSubstring("failed to verify signature")))
+	})
+
+	It("should pick up rotated signing keys", func() {
+		Expect(verifier.verify(signToken("RS256", "rsa", rsaKey, validClaims()))).To(Succeed())
+
+		rotatedKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+		issuer.publishKey("rotated", &rotatedKey.PublicKey)
+		Expect(verifier.verify(signToken("RS256", "rotated", rotatedKey, validClaims()))).To(Succeed())
+	})
+
+	It("should read the groups from the configured claim", func() {
+		config.GroupsClaim = "roles"
+		claims := validClaims()
+		Expect(verifier.verify(signToken("RS256", "rsa", rsaKey, claims))).To(MatchError(ContainSubstring("not a member of an allowed group")))
+		claims["roles"] = "admins"
+		Expect(verifier.verify(signToken("RS256", "rsa", rsaKey, claims))).To(Succeed())
+	})
+
+	It("should reject all tokens without groups bound to the namespace", func() {
+		config.GroupBindings = nil
+		Expect(verifier.verify(signToken("RS256", "rsa", rsaKey, validClaims()))).To(MatchError(ContainSubstring("no groups bound to namespace")))
+	})
+
+	It("should reject all tokens without the namespace of the export", func() {
+		verifier.namespace = ""
+		Expect(verifier.verify(signToken("RS256", "rsa", rsaKey, validClaims()))).To(MatchError(ContainSubstring("no groups bound to namespace")))
+	})
+
+	It("should discover the issuer again if it was not reachable", func() {
+		issuer.lock.Lock()
+		issuer.unavailable = true
+		issuer.lock.Unlock()
+		token := signToken("RS256", "rsa", rsaKey, validClaims())
+		Expect(verifier.verify(token)).To(MatchError(ContainSubstring("failed to discover the OIDC issuer")))
+
+		issuer.lock.Lock()
+		issuer.unavailable = false
+		issuer.lock.Unlock()
+		Expect(verifier.verify(token)).To(Succeed())
+	})
+
+	DescribeTable("export server should check bearer tokens", func(verifyErr error, expectedStatus int) {
+		tokenGetter := func() (string, error) {
+			return "foo", nil
+		}
+		handler := tokenChecker(tokenGetter, func(token string) error {
+			Expect(token).To(Equal("oidc-token"))
+			return verifyErr
+		}, http.HandlerFunc(successHandler))
+
+		req := httptest.NewRequest(http.MethodGet, "/internal/vm", nil)
+		req.Header.Set("Authorization", "Bearer oidc-token")
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, req)
+		Expect(recorder.Code).To(Equal(expectedStatus))
+	},
+		Entry("and accept valid ones", nil, http.StatusOK),
+		Entry("and reject invalid ones", errors.New("token expired"), http.StatusUnauthorized),
+	)
+})
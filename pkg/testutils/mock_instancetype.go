@@ -19,6 +19,7 @@ type MockInstancetypeMethods struct {
 	InferDefaultPreferenceFunc      func(vm *v1.VirtualMachine) error
 	CheckPreferenceRequirementsFunc func(instancetypeSpec *instancetypev1beta1.VirtualMachineInstancetypeSpec, preferenceSpec *instancetypev1beta1.VirtualMachinePreferenceSpec, vmiSpec *v1.VirtualMachineInstanceSpec) (instancetype.Conflicts, error)
 	UpgradeFunc                     func(vm *v1.VirtualMachine) error
+	IsOutdatedFunc                  func(vm *v1.VirtualMachine) (bool, error)
 	ApplyToVMFunc                   func(vm *v1.VirtualMachine) error
 }
 
@@ -56,6 +57,10 @@ func (m *MockInstancetypeMethods) Upgrade(vm *v1.VirtualMachine) error {
 	return m.UpgradeFunc(vm)
 }
 
+func (m *MockInstancetypeMethods) IsOutdated(vm *v1.VirtualMachine) (bool, error) {
+	return m.IsOutdatedFunc(vm)
+}
+
 func (m *MockInstancetypeMethods) ApplyToVM(vm *v1.VirtualMachine) error {
 	return m.ApplyToVMFunc(vm)
 }
@@ -86,6 +91,9 @@ func NewMockInstancetypeMethods() *MockInstancetypeMethods {
 		UpgradeFunc: func(_ *v1.VirtualMachine) error {
 			return nil
 		},
+		IsOutdatedFunc: func(_ *v1.VirtualMachine) (bool, error) {
+			return false, nil
+		},
 		ApplyToVMFunc: func(_ *v1.VirtualMachine) error {
 			return nil
 		},
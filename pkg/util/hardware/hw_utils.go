@@ -114,22 +114,37 @@ func ParsePciAddress(pciAddress string) ([]string, error) {
 }
 
 func GetDeviceNumaNode(pciAddress string) (*uint32, error) {
-	pciBasePath := "/sys/bus/pci/devices"
-	numaNodePath := filepath.Join(pciBasePath, pciAddress, "numa_node")
-	// #nosec No risk for path injection. Reading static path of NUMA node info
-	numaNodeStr, err := os.ReadFile(numaNodePath)
+	numaNodeInt, err := readDeviceNumaNode(pciAddress)
 	if err != nil {
 		return nil, err
 	}
-	numaNodeStr = bytes.TrimSpace(numaNodeStr)
-	numaNodeInt, err := strconv.Atoi(string(numaNodeStr))
-	if err != nil {
+	numaNode := uint32(numaNodeInt)
+	return &numaNode, nil
+}
+
+// GetDeviceNumaNodeAffinity returns the NUMA node of a PCI device, or nil if the device
+// has no NUMA affinity, which the kernel reports as -1.
+func GetDeviceNumaNodeAffinity(pciAddress string) (*uint32, error) {
+	numaNodeInt, err := readDeviceNumaNode(pciAddress)
+	if err != nil || numaNodeInt < 0 {
 		return nil, err
 	}
 	numaNode := uint32(numaNodeInt)
 	return &numaNode, nil
 }
 
+func readDeviceNumaNode(pciAddress string) (int, error) {
+	pciBasePath := "/sys/bus/pci/devices"
+	numaNodePath := filepath.Join(pciBasePath, pciAddress, "numa_node")
+	// #nosec No risk for path injection. Reading static path of NUMA node info
+	numaNodeStr, err := os.ReadFile(numaNodePath)
+	if err != nil {
+		return 0, err
+	}
+	numaNodeStr = bytes.TrimSpace(numaNodeStr)
+	return strconv.Atoi(string(numaNodeStr))
+}
+
 func GetDeviceAlignedCPUs(pciAddress string) ([]int, error) {
 	numaNode, err := GetDeviceNumaNode(pciAddress)
 	if err != nil {
@@ -157,6 +172,28 @@ func GetNumaNodeCPUList(numaNode int) ([]int, error) {
 	return cpusList, nil
 }
 
+// GetNumaNodeLocalAccess returns the read latency in nanoseconds and the read bandwidth in MB/s of the memory of a
+// numa node, when accessed from its local initiators, as reported by the firmware in the ACPI HMAT.
+func GetNumaNodeLocalAccess(numaNode int) (latency uint64, bandwidth uint64, err error) {
+	accessPath := fmt.Sprintf("/sys/bus/node/devices/node%d/access0/initiators", numaNode)
+	if latency, err = readUintFile(filepath.Join(accessPath, "read_latency")); err != nil {
+		return 0, 0, err
+	}
+	if bandwidth, err = readUintFile(filepath.Join(accessPath, "read_bandwidth")); err != nil {
+		return 0, 0, err
+	}
+	return latency, bandwidth, nil
+}
+
+func readUintFile(path string) (uint64, error) {
+	// #nosec No risk for path injection. Reading static path of NUMA node info
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(string(bytes.TrimSpace(content)), 10, 64)
+}
+
 func LookupDeviceVCPUAffinity(pciAddress string, domainSpec *api.DomainSpec) ([]uint32, error) {
 	alignedVCPUList := []uint32{}
 	p2vCPUMap := make(map[string]uint32)
@@ -0,0 +1,10 @@
+//go:build boringcrypto
+
+package tls
+
+import "crypto/boring"
+
+// FIPSModuleEnabled reports whether the cryptography is provided by the FIPS 140 validated BoringCrypto module
+func FIPSModuleEnabled() bool {
+	return boring.Enabled()
+}
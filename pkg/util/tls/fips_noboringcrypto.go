@@ -0,0 +1,9 @@
+//go:build !boringcrypto
+
+package tls
+
+// FIPSModuleEnabled reports whether the cryptography is provided by the FIPS 140 validated BoringCrypto module,
+// which requires a build with GOEXPERIMENT=boringcrypto
+func FIPSModuleEnabled() bool {
+	return false
+}
@@ -21,6 +21,21 @@ const noSrvCertMessage = "No server certificate, server is not yet ready to rece
 var (
 	cipherSuites         = tls.CipherSuites()
 	insecureCipherSuites = tls.InsecureCipherSuites()
+
+	// fipsCipherSuites are the TLS 1.2 cipher suites approved by FIPS 140, the TLS 1.3 ones are not configurable
+	fipsCipherSuites = []uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	}
+	// fipsTLS13CipherSuites are the TLS 1.3 cipher suites approved by FIPS 140
+	fipsTLS13CipherSuites = []uint16{
+		tls.TLS_AES_128_GCM_SHA256,
+		tls.TLS_AES_256_GCM_SHA384,
+	}
+	// fipsCurves are the key exchange curves approved by FIPS 140
+	fipsCurves = []tls.CurveID{tls.CurveP256, tls.CurveP384, tls.CurveP521}
 )
 
 func SetupPromTLS(certManager certificate.Manager, clusterConfig *virtconfig.ClusterConfig) *tls.Config {
@@ -44,10 +59,12 @@ func SetupPromTLS(certManager certificate.Manager, clusterConfig *virtconfig.Clu
 			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
 			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config := &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
-				Certificates: []tls.Certificate{*crt},
-				ClientAuth:   tls.VerifyClientCertIfGiven,
+				CipherSuites:     ciphers,
+				MinVersion:       minTLSVersion,
+				MaxVersion:       MaxTLSVersion(tlsConfig),
+				CurvePreferences: CurvePreferences(tlsConfig),
+				Certificates:     []tls.Certificate{*crt},
+				ClientAuth:       tls.VerifyClientCertIfGiven,
 			}
 
 			config.BuildNameToCertificate()
@@ -79,9 +96,11 @@ func SetupExportProxyTLS(certManager certificate.Manager, kubeVirtStore cache.St
 			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
 			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config := &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
-				Certificates: []tls.Certificate{*crt},
+				CipherSuites:     ciphers,
+				MinVersion:       minTLSVersion,
+				MaxVersion:       MaxTLSVersion(tlsConfig),
+				CurvePreferences: CurvePreferences(tlsConfig),
+				Certificates:     []tls.Certificate{*crt},
 			}
 
 			config.BuildNameToCertificate()
@@ -117,11 +136,13 @@ func SetupTLSWithCertManager(caManager ClientCAManager, certManager certificate.
 			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
 			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config := &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
-				Certificates: []tls.Certificate{*cert},
-				ClientCAs:    clientCAPool,
-				ClientAuth:   clientAuth,
+				CipherSuites:     ciphers,
+				MinVersion:       minTLSVersion,
+				MaxVersion:       MaxTLSVersion(tlsConfig),
+				CurvePreferences: CurvePreferences(tlsConfig),
+				Certificates:     []tls.Certificate{*cert},
+				ClientCAs:        clientCAPool,
+				ClientAuth:       clientAuth,
 			}
 
 			config.BuildNameToCertificate()
@@ -164,9 +185,11 @@ func SetupTLSForVirtHandlerServer(caManager ClientCAManager, certManager certifi
 			ciphers := CipherSuiteIds(tlsConfig.Ciphers)
 			minTLSVersion := TLSVersion(tlsConfig.MinTLSVersion)
 			config = &tls.Config{
-				CipherSuites: ciphers,
-				MinVersion:   minTLSVersion,
-				ClientCAs:    certPool,
+				CipherSuites:     ciphers,
+				MinVersion:       minTLSVersion,
+				MaxVersion:       MaxTLSVersion(tlsConfig),
+				CurvePreferences: CurvePreferences(tlsConfig),
+				ClientCAs:        certPool,
 				GetCertificate: func(info *tls.ClientHelloInfo) (i *tls.Certificate, e error) {
 					return cert, nil
 				},
@@ -183,7 +206,18 @@ func SetupTLSForVirtHandlerServer(caManager ClientCAManager, certManager certifi
 	}
 }
 
-func SetupTLSForVirtHandlerClients(caManager ClientCAManager, certManager certificate.Manager, externallyManaged bool) *tls.Config {
+// SetupFIPSTLS returns the FIPS TLS profile for servers which can't follow the KubeVirt TLS configuration
+func SetupFIPSTLS() *tls.Config {
+	tlsConfig := fipsTLSConfiguration(&v1.TLSConfiguration{FIPSMode: true})
+	return &tls.Config{
+		CipherSuites:     CipherSuiteIds(tlsConfig.Ciphers),
+		MinVersion:       TLSVersion(tlsConfig.MinTLSVersion),
+		MaxVersion:       MaxTLSVersion(tlsConfig),
+		CurvePreferences: CurvePreferences(tlsConfig),
+	}
+}
+
+func SetupTLSForVirtHandlerClients(caManager ClientCAManager, certManager certificate.Manager, externallyManaged bool, clusterConfig *virtconfig.ClusterConfig) *tls.Config {
 	// #nosec cause: InsecureSkipVerify: true
 	// resolution: Neither the client nor the server should validate anything itself, `VerifyPeerCertificate` is still executed
 	return &tls.Config{
@@ -212,6 +246,10 @@ func SetupTLSForVirtHandlerClients(caManager ClientCAManager, certManager certif
 			}
 			return verifyPeerCert(rawCerts, externallyManaged, certPool, x509.ExtKeyUsageServerAuth, "node")
 		},
+		// The client side can't pick its settings per connection, the negotiated ones are checked instead
+		VerifyConnection: func(state tls.ConnectionState) error {
+			return verifyConnectionFIPSCompliance(state, getTLSConfiguration(clusterConfig.GetConfigFromKubeVirtCR()))
+		},
 	}
 }
 
@@ -224,9 +262,124 @@ func getTLSConfiguration(kubevirt *v1.KubeVirt) *v1.TLSConfiguration {
 	if kubevirt != nil && kubevirt.Spec.Configuration.TLSConfiguration != nil {
 		tlsConfiguration = kubevirt.Spec.Configuration.TLSConfiguration
 	}
+	if tlsConfiguration.FIPSMode {
+		return fipsTLSConfiguration(tlsConfiguration)
+	}
 	return tlsConfiguration
 }
 
+// fipsTLSConfiguration restricts a TLS configuration to the FIPS 140 approved protocol versions and cipher suites.
+// Non-compliant settings are rejected by the KubeVirt webhook, they are dropped here nevertheless.
+// TLS 1.3 is only approved with a FIPS 140 validated module, see MaxTLSVersion.
+func fipsTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) *v1.TLSConfiguration {
+	fipsConfiguration := &v1.TLSConfiguration{
+		MinTLSVersion: v1.VersionTLS12,
+		FIPSMode:      true,
+	}
+	if tlsConfiguration.MinTLSVersion == v1.VersionTLS13 && FIPSModuleEnabled() {
+		fipsConfiguration.MinTLSVersion = v1.VersionTLS13
+		return fipsConfiguration
+	}
+	for _, cipher := range tlsConfiguration.Ciphers {
+		if IsFIPSCipherSuite(cipher) {
+			fipsConfiguration.Ciphers = append(fipsConfiguration.Ciphers, cipher)
+		}
+	}
+	if len(fipsConfiguration.Ciphers) == 0 {
+		fipsConfiguration.Ciphers = FIPSCipherSuiteNames()
+	}
+	return fipsConfiguration
+}
+
+// FIPSCipherSuiteNames returns the names of the TLS 1.2 cipher suites approved by FIPS 140
+func FIPSCipherSuiteNames() []string {
+	names := make([]string, 0, len(fipsCipherSuites))
+	for _, id := range fipsCipherSuites {
+		names = append(names, tls.CipherSuiteName(id))
+	}
+	return names
+}
+
+// IsFIPSCipherSuite reports whether the named cipher suite is approved by FIPS 140
+func IsFIPSCipherSuite(name string) bool {
+	for _, id := range fipsCipherSuites {
+		if tls.CipherSuiteName(id) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFIPSTLSVersion reports whether the TLS version is approved by FIPS 140, an empty version defaults to VersionTLS12.
+// VersionTLS13 is only approved with a FIPS 140 validated module.
+func IsFIPSTLSVersion(version v1.TLSProtocolVersion) bool {
+	return version == "" || version == v1.VersionTLS12 || (version == v1.VersionTLS13 && FIPSModuleEnabled())
+}
+
+func isFIPSTLS13CipherSuite(id uint16) bool {
+	for _, fipsID := range fipsTLS13CipherSuites {
+		if fipsID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxTLSVersion returns the maximum TLS version allowed by the TLS configuration, 0 leaves the choice to crypto/tls.
+// The TLS 1.3 cipher suites are not configurable, so the FIPS mode caps the version at TLS 1.2 unless a FIPS 140
+// validated module restricts them.
+func MaxTLSVersion(tlsConfiguration *v1.TLSConfiguration) uint16 {
+	if !tlsConfiguration.FIPSMode || FIPSModuleEnabled() {
+		return 0
+	}
+	return tls.VersionTLS12
+}
+
+// CurvePreferences returns the key exchange curves allowed by the TLS configuration, nil leaves the choice to crypto/tls
+func CurvePreferences(tlsConfiguration *v1.TLSConfiguration) []tls.CurveID {
+	if !tlsConfiguration.FIPSMode {
+		return nil
+	}
+	return fipsCurves
+}
+
+// FIPSStatus reports the TLS profile the KubeVirt components enforce when the FIPS mode is enabled and whether
+// their cryptography is provided by a FIPS 140 validated module. Only both together make them FIPS compliant.
+func FIPSStatus(kubevirt *v1.KubeVirt) *v1.KubeVirtFIPSStatus {
+	tlsConfiguration := getTLSConfiguration(kubevirt)
+	if !tlsConfiguration.FIPSMode {
+		return &v1.KubeVirtFIPSStatus{Enabled: false, ValidatedModule: FIPSModuleEnabled()}
+	}
+	return &v1.KubeVirtFIPSStatus{
+		Enabled:         true,
+		ValidatedModule: FIPSModuleEnabled(),
+		MinTLSVersion:   tlsConfiguration.MinTLSVersion,
+		Ciphers:         tlsConfiguration.Ciphers,
+	}
+}
+
+func verifyConnectionFIPSCompliance(state tls.ConnectionState, tlsConfiguration *v1.TLSConfiguration) error {
+	if !tlsConfiguration.FIPSMode {
+		return nil
+	}
+	if state.Version < TLSVersion(tlsConfiguration.MinTLSVersion) {
+		return fmt.Errorf("TLS version %s is not allowed in FIPS mode", TLSVersionName(state.Version))
+	}
+	if state.Version >= tls.VersionTLS13 {
+		if !FIPSModuleEnabled() {
+			return fmt.Errorf("TLS version %s is not allowed in FIPS mode without a FIPS 140 validated module", TLSVersionName(state.Version))
+		}
+		if !isFIPSTLS13CipherSuite(state.CipherSuite) {
+			return fmt.Errorf("cipher suite %s is not allowed in FIPS mode", tls.CipherSuiteName(state.CipherSuite))
+		}
+		return nil
+	}
+	if !IsFIPSCipherSuite(tls.CipherSuiteName(state.CipherSuite)) {
+		return fmt.Errorf("cipher suite %s is not allowed in FIPS mode", tls.CipherSuiteName(state.CipherSuite))
+	}
+	return nil
+}
+
 func CipherSuiteIds(names []string) []uint16 {
 	var idByName = CipherSuiteNameMap()
 	var ids []uint16
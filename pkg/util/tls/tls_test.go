@@ -113,7 +113,7 @@ var _ = Describe("TLS", func() {
 
 	DescribeTable("on virt-handler with self-signed CA should", func(serverSecret, clientSecret string, errStr string) {
 		serverTLSConfig := kvtls.SetupTLSForVirtHandlerServer(caManager, certmanagers[serverSecret], false, clusterConfig)
-		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], false)
+		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], false, clusterConfig)
 		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			fmt.Fprintln(w, "hello")
 		}))
@@ -156,7 +156,7 @@ var _ = Describe("TLS", func() {
 
 	DescribeTable("on virt-handler with externally-managed certificates should", func(serverSecret, clientSecret string, errStr string) {
 		serverTLSConfig := kvtls.SetupTLSForVirtHandlerServer(caManager, certmanagers[serverSecret], true, clusterConfig)
-		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], true)
+		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], true, clusterConfig)
 		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			fmt.Fprintln(w, "hello")
 		}))
@@ -222,7 +222,7 @@ var _ = Describe("TLS", func() {
 
 	DescribeTable("should verify self-signed client and server certificates", func(serverSecret, clientSecret string, errStr string) {
 		serverTLSConfig := kvtls.SetupTLSWithCertManager(caManager, certmanagers[serverSecret], tls.RequireAndVerifyClientCert, clusterConfig)
-		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], false)
+		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], false, clusterConfig)
 		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			fmt.Fprintln(w, "hello")
 		}))
@@ -265,7 +265,7 @@ var _ = Describe("TLS", func() {
 
 	DescribeTable("should verify externally-managed client and server certificates", func(serverSecret, clientSecret string, errStr string) {
 		serverTLSConfig := kvtls.SetupTLSWithCertManager(caManager, certmanagers[serverSecret], tls.RequireAndVerifyClientCert, clusterConfig)
-		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], true)
+		clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[clientSecret], true, clusterConfig)
 		srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			fmt.Fprintln(w, "hello")
 		}))
@@ -343,7 +343,7 @@ var _ = Describe("TLS", func() {
 			},
 
 			func() *tls.Config {
-				return kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[components.VirtHandlerCertSecretName], false)
+				return kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[components.VirtHandlerCertSecretName], false, clusterConfig)
 			},
 		),
 		Entry("on prometheus endpoint",
@@ -363,4 +363,179 @@ var _ = Describe("TLS", func() {
 			},
 		),
 	)
+
+	Context("with FIPS mode", func() {
+		BeforeEach(func() {
+			kvConfig := clusterConfig.GetConfigFromKubeVirtCR().DeepCopy()
+			kvConfig.Spec.Configuration.TLSConfiguration = &v12.TLSConfiguration{FIPSMode: true}
+			testutils.UpdateFakeKubeVirtClusterConfig(kubeVirtStore, kvConfig)
+		})
+
+		DescribeTable("should only accept FIPS approved cipher suites", func(serverTLSConfigFunc, clientTLSConfigFunc configFunc) {
+			srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, "hello")
+			}))
+			srv.TLS = serverTLSConfigFunc()
+			srv.StartTLS()
+			defer srv.Close()
+
+			clientTLSConfig := clientTLSConfigFunc()
+			clientTLSConfig.MaxVersion = tls.VersionTLS12
+			clientTLSConfig.CipherSuites = []uint16{tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384}
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+			resp, err := client.Get(srv.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.TLS.CipherSuite).To(BeElementOf(tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384, tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384))
+
+			clientTLSConfig = clientTLSConfigFunc()
+			clientTLSConfig.MaxVersion = tls.VersionTLS12
+			clientTLSConfig.CipherSuites = []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256, tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256}
+			client = &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+			_, err = client.Get(srv.URL)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("remote error: tls: handshake failure"))
+		},
+			Entry("on virt-handler",
+				func() *tls.Config {
+					return kvtls.SetupTLSForVirtHandlerServer(caManager, certmanagers[components.VirtHandlerServerCertSecretName], false, clusterConfig)
+				},
+				func() *tls.Config {
+					return kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[components.VirtHandlerCertSecretName], false, clusterConfig)
+				},
+			),
+			Entry("on prometheus endpoint",
+				func() *tls.Config {
+					return kvtls.SetupPromTLS(certmanagers[components.VirtHandlerServerCertSecretName], clusterConfig)
+				},
+				func() *tls.Config {
+					return &tls.Config{InsecureSkipVerify: true}
+				},
+			),
+			Entry("on exportproxy endpoint",
+				func() *tls.Config {
+					return kvtls.SetupExportProxyTLS(certmanagers[components.VirtHandlerServerCertSecretName], kubeVirtStore)
+				},
+				func() *tls.Config {
+					return &tls.Config{InsecureSkipVerify: true}
+				},
+			),
+			Entry("on export server",
+				func() *tls.Config {
+					serverTLSConfig := kvtls.SetupFIPSTLS()
+					serverTLSConfig.Certificates = []tls.Certificate{*certmanagers[components.VirtHandlerServerCertSecretName].Current()}
+					return serverTLSConfig
+				},
+				func() *tls.Config {
+					return &tls.Config{InsecureSkipVerify: true}
+				},
+			),
+		)
+
+		It("virt-handler clients should refuse cipher suites which are not FIPS approved", func() {
+			serverTLSConfig := kvtls.SetupTLSForVirtHandlerServer(caManager, certmanagers[components.VirtHandlerServerCertSecretName], false, clusterConfig)
+			getConfigForClient := serverTLSConfig.GetConfigForClient
+			serverTLSConfig.GetConfigForClient = func(info *tls.ClientHelloInfo) (*tls.Config, error) {
+				config, err := getConfigForClient(info)
+				if err == nil {
+					config.CipherSuites = []uint16{tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256, tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256}
+				}
+				return config, err
+			}
+			srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprintln(w, "hello")
+			}))
+			srv.TLS = serverTLSConfig
+			srv.StartTLS()
+			defer srv.Close()
+
+			clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[components.VirtHandlerCertSecretName], false, clusterConfig)
+			clientTLSConfig.MaxVersion = tls.VersionTLS12
+			client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+			_, err := client.Get(srv.URL)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("is not allowed in FIPS mode"))
+		})
+
+		It("should report the enforced FIPS profile", func() {
+			Expect(kvtls.FIPSStatus(clusterConfig.GetConfigFromKubeVirtCR())).To(Equal(&v12.KubeVirtFIPSStatus{
+				Enabled:         true,
+				ValidatedModule: kvtls.FIPSModuleEnabled(),
+				MinTLSVersion:   v12.VersionTLS12,
+				Ciphers:         kvtls.FIPSCipherSuiteNames(),
+			}))
+		})
+
+		Context("without a FIPS 140 validated module", func() {
+			BeforeEach(func() {
+				if kvtls.FIPSModuleEnabled() {
+					Skip("the FIPS 140 validated module is active")
+				}
+			})
+
+			It("should not accept TLS 1.3", func() {
+				srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					fmt.Fprintln(w, "hello")
+				}))
+				srv.TLS = kvtls.SetupTLSForVirtHandlerServer(caManager, certmanagers[components.VirtHandlerServerCertSecretName], false, clusterConfig)
+				srv.StartTLS()
+				defer srv.Close()
+
+				client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: true,
+					MinVersion:         tls.VersionTLS13,
+				}}}
+				_, err := client.Get(srv.URL)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("protocol version not supported"))
+			})
+
+			It("should fall back to TLS 1.2 if TLS 1.3 is the minimum version", func() {
+				kvConfig := clusterConfig.GetConfigFromKubeVirtCR().DeepCopy()
+				kvConfig.Spec.Configuration.TLSConfiguration = &v12.TLSConfiguration{FIPSMode: true, MinTLSVersion: v12.VersionTLS13}
+				testutils.UpdateFakeKubeVirtClusterConfig(kubeVirtStore, kvConfig)
+
+				srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					fmt.Fprintln(w, "hello")
+				}))
+				srv.TLS = kvtls.SetupPromTLS(certmanagers[components.VirtHandlerServerCertSecretName], clusterConfig)
+				srv.StartTLS()
+				defer srv.Close()
+
+				client := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}}
+				resp, err := client.Get(srv.URL)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.TLS.Version).To(Equal(uint16(tls.VersionTLS12)))
+				Expect(kvtls.FIPSStatus(clusterConfig.GetConfigFromKubeVirtCR())).To(Equal(&v12.KubeVirtFIPSStatus{
+					Enabled:         true,
+					ValidatedModule: false,
+					MinTLSVersion:   v12.VersionTLS12,
+					Ciphers:         kvtls.FIPSCipherSuiteNames(),
+				}))
+			})
+
+			It("virt-handler clients should refuse TLS 1.3", func() {
+				serverTLSConfig := kvtls.SetupTLSForVirtHandlerServer(caManager, certmanagers[components.VirtHandlerServerCertSecretName], false, clusterConfig)
+				getConfigForClient := serverTLSConfig.GetConfigForClient
+				serverTLSConfig.GetConfigForClient = func(info *tls.ClientHelloInfo) (*tls.Config, error) {
+					config, err := getConfigForClient(info)
+					if err == nil {
+						config.MaxVersion = tls.VersionTLS13
+					}
+					return config, err
+				}
+				srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					fmt.Fprintln(w, "hello")
+				}))
+				srv.TLS = serverTLSConfig
+				srv.StartTLS()
+				defer srv.Close()
+
+				clientTLSConfig := kvtls.SetupTLSForVirtHandlerClients(caManager, certmanagers[components.VirtHandlerCertSecretName], false, clusterConfig)
+				client := &http.Client{Transport: &http.Transport{TLSClientConfig: clientTLSConfig}}
+				_, err := client.Get(srv.URL)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("TLS version VersionTLS13 is not allowed in FIPS mode without a FIPS 140 validated module"))
+			})
+		})
+	})
 })
@@ -37,6 +37,9 @@ const (
 	memoryDumpOverhead = 100 * 1024 * 1024
 
 	UnprivilegedContainerSELinuxLabel = "system_u:object_r:container_file_t:s0"
+
+	// ToolsDiskName is the name of the disk and volume the tools disk of the KubeVirt configuration is attached with
+	ToolsDiskName = "kubevirt-tools"
 )
 
 func IsNonRootVMI(vmi *v1.VirtualMachineInstance) bool {
@@ -200,6 +203,33 @@ func HasKernelBootContainerImage(vmi *v1.VirtualMachineInstance) bool {
 	return true
 }
 
+// HasFirmwareImage returns true if the VMI was pinned to a firmware image on creation
+func HasFirmwareImage(vmi *v1.VirtualMachineInstance) bool {
+	return vmi != nil && vmi.Status.FirmwareImageStatus != nil
+}
+
+// Checks if kernel boot artifacts are provided by a volume of the VMI
+func HasKernelBootVolume(vmi *v1.VirtualMachineInstance) bool {
+	if vmi == nil {
+		return false
+	}
+
+	vmiFirmware := vmi.Spec.Domain.Firmware
+	if (vmiFirmware == nil) || (vmiFirmware.KernelBoot == nil) || (vmiFirmware.KernelBoot.Volume == nil) {
+		return false
+	}
+
+	return true
+}
+
+// ShutdownSignalIntervalSeconds returns the time to wait between two graceful shutdown signals
+func ShutdownSignalIntervalSeconds(vmi *v1.VirtualMachineInstance) int64 {
+	if vmi.Spec.ShutdownPolicy != nil && vmi.Spec.ShutdownPolicy.ACPIRetryIntervalSeconds != nil {
+		return int64(*vmi.Spec.ShutdownPolicy.ACPIRetryIntervalSeconds)
+	}
+	return v1.DefaultACPIRetryIntervalSeconds
+}
+
 func HasHugePages(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.Memory != nil && vmi.Spec.Domain.Memory.Hugepages != nil
 }
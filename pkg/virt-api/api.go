@@ -95,6 +95,7 @@ const (
 	httpStatusNotFoundMessage     = "Not Found"
 	httpStatusBadRequestMessage   = "Bad Request"
 	httpStatusInternalServerError = "Internal Server Error"
+	httpStatusTooManyRequests     = "Too Many Requests"
 )
 
 type VirtApi interface {
@@ -132,6 +133,10 @@ type virtAPIApp struct {
 	externallyManaged            bool
 	reloadableRateLimiter        *ratelimiter.ReloadableRateLimiter
 	reloadableWebhookRateLimiter *ratelimiter.ReloadableRateLimiter
+	streamDrainer                *filter.StreamDrainer
+	nodeInformer                 cache.SharedIndexInformer
+	podInformer                  cache.SharedIndexInformer
+	migrationInformer            cache.SharedIndexInformer
 
 	// indicates if controllers were started with or without CDI/DataSource support
 	hasCDIDataSource bool
@@ -226,16 +231,20 @@ func (app *virtAPIApp) composeSubresources() {
 
 	var subwss []*restful.WebService
 
+	subresourceRateLimiter := rest.NewSubresourceRateLimiter(app.clusterConfig, app.authorizor)
+	app.streamDrainer = filter.NewStreamDrainer()
+
 	for _, version := range v1.SubresourceGroupVersions {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
 		expandvmspecGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "expand-vm-spec"}
+		capacityforecastGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "capacity-forecast"}
 
 		subws := new(restful.WebService)
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
 		subws.Path(definitions.GroupVersionBasePath(version))
 
-		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig, subresourceRateLimiter, app.nodeInformer, app.podInformer, app.migrationInformer)
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
@@ -293,6 +302,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Doc("Get VirtualMachine object with expanded instancetype and preference.").
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusTooManyRequests, httpStatusTooManyRequests, "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("freeze")).
@@ -343,24 +353,30 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
-		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("console")).
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("console")).
 			To(subresourceApp.ConsoleRequestHandler).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
-			Operation(version.Version + "Console").
-			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance."))
+			Operation(version.Version+"Console").
+			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance.").
+			Returns(http.StatusTooManyRequests, httpStatusTooManyRequests, ""))
 
-		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc")).
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("vnc")).
 			To(subresourceApp.VNCRequestHandler).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
-			Operation(version.Version + "VNC").
-			Doc("Open a websocket connection to connect to VNC on the specified VirtualMachineInstance."))
-		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc/screenshot")).
+			Operation(version.Version+"VNC").
+			Doc("Open a websocket connection to connect to VNC on the specified VirtualMachineInstance.").
+			Returns(http.StatusTooManyRequests, httpStatusTooManyRequests, ""))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("vnc/screenshot")).
 			To(subresourceApp.VNCScreenshotRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.MoveCursorParam(subws)).
-			Operation(version.Version + "VNCScreenshot").
-			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance."))
+			Operation(version.Version+"VNCScreenshot").
+			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance.").
+			Returns(http.StatusTooManyRequests, httpStatusTooManyRequests, ""))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("usbredir")).
 			To(subresourceApp.USBRedirRequestHandler).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).
 			Param(definitions.NameParam(subws)).
 			Operation(version.Version + "usbredir").
@@ -369,12 +385,14 @@ func (app *virtAPIApp) composeSubresources() {
 		// VMI endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vmi-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstance)).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Param(definitions.PortForwardProtocolParameter(subws)).
@@ -382,6 +400,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Doc("Open a websocket connection forwarding traffic of the specified protocol (either tcp or udp) to the specified VirtualMachineInstance and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vsock")).
 			To(subresourceApp.VSOCKRequestHandler).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.VSOCKPortParameter(subws)).Param(definitions.VSOCKTLSParameter(subws)).
 			Operation(version.Version + "VSOCK").
 			Doc("Open a websocket connection forwarding traffic to the specified VirtualMachineInstance and port via VSOCK."))
@@ -389,12 +408,14 @@ func (app *virtAPIApp) composeSubresources() {
 		// VM endpoint
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Operation(version.Version + "vm-PortForward").
 			Doc("Open a websocket connection forwarding traffic to the running VMI for the specified VirtualMachine and port."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR) + definitions.SubResourcePath("portforward") + definitions.PortPath + definitions.ProtocolPath).
 			To(subresourceApp.PortForwardRequestHandler(subresourceApp.FetchVirtualMachineInstanceForVM)).
+			Filter(app.streamDrainer.Filter).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Param(definitions.PortForwardPortParameter(subws)).
 			Param(definitions.PortForwardProtocolParameter(subws)).
@@ -404,12 +425,29 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Route(subws.PUT(definitions.NamespacedResourceBasePath(expandvmspecGVR)).
 			To(subresourceApp.ExpandSpecRequestHandler).
 			Param(definitions.NamespaceParam(subws)).
+			Param(definitions.MutationPreviewParam(subws)).
 			Operation(version.Version+"ExpandSpec").
 			Consumes(restful.MIME_JSON).
 			Produces(restful.MIME_JSON).
 			Doc("Expands instancetype and preference into the passed VirtualMachine object.").
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusTooManyRequests, httpStatusTooManyRequests, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourceBasePath(capacityforecastGVR)).
+			To(subresourceApp.CapacityForecastRequestHandler).
+			Reads(v1.CapacityForecastOptions{}).
+			Param(definitions.NamespaceParam(subws)).
+			Operation(version.Version+"CapacityForecast").
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Doc("Forecasts whether the cluster can place the passed VirtualMachines or replicas of an instancetype.").
+			Writes(v1.CapacityForecast{}).
+			Returns(http.StatusOK, "OK", v1.CapacityForecast{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusTooManyRequests, httpStatusTooManyRequests, "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
 		subws.Route(subws.GET(definitions.SubResourcePath("version")).Produces(restful.MIME_JSON).
@@ -429,6 +467,14 @@ func (app *virtAPIApp) composeSubresources() {
 			To(subresourceApp.DumpClusterProfilerHandler).
 			Operation(version.Version + "dump-cluster-profiler"))
 
+		subws.Route(subws.GET(definitions.SubResourcePath("health")).Produces(restful.MIME_JSON).
+			To(subresourceApp.KubeVirtHealthHandler(app.namespace)).
+			Operation(version.Version+"KubeVirtHealth").
+			Doc("Get the aggregated health and readiness of the KubeVirt install.").
+			Writes(v1.KubeVirtHealth{}).
+			Returns(http.StatusOK, "OK", v1.KubeVirtHealth{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.GET(definitions.SubResourcePath("guestfs")).Produces(restful.MIME_JSON).
 			To(app.GetGsInfo()).
 			Operation(version.Version+"Guestfs").
@@ -520,6 +566,7 @@ func (app *virtAPIApp) composeSubresources() {
 			Operation(version.Version+"MemoryDump").
 			Doc("Dumps a VirtualMachineInstance memory.").
 			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusTooManyRequests, httpStatusTooManyRequests, "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("removememorydump")).
@@ -589,6 +636,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "expand-vm-spec",
 						Namespaced: true,
 					},
+					{
+						Name:       "capacity-forecast",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/vnc",
 						Namespaced: true,
@@ -959,7 +1010,7 @@ func (app *virtAPIApp) setupTLS(k8sCAManager kvtls.ClientCAManager, kubevirtCAMa
 	// if the TLS handshake requests it. As a result, the TLS handshake fails
 	// and our aggregated endpoint never becomes available.
 	app.tlsConfig = kvtls.SetupTLSWithCertManager(k8sCAManager, app.certmanager, tls.VerifyClientCertIfGiven, app.clusterConfig)
-	app.handlerTLSConfiguration = kvtls.SetupTLSForVirtHandlerClients(kubevirtCAManager, app.handlerCertManager, app.externallyManaged)
+	app.handlerTLSConfiguration = kvtls.SetupTLSForVirtHandlerClients(kubevirtCAManager, app.handlerCertManager, app.externallyManaged, app.clusterConfig)
 }
 
 func (app *virtAPIApp) startTLS(informerFactory controller.KubeInformerFactory) error {
@@ -1013,6 +1064,15 @@ func (app *virtAPIApp) startTLS(informerFactory controller.KubeInformerFactory)
 		// procedure
 		time.Sleep(5 * time.Second)
 
+		// refuse new console, VNC and port-forward streams and give the open ones
+		// the configured time to finish, server.Shutdown() does not track them
+		if drainTimeout := app.clusterConfig.GetStreamDrainTimeout(); drainTimeout > 0 {
+			log.Log.Infof("Draining %d open streams for up to %s", app.streamDrainer.OpenStreams(), drainTimeout)
+			if open := app.streamDrainer.Drain(drainTimeout); open > 0 {
+				log.Log.Infof("Closing %d streams which are still open", open)
+			}
+		}
+
 		// by default, server.Shutdown() waits indefinitely for all existing
 		// connections to close. We need to give this a timeout to ensure the
 		// shutdown will eventually complete.
@@ -1071,6 +1131,10 @@ func (app *virtAPIApp) Run() {
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	// Used by the capacity forecasts and the health subresource
+	app.nodeInformer = kubeInformerFactory.KubeVirtNode()
+	app.podInformer = kubeInformerFactory.ScheduledPod()
+	app.migrationInformer = kubeInformerFactory.VirtualMachineInstanceMigration()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
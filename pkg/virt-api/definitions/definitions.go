@@ -565,9 +565,10 @@ func addPatchParams(ws *restful.WebService, builder *restful.RouteBuilder) *rest
 }
 
 const (
-	NamespaceParamName  = "namespace"
-	NameParamName       = "name"
-	MoveCursorParamName = "moveCursor"
+	NamespaceParamName       = "namespace"
+	NameParamName            = "name"
+	MoveCursorParamName      = "moveCursor"
+	MutationPreviewParamName = "mutationPreview"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -582,6 +583,10 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func MutationPreviewParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(MutationPreviewParamName, "Return the mutations applied to the VirtualMachine on admission as a JSON patch instead of the expanded VirtualMachine").DataType("boolean").DefaultValue("false")
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
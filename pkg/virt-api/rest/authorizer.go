@@ -59,10 +59,12 @@ var noAuthEndpoints = map[string]struct{}{
 	"/apis/subresources.kubevirt.io/v1/version":       {},
 	"/apis/subresources.kubevirt.io/v1/guestfs":       {},
 	"/apis/subresources.kubevirt.io/v1/healthz":       {},
+	"/apis/subresources.kubevirt.io/v1/health":        {},
 	"/apis/subresources.kubevirt.io/v1alpha3":         {},
 	"/apis/subresources.kubevirt.io/v1alpha3/version": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/guestfs": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/healthz": {},
+	"/apis/subresources.kubevirt.io/v1alpha3/health":  {},
 	// the profiler endpoints are blocked by a feature gate
 	// to restrict the usage to development environments
 	"/start-profiler": {},
@@ -238,7 +240,7 @@ func addNamespacedResourceBaseAttributes(pathSplit []string, requestMethod strin
 	namespace := pathSplit[5]
 	resource := pathSplit[6]
 
-	if resource != "expand-vm-spec" {
+	if resource != "expand-vm-spec" && resource != "capacity-forecast" {
 		return fmt.Errorf("unknown resource type %s", resource)
 	}
 
@@ -184,6 +184,20 @@ var _ = Describe("Authorizer", func() {
 					Expect(result).To(BeTrue())
 				})
 
+				It("should check access to the capacity forecast", func() {
+					req.Request.URL.Path = "/apis/subresources.kubevirt.io/v1alpha3/namespaces/default/capacity-forecast"
+					allowedFn = func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+						Expect(sar.Spec.ResourceAttributes).ToNot(BeNil())
+						Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("update"))
+						Expect(sar.Spec.ResourceAttributes.Resource).To(Equal("capacity-forecast"))
+						sar.Status.Allowed = true
+						return sar, nil
+					}
+					result, _, err := app.Authorize(req)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeTrue())
+				})
+
 			})
 
 			DescribeTable("should allow all users for info endpoints", func(path string) {
@@ -206,6 +220,7 @@ var _ = Describe("Authorizer", func() {
 				Entry("subresource v1 version", "/apis/subresources.kubevirt.io/v1/version"),
 				Entry("subresource v1 guestfs", "/apis/subresources.kubevirt.io/v1/guestfs"),
 				Entry("subresource v1 healthz", "/apis/subresources.kubevirt.io/v1/healthz"),
+				Entry("subresource v1 health", "/apis/subresources.kubevirt.io/v1/health"),
 				Entry("subresource v1 start profiler", "/apis/subresources.kubevirt.io/v1/start-cluster-profiler"),
 				Entry("subresource v1 stop profiler", "/apis/subresources.kubevirt.io/v1/stop-cluster-profiler"),
 				Entry("subresource v1 dump profiler", "/apis/subresources.kubevirt.io/v1/dump-cluster-profiler"),
@@ -214,6 +229,7 @@ var _ = Describe("Authorizer", func() {
 				Entry("subresource v1alpha3 version", "/apis/subresources.kubevirt.io/v1alpha3/version"),
 				Entry("subresource v1alpha3 guestfs", "/apis/subresources.kubevirt.io/v1alpha3/guestfs"),
 				Entry("subresource v1alpha3 healthz", "/apis/subresources.kubevirt.io/v1alpha3/healthz"),
+				Entry("subresource v1alpha3 health", "/apis/subresources.kubevirt.io/v1alpha3/health"),
 				Entry("subresource v1alpha3 start profiler", "/apis/subresources.kubevirt.io/v1alpha3/start-cluster-profiler"),
 				Entry("subresource v1alpha3 stop profiler", "/apis/subresources.kubevirt.io/v1alpha3/stop-cluster-profiler"),
 				Entry("subresource v1alpha3 dump profiler", "/apis/subresources.kubevirt.io/v1alpha3/dump-cluster-profiler"),
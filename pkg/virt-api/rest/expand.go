@@ -13,11 +13,17 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/mutating-webhook/mutators"
 )
 
 func (app *SubresourceAPIApp) ExpandSpecRequestHandler(request *restful.Request, response *restful.Response) {
+	if app.rateLimited(request, response) {
+		return
+	}
+
 	if request.Request.Body == nil {
 		writeError(errors.NewBadRequest("empty request body"), response)
 		return
@@ -63,12 +69,21 @@ func (app *SubresourceAPIApp) ExpandSpecRequestHandler(request *restful.Request,
 	}
 	vm.Namespace = request.PathParameter("namespace")
 
+	if request.QueryParameter(definitions.MutationPreviewParamName) == "true" {
+		app.mutationPreviewResponse(vm, response)
+		return
+	}
+
 	expandSpecResponse(vm, app.instancetypeMethods, func(err error) *errors.StatusError {
 		return errors.NewBadRequest(err.Error())
 	}, response)
 }
 
 func (app *SubresourceAPIApp) ExpandSpecVMRequestHandler(request *restful.Request, response *restful.Response) {
+	if app.rateLimited(request, response) {
+		return
+	}
+
 	name := request.PathParameter("name")
 	namespace := request.PathParameter("namespace")
 
@@ -117,6 +132,33 @@ func expandSpecResponse(vm *v1.VirtualMachine, instancetypeMethods instancetype.
 	}
 }
 
+// mutationPreviewResponse writes the mutations the VM mutating webhook applies to the VM as a JSON patch
+func (app *SubresourceAPIApp) mutationPreviewResponse(vm *v1.VirtualMachine, response *restful.Response) {
+	mutator := &mutators.VMsMutator{
+		ClusterConfig:       app.clusterConfig,
+		InstancetypeMethods: app.instancetypeMethods,
+	}
+
+	mutated := vm.DeepCopy()
+	if err := mutator.ApplyDefaults(mutated); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	mutations, err := patch.Diff(vm, mutated)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if mutations == nil {
+		mutations = []patch.PatchOperation{}
+	}
+
+	if err := response.WriteEntity(mutations); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
 func writeValidationErrors(validationErrors []error, response *restful.Response) {
 	causes := make([]metav1.StatusCause, 0, len(validationErrors))
 	for _, err := range validationErrors {
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 
 	"github.com/emicklei/go-restful/v3"
@@ -27,6 +28,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
 var _ = Describe("Instancetype expansion subresources", func() {
@@ -57,10 +59,10 @@ var _ = Describe("Instancetype expansion subresources", func() {
 
 		instancetypeMethods = testutils.NewMockInstancetypeMethods()
 
-		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil, nil, nil, nil, nil)
 		app.instancetypeMethods = instancetypeMethods
 
-		request = restful.NewRequest(&http.Request{})
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
 		recorder = httptest.NewRecorder()
 		response = restful.NewResponse(recorder)
 		response.SetRequestAccepts(restful.MIME_JSON)
@@ -291,5 +293,57 @@ var _ = Describe("Instancetype expansion subresources", func() {
 			errMsg := fmt.Sprintf("VM namespace must be empty or %s", vmNamespace)
 			Expect(statusErr.Status().Message).To(Equal(errMsg))
 		})
+
+		Context("with mutationPreview", func() {
+			BeforeEach(func() {
+				app.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+				request.Request.URL.RawQuery = definitions.MutationPreviewParamName + "=true"
+			})
+
+			It("should return the defaults applied to the VM as a JSON patch", func() {
+				vm.Spec.Template.Spec.Architecture = "amd64"
+
+				recorder := callExpandSpecApi(vm)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(MatchJSON(`[
+					{"op": "add", "path": "/spec/template/spec/domain/machine", "value": {"type": "q35"}}
+				]`))
+			})
+
+			It("should return the preferred machine type applied to the VM", func() {
+				instancetypeMethods.FindPreferenceSpecFunc = func(_ *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachinePreferenceSpec, error) {
+					return &instancetypev1beta1.VirtualMachinePreferenceSpec{
+						Machine: &instancetypev1beta1.MachinePreferences{PreferredMachineType: "pc-q35-rhel9.2.0"},
+					}, nil
+				}
+				vm.Spec.Preference = &v1.PreferenceMatcher{Name: "test-preference", Kind: "virtualmachinepreference"}
+				vm.Spec.Template.Spec.Architecture = "amd64"
+
+				recorder := callExpandSpecApi(vm)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(MatchJSON(`[
+					{"op": "add", "path": "/spec/template/spec/domain/machine", "value": {"type": "pc-q35-rhel9.2.0"}}
+				]`))
+			})
+
+			It("should return an empty JSON patch if no mutation applies", func() {
+				vm.Spec.Template.Spec.Architecture = "amd64"
+				vm.Spec.Template.Spec.Domain.Machine = &v1.Machine{Type: "q35"}
+
+				recorder := callExpandSpecApi(vm)
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(MatchJSON(`[]`))
+			})
+
+			It("should fail if a default can't be inferred", func() {
+				instancetypeMethods.InferDefaultInstancetypeFunc = func(_ *v1.VirtualMachine) error {
+					return fmt.Errorf("unable to infer instancetype")
+				}
+
+				recorder := callExpandSpecApi(vm)
+				statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+				Expect(statusErr.Status().Message).To(ContainSubstring("unable to infer instancetype"))
+			})
+		})
 	})
 })
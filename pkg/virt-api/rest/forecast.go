@@ -0,0 +1,414 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+const maxCapacityForecastReplicas = 1000
+
+// capacityForecastVM is a VirtualMachine to place, with the resources its virt-launcher pod requests
+type capacityForecastVM struct {
+	name     string
+	vmi      *v1.VirtualMachineInstance
+	requests k8sv1.ResourceList
+}
+
+// capacityForecastNode tracks the capacity of a node left for the VirtualMachines to place
+type capacityForecastNode struct {
+	node   *k8sv1.Node
+	free   k8sv1.ResourceList
+	placed []string
+}
+
+// CapacityForecastRequestHandler simulates placing the requested VirtualMachines onto the schedulable nodes.
+// Like the scheduler it respects the requests of the virt-launcher pods, including hugepages, devices and
+// the memory overhead, node selectors and taints, but it doesn't simulate node or pod affinities.
+// The forecast reveals the nodes with their taints and capacity, so no aggregated role grants access to it.
+func (app *SubresourceAPIApp) CapacityForecastRequestHandler(request *restful.Request, response *restful.Response) {
+	if app.rateLimited(request, response) {
+		return
+	}
+
+	namespace := request.PathParameter("namespace")
+
+	opts := &v1.CapacityForecastOptions{}
+	if request.Request.Body != nil {
+		err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+		switch err {
+		case io.EOF, nil:
+			break
+		default:
+			writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+			return
+		}
+	}
+	if err := validateCapacityForecastOptions(opts); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	vms, statusErr := app.capacityForecastVMs(namespace, opts)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	nodes, err := app.capacityForecastNodes()
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	if err := response.WriteEntity(forecastCapacity(vms, nodes)); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func validateCapacityForecastOptions(opts *v1.CapacityForecastOptions) error {
+	if len(opts.VirtualMachines) > 0 && opts.Instancetype != nil {
+		return fmt.Errorf("either virtualMachines or instancetype can be set")
+	}
+	if len(opts.VirtualMachines) == 0 && opts.Instancetype == nil {
+		return fmt.Errorf("either virtualMachines or instancetype has to be set")
+	}
+	if opts.Instancetype == nil && (opts.Preference != nil || opts.Replicas != nil) {
+		return fmt.Errorf("preference and replicas can only be set with instancetype")
+	}
+	if opts.Replicas != nil && (*opts.Replicas < 1 || *opts.Replicas > maxCapacityForecastReplicas) {
+		return fmt.Errorf("replicas has to be between 1 and %d", maxCapacityForecastReplicas)
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) capacityForecastVMs(namespace string, opts *v1.CapacityForecastOptions) ([]capacityForecastVM, *errors.StatusError) {
+	var vms []capacityForecastVM
+	if opts.Instancetype != nil {
+		vm := &v1.VirtualMachine{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: opts.Instancetype.Name, Namespace: namespace},
+			Spec: v1.VirtualMachineSpec{
+				Instancetype: opts.Instancetype,
+				Preference:   opts.Preference,
+				Template:     &v1.VirtualMachineInstanceTemplateSpec{},
+			},
+		}
+		forecastVM, statusErr := app.renderCapacityForecastVM(vm)
+		if statusErr != nil {
+			return nil, statusErr
+		}
+		replicas := int32(1)
+		if opts.Replicas != nil {
+			replicas = *opts.Replicas
+		}
+		for i := int32(0); i < replicas; i++ {
+			replica := forecastVM
+			replica.name = fmt.Sprintf("%s-%d", vm.Name, i)
+			vms = append(vms, replica)
+		}
+		return vms, nil
+	}
+
+	for _, name := range opts.VirtualMachines {
+		vm, statusErr := app.fetchVirtualMachine(name, namespace)
+		if statusErr != nil {
+			return nil, statusErr
+		}
+		if vm.Status.Created {
+			continue
+		}
+		forecastVM, statusErr := app.renderCapacityForecastVM(vm)
+		if statusErr != nil {
+			return nil, statusErr
+		}
+		vms = append(vms, forecastVM)
+	}
+	return vms, nil
+}
+
+// renderCapacityForecastVM creates the VMI of the VM like the VM controller and renders the resources its virt-launcher pod requests
+func (app *SubresourceAPIApp) renderCapacityForecastVM(vm *v1.VirtualMachine) (capacityForecastVM, *errors.StatusError) {
+	renderErr := func(err error) *errors.StatusError {
+		return errors.NewBadRequest(fmt.Sprintf("failed to render VM %s: %v", vm.Name, err))
+	}
+
+	vmi := v1.NewVMIReferenceFromNameWithNS(vm.Namespace, vm.Name)
+	if vm.Spec.Template != nil {
+		vmi.ObjectMeta.Labels = vm.Spec.Template.ObjectMeta.Labels
+		vmi.ObjectMeta.Annotations = vm.Spec.Template.ObjectMeta.Annotations
+		vmi.Spec = *vm.Spec.Template.Spec.DeepCopy()
+	}
+
+	instancetypeSpec, err := app.instancetypeMethods.FindInstancetypeSpec(vm)
+	if err != nil {
+		return capacityForecastVM{}, renderErr(err)
+	}
+	preferenceSpec, err := app.instancetypeMethods.FindPreferenceSpec(vm)
+	if err != nil {
+		return capacityForecastVM{}, renderErr(err)
+	}
+	if conflicts := app.instancetypeMethods.ApplyToVmi(field.NewPath("spec"), instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta); len(conflicts) > 0 {
+		return capacityForecastVM{}, renderErr(fmt.Errorf("VMI conflicts with instancetype spec in fields: [%s]", conflicts.String()))
+	}
+	if err := webhooks.SetDefaultVirtualMachineInstance(app.clusterConfig, vmi); err != nil {
+		return capacityForecastVM{}, renderErr(err)
+	}
+
+	resources, err := services.RenderLauncherResources(app.virtCli, app.clusterConfig, vmi)
+	if err != nil {
+		return capacityForecastVM{}, renderErr(err)
+	}
+	return capacityForecastVM{
+		name:     vm.Name,
+		vmi:      vmi,
+		requests: effectiveRequests(resources),
+	}, nil
+}
+
+// capacityForecastNodes returns the nodes with the capacity left by the pods running on them
+func (app *SubresourceAPIApp) capacityForecastNodes() ([]*capacityForecastNode, error) {
+	if app.nodeInformer == nil || app.podInformer == nil {
+		return nil, fmt.Errorf("node and pod informers are not available")
+	}
+
+	nodeObjs := app.nodeInformer.GetStore().List()
+	nodes := make([]*capacityForecastNode, 0, len(nodeObjs))
+	nodesByName := map[string]*capacityForecastNode{}
+	for _, obj := range nodeObjs {
+		k8sNode := obj.(*k8sv1.Node)
+		node := &capacityForecastNode{
+			node: k8sNode,
+			free: k8sNode.Status.Allocatable.DeepCopy(),
+		}
+		nodes = append(nodes, node)
+		nodesByName[k8sNode.Name] = node
+	}
+	for _, obj := range app.podInformer.GetStore().List() {
+		pod := obj.(*k8sv1.Pod)
+		if pod.Status.Phase == k8sv1.PodSucceeded || pod.Status.Phase == k8sv1.PodFailed {
+			continue
+		}
+		if node, exists := nodesByName[pod.Spec.NodeName]; exists {
+			node.consume(podRequests(pod))
+		}
+	}
+	return nodes, nil
+}
+
+// forecastCapacity places the VMs one after the other onto the node which fits them and has the most
+// capacity left, like the default scoring of the scheduler
+func forecastCapacity(vms []capacityForecastVM, nodes []*capacityForecastNode) *v1.CapacityForecast {
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].node.Name < nodes[j].node.Name
+	})
+
+	forecast := &v1.CapacityForecast{Requested: int32(len(vms))}
+	for i := range vms {
+		vm := &vms[i]
+		var target *capacityForecastNode
+		reasons := map[string]int{}
+		for _, node := range nodes {
+			if reason := node.fits(vm); reason != "" {
+				reasons[reason]++
+				continue
+			}
+			if target == nil || node.score() > target.score() {
+				target = node
+			}
+		}
+		if target == nil {
+			forecast.Unplaceable = append(forecast.Unplaceable, v1.CapacityForecastUnplaceable{
+				Name:   vm.name,
+				Reason: unplaceableReason(len(nodes), reasons),
+			})
+			continue
+		}
+		target.consume(vm.requests)
+		target.placed = append(target.placed, vm.name)
+		forecast.Placeable++
+	}
+
+	for _, node := range nodes {
+		if len(node.placed) > 0 {
+			forecast.Nodes = append(forecast.Nodes, v1.CapacityForecastNode{
+				Name:            node.node.Name,
+				VirtualMachines: node.placed,
+				Remaining:       node.free,
+			})
+		}
+	}
+	return forecast
+}
+
+// fits returns why the VM doesn't fit onto the node, or an empty string if it does
+func (n *capacityForecastNode) fits(vm *capacityForecastVM) string {
+	if n.node.Spec.Unschedulable || !isNodeReady(n.node) {
+		return "node(s) were unschedulable"
+	}
+
+	nodeSelector := map[string]string{v1.NodeSchedulable: "true"}
+	for key, value := range vm.vmi.Spec.NodeSelector {
+		nodeSelector[key] = value
+	}
+	if !labels.SelectorFromSet(nodeSelector).Matches(labels.Set(n.node.Labels)) {
+		return "node(s) didn't match Pod's node affinity/selector"
+	}
+
+	for i := range n.node.Spec.Taints {
+		taint := &n.node.Spec.Taints[i]
+		if taint.Effect != k8sv1.TaintEffectNoSchedule && taint.Effect != k8sv1.TaintEffectNoExecute {
+			continue
+		}
+		if !toleratesTaint(vm.vmi.Spec.Tolerations, taint) {
+			return fmt.Sprintf("node(s) had untolerated taint {%s: %s}", taint.Key, taint.Value)
+		}
+	}
+
+	if free, exists := n.free[k8sv1.ResourcePods]; exists && free.Value() < 1 {
+		return "Too many pods"
+	}
+	names := make([]string, 0, len(vm.requests))
+	for name := range vm.requests {
+		names = append(names, string(name))
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		requested := vm.requests[k8sv1.ResourceName(name)]
+		if requested.IsZero() {
+			continue
+		}
+		free := n.free[k8sv1.ResourceName(name)]
+		if free.Cmp(requested) < 0 {
+			return "Insufficient " + name
+		}
+	}
+	return ""
+}
+
+func (n *capacityForecastNode) consume(requests k8sv1.ResourceList) {
+	for name, requested := range requests {
+		if free, exists := n.free[name]; exists {
+			free.Sub(requested)
+			n.free[name] = free
+		}
+	}
+	if free, exists := n.free[k8sv1.ResourcePods]; exists {
+		free.Sub(resource.MustParse("1"))
+		n.free[k8sv1.ResourcePods] = free
+	}
+}
+
+// score is the share of the allocatable CPU and memory left on the node
+func (n *capacityForecastNode) score() float64 {
+	var score float64
+	for _, name := range []k8sv1.ResourceName{k8sv1.ResourceCPU, k8sv1.ResourceMemory} {
+		allocatable := n.node.Status.Allocatable[name]
+		if allocatable.IsZero() {
+			continue
+		}
+		free := n.free[name]
+		score += float64(free.MilliValue()) / float64(allocatable.MilliValue())
+	}
+	return score
+}
+
+func isNodeReady(node *k8sv1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == k8sv1.NodeReady {
+			return condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func toleratesTaint(tolerations []k8sv1.Toleration, taint *k8sv1.Taint) bool {
+	for i := range tolerations {
+		if tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// unplaceableReason summarizes why a VM doesn't fit onto any node in the format of the scheduler
+func unplaceableReason(nodeCount int, reasons map[string]int) string {
+	summary := make([]string, 0, len(reasons))
+	for reason, count := range reasons {
+		summary = append(summary, fmt.Sprintf("%d %s", count, reason))
+	}
+	sort.Strings(summary)
+	return fmt.Sprintf("0/%d nodes are available: %s.", nodeCount, strings.Join(summary, ", "))
+}
+
+// effectiveRequests returns the requests of a container, which default to the limits
+func effectiveRequests(resources k8sv1.ResourceRequirements) k8sv1.ResourceList {
+	requests := resources.Requests.DeepCopy()
+	if requests == nil {
+		requests = k8sv1.ResourceList{}
+	}
+	for name, limit := range resources.Limits {
+		if _, exists := requests[name]; !exists {
+			requests[name] = limit.DeepCopy()
+		}
+	}
+	return requests
+}
+
+// podRequests returns the resources a pod requests, following the rules of the scheduler
+func podRequests(pod *k8sv1.Pod) k8sv1.ResourceList {
+	requests := k8sv1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		for name, requested := range effectiveRequests(container.Resources) {
+			sum := requests[name]
+			sum.Add(requested)
+			requests[name] = sum
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, requested := range effectiveRequests(container.Resources) {
+			if current, exists := requests[name]; !exists || requested.Cmp(current) > 0 {
+				requests[name] = requested
+			}
+		}
+	}
+	for name, overhead := range pod.Spec.Overhead {
+		sum := requests[name]
+		sum.Add(overhead)
+		requests[name] = sum
+	}
+	return requests
+}
@@ -0,0 +1,294 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/instancetype"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+)
+
+var _ = Describe("Capacity forecast subresource", func() {
+	const namespace = "test-namespace"
+
+	var (
+		kubeClient          *fake.Clientset
+		nodeInformer        cache.SharedIndexInformer
+		podInformer         cache.SharedIndexInformer
+		vmClient            *kubecli.MockVirtualMachineInterface
+		instancetypeMethods *testutils.MockInstancetypeMethods
+		instancetypeSpec    *instancetypev1beta1.VirtualMachineInstancetypeSpec
+		app                 *SubresourceAPIApp
+
+		request  *restful.Request
+		recorder *httptest.ResponseRecorder
+		response *restful.Response
+	)
+
+	newNode := func(name string, memory string) *k8sv1.Node {
+		allocatable := k8sv1.ResourceList{
+			k8sv1.ResourceCPU:              resource.MustParse("8"),
+			k8sv1.ResourceMemory:           resource.MustParse(memory),
+			k8sv1.ResourceEphemeralStorage: resource.MustParse("100Gi"),
+			k8sv1.ResourcePods:             resource.MustParse("110"),
+			services.KvmDevice:             resource.MustParse("1k"),
+			services.TunDevice:             resource.MustParse("1k"),
+			services.VhostNetDevice:        resource.MustParse("1k"),
+		}
+		return &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{v1.NodeSchedulable: "true"},
+			},
+			Status: k8sv1.NodeStatus{
+				Allocatable: allocatable,
+				Conditions:  []k8sv1.NodeCondition{{Type: k8sv1.NodeReady, Status: k8sv1.ConditionTrue}},
+			},
+		}
+	}
+
+	createNode := func(node *k8sv1.Node) {
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+	}
+
+	createPod := func(name string, phase k8sv1.PodPhase, memory string) {
+		Expect(podInformer.GetStore().Add(&k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: k8sv1.PodSpec{
+				NodeName: "node01",
+				Containers: []k8sv1.Container{{
+					Resources: k8sv1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(memory)},
+					},
+				}},
+			},
+			Status: k8sv1.PodStatus{Phase: phase},
+		})).To(Succeed())
+	}
+
+	callCapacityForecast := func(opts *v1.CapacityForecastOptions) *httptest.ResponseRecorder {
+		request.PathParameters()["namespace"] = namespace
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewBuffer(body))
+
+		app.CapacityForecastRequestHandler(request, response)
+		return recorder
+	}
+
+	expectForecast := func(recorder *httptest.ResponseRecorder) *v1.CapacityForecast {
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		forecast := &v1.CapacityForecast{}
+		Expect(json.NewDecoder(recorder.Body).Decode(forecast)).To(Succeed())
+		return forecast
+	}
+
+	instancetypeOpts := func(replicas int32) *v1.CapacityForecastOptions {
+		return &v1.CapacityForecastOptions{
+			Instancetype: &v1.InstancetypeMatcher{Name: "u1.medium"},
+			Replicas:     pointer.Int32(replicas),
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubeClient = fake.NewSimpleClientset()
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(namespace).Return(vmClient).AnyTimes()
+
+		instancetypeSpec = &instancetypev1beta1.VirtualMachineInstancetypeSpec{
+			CPU:    instancetypev1beta1.CPUInstancetype{Guest: 2},
+			Memory: instancetypev1beta1.MemoryInstancetype{Guest: resource.MustParse("3Gi")},
+		}
+		instancetypeMethods = testutils.NewMockInstancetypeMethods()
+		instancetypeMethods.FindInstancetypeSpecFunc = func(vm *v1.VirtualMachine) (*instancetypev1beta1.VirtualMachineInstancetypeSpec, error) {
+			if vm.Spec.Instancetype == nil {
+				return nil, nil
+			}
+			return instancetypeSpec, nil
+		}
+		instancetypeMethods.ApplyToVmiFunc = (&instancetype.InstancetypeMethods{}).ApplyToVmi
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		app = NewSubresourceAPIApp(virtClient, 0, nil, clusterConfig, nil, nodeInformer, podInformer, nil)
+		app.instancetypeMethods = instancetypeMethods
+
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		createNode(newNode("node01", "8Gi"))
+		createNode(newNode("node02", "8Gi"))
+	})
+
+	It("should place replicas of an instancetype onto the nodes", func() {
+		forecast := expectForecast(callCapacityForecast(instancetypeOpts(4)))
+		Expect(forecast.Requested).To(BeEquivalentTo(4))
+		Expect(forecast.Placeable).To(BeEquivalentTo(4))
+		Expect(forecast.Unplaceable).To(BeEmpty())
+		Expect(forecast.Nodes).To(HaveLen(2))
+		Expect(forecast.Nodes[0].Name).To(Equal("node01"))
+		Expect(forecast.Nodes[0].VirtualMachines).To(ConsistOf("u1.medium-0", "u1.medium-2"))
+		Expect(forecast.Nodes[1].Name).To(Equal("node02"))
+		Expect(forecast.Nodes[1].VirtualMachines).To(ConsistOf("u1.medium-1", "u1.medium-3"))
+	})
+
+	It("should report the replicas which don't fit, including the memory overhead", func() {
+		forecast := expectForecast(callCapacityForecast(instancetypeOpts(5)))
+		Expect(forecast.Requested).To(BeEquivalentTo(5))
+		Expect(forecast.Placeable).To(BeEquivalentTo(4))
+		Expect(forecast.Unplaceable).To(ConsistOf(v1.CapacityForecastUnplaceable{
+			Name:   "u1.medium-4",
+			Reason: "0/2 nodes are available: 2 Insufficient memory.",
+		}))
+	})
+
+	It("should respect the requests of the pods running on the nodes", func() {
+		createPod("running", k8sv1.PodRunning, "6Gi")
+		createPod("succeeded", k8sv1.PodSucceeded, "8Gi")
+
+		forecast := expectForecast(callCapacityForecast(instancetypeOpts(4)))
+		Expect(forecast.Placeable).To(BeEquivalentTo(2))
+		Expect(forecast.Nodes).To(HaveLen(1))
+		Expect(forecast.Nodes[0].Name).To(Equal("node02"))
+	})
+
+	It("should require nodes with hugepages for VMs backed by hugepages", func() {
+		instancetypeSpec.Memory.Hugepages = &v1.Hugepages{PageSize: "2Mi"}
+		node := newNode("node03", "8Gi")
+		node.Status.Allocatable["hugepages-2Mi"] = resource.MustParse("4Gi")
+		createNode(node)
+
+		forecast := expectForecast(callCapacityForecast(instancetypeOpts(2)))
+		Expect(forecast.Placeable).To(BeEquivalentTo(1))
+		Expect(forecast.Nodes).To(HaveLen(1))
+		Expect(forecast.Nodes[0].Name).To(Equal("node03"))
+		Expect(forecast.Unplaceable).To(HaveLen(1))
+		Expect(forecast.Unplaceable[0].Reason).To(Equal("0/3 nodes are available: 3 Insufficient hugepages-2Mi."))
+	})
+
+	It("should skip nodes which are unschedulable or have untolerated taints", func() {
+		unschedulable := newNode("node01", "8Gi")
+		unschedulable.Spec.Unschedulable = true
+		tainted := newNode("node02", "8Gi")
+		tainted.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "db", Effect: k8sv1.TaintEffectNoSchedule}}
+		Expect(nodeInformer.GetStore().Update(unschedulable)).To(Succeed())
+		Expect(nodeInformer.GetStore().Update(tainted)).To(Succeed())
+
+		forecast := expectForecast(callCapacityForecast(instancetypeOpts(1)))
+		Expect(forecast.Placeable).To(BeZero())
+		Expect(forecast.Unplaceable[0].Reason).To(Equal("0/2 nodes are available: 1 node(s) had untolerated taint {dedicated: db}, 1 node(s) were unschedulable."))
+	})
+
+	It("should place the passed VirtualMachines and ignore running ones", func() {
+		newVM := func(name string, created bool) *v1.VirtualMachine {
+			vm := &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+				Spec: v1.VirtualMachineSpec{
+					Template: &v1.VirtualMachineInstanceTemplateSpec{
+						Spec: v1.VirtualMachineInstanceSpec{
+							Domain: v1.DomainSpec{
+								Resources: v1.ResourceRequirements{
+									Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("1Gi")},
+								},
+							},
+							NodeSelector: map[string]string{"zone": "a"},
+						},
+					},
+				},
+				Status: v1.VirtualMachineStatus{Created: created},
+			}
+			vmClient.EXPECT().Get(context.Background(), name, metav1.GetOptions{}).Return(vm, nil)
+			return vm
+		}
+		newVM("stopped", false)
+		newVM("running", true)
+		node := newNode("node03", "8Gi")
+		node.Labels["zone"] = "a"
+		createNode(node)
+
+		forecast := expectForecast(callCapacityForecast(&v1.CapacityForecastOptions{
+			VirtualMachines: []string{"stopped", "running"},
+		}))
+		Expect(forecast.Requested).To(BeEquivalentTo(1))
+		Expect(forecast.Placeable).To(BeEquivalentTo(1))
+		Expect(forecast.Nodes).To(HaveLen(1))
+		Expect(forecast.Nodes[0].Name).To(Equal("node03"))
+		Expect(forecast.Nodes[0].VirtualMachines).To(ConsistOf("stopped"))
+	})
+
+	It("should fail if a passed VirtualMachine does not exist", func() {
+		vmClient.EXPECT().Get(context.Background(), "missing", metav1.GetOptions{}).
+			Return(nil, errors.NewNotFound(schema.GroupResource{Resource: "virtualmachines"}, "missing"))
+
+		recorder := callCapacityForecast(&v1.CapacityForecastOptions{VirtualMachines: []string{"missing"}})
+		ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+	})
+
+	DescribeTable("should reject invalid options", func(opts *v1.CapacityForecastOptions, expectedMessage string) {
+		recorder := callCapacityForecast(opts)
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		Expect(statusErr.Status().Message).To(ContainSubstring(expectedMessage))
+	},
+		Entry("without VirtualMachines and instancetype", &v1.CapacityForecastOptions{}, "has to be set"),
+		Entry("with VirtualMachines and instancetype", &v1.CapacityForecastOptions{
+			VirtualMachines: []string{"vm"},
+			Instancetype:    &v1.InstancetypeMatcher{Name: "u1.medium"},
+		}, "either virtualMachines or instancetype can be set"),
+		Entry("with replicas but without instancetype", &v1.CapacityForecastOptions{
+			VirtualMachines: []string{"vm"},
+			Replicas:        pointer.Int32(2),
+		}, "can only be set with instancetype"),
+		Entry("with too many replicas", &v1.CapacityForecastOptions{
+			Instancetype: &v1.InstancetypeMatcher{Name: "u1.medium"},
+			Replicas:     pointer.Int32(maxCapacityForecastReplicas + 1),
+		}, "replicas has to be between 1 and"),
+	)
+})
@@ -0,0 +1,303 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+// handlerHeartbeatTimeout matches the timeout after which virt-controller marks a node unresponsive
+const handlerHeartbeatTimeout = 5 * time.Minute
+
+// KubeVirtHealthHandler reports the aggregated health and readiness of the KubeVirt install in namespace
+func (app *SubresourceAPIApp) KubeVirtHealthHandler(namespace string) restful.RouteFunction {
+	return func(request *restful.Request, response *restful.Response) {
+		health, err := app.kubeVirtHealth(namespace, time.Now())
+		if err != nil {
+			writeError(errors.NewInternalError(err), response)
+			return
+		}
+
+		if err := response.WriteEntity(health); err != nil {
+			log.Log.Reason(err).Error("Failed to write http response.")
+		}
+	}
+}
+
+func (app *SubresourceAPIApp) kubeVirtHealth(namespace string, now time.Time) (*v1.KubeVirtHealth, error) {
+	health := &v1.KubeVirtHealth{}
+
+	kvList, err := app.virtCli.KubeVirt(namespace).List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list KubeVirt: %v", err)
+	}
+	if len(kvList.Items) > 0 {
+		kv := kvList.Items[0]
+		health.Phase = kv.Status.Phase
+		health.ObservedKubeVirtVersion = kv.Status.ObservedKubeVirtVersion
+		health.TargetKubeVirtVersion = kv.Status.TargetKubeVirtVersion
+	}
+
+	if health.Components, err = app.componentsHealth(namespace); err != nil {
+		return nil, err
+	}
+	if health.Webhooks, err = app.webhooksHealth(); err != nil {
+		return nil, err
+	}
+	if health.Nodes, err = app.nodesHealth(now); err != nil {
+		return nil, err
+	}
+	if health.Migrations, err = app.migrationsHealth(); err != nil {
+		return nil, err
+	}
+
+	health.Ready = health.Phase == v1.KubeVirtPhaseDeployed && health.ObservedKubeVirtVersion == health.TargetKubeVirtVersion
+	health.Healthy = true
+	for _, component := range health.Components {
+		health.Ready = health.Ready && component.RolledOut
+		health.Healthy = health.Healthy && component.Available > 0
+	}
+	for _, webhook := range health.Webhooks {
+		health.Healthy = health.Healthy && webhook.Reachable
+	}
+	for _, node := range health.Nodes {
+		health.Healthy = health.Healthy && node.Responsive
+	}
+
+	return health, nil
+}
+
+func (app *SubresourceAPIApp) componentsHealth(namespace string) ([]v1.KubeVirtComponentHealth, error) {
+	var componentsHealth []v1.KubeVirtComponentHealth
+
+	for _, name := range []string{components.VirtOperatorName, components.VirtAPIName, components.VirtControllerName, components.VirtExportProxyName} {
+		deployment, err := app.virtCli.AppsV1().Deployments(namespace).Get(context.Background(), name, k8smetav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			// virt-exportproxy is only deployed with the VMExport feature gate
+			if name != components.VirtExportProxyName {
+				componentsHealth = append(componentsHealth, v1.KubeVirtComponentHealth{Name: name, Message: "deployment not found"})
+			}
+			continue
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to get deployment %s: %v", name, err)
+		}
+		componentsHealth = append(componentsHealth, deploymentHealth(deployment))
+	}
+
+	daemonSet, err := app.virtCli.AppsV1().DaemonSets(namespace).Get(context.Background(), components.VirtHandlerName, k8smetav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		componentsHealth = append(componentsHealth, v1.KubeVirtComponentHealth{Name: components.VirtHandlerName, Message: "daemonset not found"})
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get daemonset %s: %v", components.VirtHandlerName, err)
+	} else {
+		componentsHealth = append(componentsHealth, daemonSetHealth(daemonSet))
+	}
+
+	return componentsHealth, nil
+}
+
+func deploymentHealth(deployment *appsv1.Deployment) v1.KubeVirtComponentHealth {
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	return rolloutHealth(v1.KubeVirtComponentHealth{
+		Name:      deployment.Name,
+		Desired:   desired,
+		Updated:   deployment.Status.UpdatedReplicas,
+		Available: deployment.Status.AvailableReplicas,
+	}, deployment.Generation, deployment.Status.ObservedGeneration, deployment.Status.Replicas)
+}
+
+func daemonSetHealth(daemonSet *appsv1.DaemonSet) v1.KubeVirtComponentHealth {
+	return rolloutHealth(v1.KubeVirtComponentHealth{
+		Name:      daemonSet.Name,
+		Desired:   daemonSet.Status.DesiredNumberScheduled,
+		Updated:   daemonSet.Status.UpdatedNumberScheduled,
+		Available: daemonSet.Status.NumberAvailable,
+	}, daemonSet.Generation, daemonSet.Status.ObservedGeneration, daemonSet.Status.CurrentNumberScheduled)
+}
+
+// rolloutHealth decides like 'kubectl rollout status' whether a component is rolled out
+func rolloutHealth(component v1.KubeVirtComponentHealth, generation, observedGeneration int64, current int32) v1.KubeVirtComponentHealth {
+	switch {
+	case observedGeneration < generation:
+		component.Message = "waiting for the rollout to be observed"
+	case component.Updated < component.Desired:
+		component.Message = fmt.Sprintf("%d of %d pods are updated", component.Updated, component.Desired)
+	case current > component.Updated:
+		component.Message = fmt.Sprintf("%d old pods are pending termination", current-component.Updated)
+	case component.Available < component.Desired:
+		component.Message = fmt.Sprintf("%d of %d updated pods are available", component.Available, component.Desired)
+	default:
+		component.RolledOut = true
+	}
+	return component
+}
+
+func (app *SubresourceAPIApp) webhooksHealth() ([]v1.KubeVirtWebhookHealth, error) {
+	var webhooksHealth []v1.KubeVirtWebhookHealth
+
+	admissionClient := app.virtCli.AdmissionregistrationV1()
+	for _, name := range []string{components.VirtAPIValidatingWebhookName, components.KubeVirtOperatorValidatingWebhookName} {
+		var services []*admissionregistrationv1.ServiceReference
+		webhookConfiguration, err := admissionClient.ValidatingWebhookConfigurations().Get(context.Background(), name, k8smetav1.GetOptions{})
+		if err == nil {
+			for _, webhook := range webhookConfiguration.Webhooks {
+				services = append(services, webhook.ClientConfig.Service)
+			}
+		}
+		webhookHealth, err := app.webhookHealth(name, services, err)
+		if err != nil {
+			return nil, err
+		}
+		webhooksHealth = append(webhooksHealth, webhookHealth)
+	}
+
+	var services []*admissionregistrationv1.ServiceReference
+	webhookConfiguration, err := admissionClient.MutatingWebhookConfigurations().Get(context.Background(), components.VirtAPIMutatingWebhookName, k8smetav1.GetOptions{})
+	if err == nil {
+		for _, webhook := range webhookConfiguration.Webhooks {
+			services = append(services, webhook.ClientConfig.Service)
+		}
+	}
+	webhookHealth, err := app.webhookHealth(components.VirtAPIMutatingWebhookName, services, err)
+	if err != nil {
+		return nil, err
+	}
+	webhooksHealth = append(webhooksHealth, webhookHealth)
+
+	return webhooksHealth, nil
+}
+
+// webhookHealth checks that the services the webhooks of a configuration call have ready endpoints
+func (app *SubresourceAPIApp) webhookHealth(name string, services []*admissionregistrationv1.ServiceReference, getErr error) (v1.KubeVirtWebhookHealth, error) {
+	webhookHealth := v1.KubeVirtWebhookHealth{Name: name}
+	if errors.IsNotFound(getErr) {
+		webhookHealth.Message = "webhook configuration not found"
+		return webhookHealth, nil
+	} else if getErr != nil {
+		return webhookHealth, fmt.Errorf("failed to get webhook configuration %s: %v", name, getErr)
+	}
+
+	checked := map[string]bool{}
+	for _, service := range services {
+		if service == nil {
+			continue
+		}
+		key := service.Namespace + "/" + service.Name
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
+		endpoints, err := app.virtCli.CoreV1().Endpoints(service.Namespace).Get(context.Background(), service.Name, k8smetav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			webhookHealth.Message = fmt.Sprintf("service %s has no endpoints", key)
+			return webhookHealth, nil
+		} else if err != nil {
+			return webhookHealth, fmt.Errorf("failed to get endpoints of service %s: %v", key, err)
+		}
+
+		ready := 0
+		for _, subset := range endpoints.Subsets {
+			ready += len(subset.Addresses)
+		}
+		if ready == 0 {
+			webhookHealth.Message = fmt.Sprintf("service %s has no ready endpoints", key)
+			return webhookHealth, nil
+		}
+	}
+
+	webhookHealth.Reachable = true
+	return webhookHealth, nil
+}
+
+// nodesHealth reports the heartbeats of the nodes virt-handler ran on, which are the nodes it labeled
+func (app *SubresourceAPIApp) nodesHealth(now time.Time) ([]v1.KubeVirtNodeHealth, error) {
+	if app.nodeInformer == nil {
+		return nil, fmt.Errorf("node informer is not available")
+	}
+
+	var nodes []*k8sv1.Node
+	for _, obj := range app.nodeInformer.GetStore().List() {
+		node := obj.(*k8sv1.Node)
+		if _, labeled := node.Labels[v1.NodeSchedulable]; labeled {
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	var nodesHealth []v1.KubeVirtNodeHealth
+	for _, node := range nodes {
+		nodeHealth := v1.KubeVirtNodeHealth{
+			Name:        node.Name,
+			Schedulable: node.Labels[v1.NodeSchedulable] == "true",
+		}
+		if heartbeat, exists := node.Annotations[v1.VirtHandlerHeartbeat]; exists {
+			lastHeartbeat := &k8smetav1.Time{}
+			if err := lastHeartbeat.UnmarshalQueryParameter(heartbeat); err != nil {
+				log.Log.Reason(err).Warningf("Failed to parse the virt-handler heartbeat %q of node %s", heartbeat, node.Name)
+			} else {
+				nodeHealth.LastHeartbeat = lastHeartbeat
+				nodeHealth.Responsive = now.Sub(lastHeartbeat.Time) <= handlerHeartbeatTimeout
+			}
+		}
+		nodesHealth = append(nodesHealth, nodeHealth)
+	}
+	return nodesHealth, nil
+}
+
+func (app *SubresourceAPIApp) migrationsHealth() (v1.KubeVirtMigrationsHealth, error) {
+	migrationsHealth := v1.KubeVirtMigrationsHealth{}
+
+	if app.migrationInformer == nil {
+		return migrationsHealth, fmt.Errorf("migration informer is not available")
+	}
+
+	for _, obj := range app.migrationInformer.GetStore().List() {
+		migration := obj.(*v1.VirtualMachineInstanceMigration)
+		switch {
+		case migration.IsFinal():
+		case migration.IsRunning():
+			migrationsHealth.Running++
+		default:
+			migrationsHealth.Pending++
+		}
+	}
+	return migrationsHealth, nil
+}
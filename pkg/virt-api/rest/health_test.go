@@ -0,0 +1,275 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+var _ = Describe("KubeVirt health subresource", func() {
+	const (
+		namespace = "kubevirt"
+		version   = "v1.1.0"
+	)
+
+	var (
+		kubeClient        *fake.Clientset
+		kvClient          *kubevirtfake.Clientset
+		nodeInformer      cache.SharedIndexInformer
+		migrationInformer cache.SharedIndexInformer
+		app               *SubresourceAPIApp
+		now               time.Time
+	)
+
+	newDeployment := func(name string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec:       appsv1.DeploymentSpec{Replicas: pointer.Int32(2)},
+			Status: appsv1.DeploymentStatus{
+				ObservedGeneration: 1,
+				Replicas:           2,
+				UpdatedReplicas:    2,
+				AvailableReplicas:  2,
+			},
+		}
+	}
+
+	newDaemonSet := func() *appsv1.DaemonSet {
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: components.VirtHandlerName, Namespace: namespace, Generation: 1},
+			Status: appsv1.DaemonSetStatus{
+				ObservedGeneration:     1,
+				DesiredNumberScheduled: 2,
+				CurrentNumberScheduled: 2,
+				UpdatedNumberScheduled: 2,
+				NumberAvailable:        2,
+			},
+		}
+	}
+
+	newNode := func(name string, heartbeat time.Time) *k8sv1.Node {
+		return &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Labels:      map[string]string{v1.NodeSchedulable: "true"},
+				Annotations: map[string]string{v1.VirtHandlerHeartbeat: heartbeat.UTC().Format(time.RFC3339)},
+			},
+		}
+	}
+
+	webhookService := &admissionregistrationv1.ServiceReference{Namespace: namespace, Name: components.VirtApiServiceName}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		now = time.Now().Truncate(time.Second)
+
+		kubeClient = fake.NewSimpleClientset(
+			newDeployment(components.VirtOperatorName),
+			newDeployment(components.VirtAPIName),
+			newDeployment(components.VirtControllerName),
+			newDaemonSet(),
+			&admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: components.VirtAPIValidatingWebhookName},
+				Webhooks:   []admissionregistrationv1.ValidatingWebhook{{ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: webhookService}}},
+			},
+			&admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: components.KubeVirtOperatorValidatingWebhookName},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{{ClientConfig: admissionregistrationv1.WebhookClientConfig{
+					Service: &admissionregistrationv1.ServiceReference{Namespace: namespace, Name: components.KubevirtOperatorWebhookServiceName},
+				}}},
+			},
+			&admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: components.VirtAPIMutatingWebhookName},
+				Webhooks:   []admissionregistrationv1.MutatingWebhook{{ClientConfig: admissionregistrationv1.WebhookClientConfig{Service: webhookService}}},
+			},
+			&k8sv1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: components.VirtApiServiceName},
+				Subsets:    []k8sv1.EndpointSubset{{Addresses: []k8sv1.EndpointAddress{{IP: "10.0.0.1"}}}},
+			},
+			&k8sv1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: components.KubevirtOperatorWebhookServiceName},
+				Subsets:    []k8sv1.EndpointSubset{{Addresses: []k8sv1.EndpointAddress{{IP: "10.0.0.2"}}}},
+			},
+		)
+		kvClient = kubevirtfake.NewSimpleClientset(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: namespace},
+			Status: v1.KubeVirtStatus{
+				Phase:                   v1.KubeVirtPhaseDeployed,
+				ObservedKubeVirtVersion: version,
+				TargetKubeVirtVersion:   version,
+			},
+		})
+
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().AppsV1().Return(kubeClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().AdmissionregistrationV1().Return(kubeClient.AdmissionregistrationV1()).AnyTimes()
+		virtClient.EXPECT().KubeVirt(namespace).Return(kvClient.KubevirtV1().KubeVirts(namespace)).AnyTimes()
+
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		Expect(nodeInformer.GetStore().Add(newNode("node01", now.Add(-time.Minute)))).To(Succeed())
+		Expect(nodeInformer.GetStore().Add(newNode("node02", now.Add(-time.Minute)))).To(Succeed())
+		// nodes virt-handler never ran on are not reported
+		Expect(nodeInformer.GetStore().Add(&k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node03"}})).To(Succeed())
+		migrationInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
+
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		app = NewSubresourceAPIApp(virtClient, 0, nil, clusterConfig, nil, nodeInformer, nil, migrationInformer)
+	})
+
+	It("should report a fully deployed install as healthy and ready", func() {
+		request := restful.NewRequest(&http.Request{URL: &url.URL{}})
+		recorder := httptest.NewRecorder()
+		response := restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		app.KubeVirtHealthHandler(namespace)(request, response)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		health := &v1.KubeVirtHealth{}
+		Expect(json.NewDecoder(recorder.Body).Decode(health)).To(Succeed())
+		Expect(health.Healthy).To(BeTrue())
+		Expect(health.Ready).To(BeTrue())
+		Expect(health.Phase).To(Equal(v1.KubeVirtPhaseDeployed))
+		Expect(health.TargetKubeVirtVersion).To(Equal(version))
+		Expect(health.Components).To(HaveLen(4))
+		for _, component := range health.Components {
+			Expect(component.RolledOut).To(BeTrue(), component.Name)
+		}
+		Expect(health.Webhooks).To(HaveLen(3))
+		for _, webhook := range health.Webhooks {
+			Expect(webhook.Reachable).To(BeTrue(), webhook.Name)
+		}
+		Expect(health.Nodes).To(HaveLen(2))
+		Expect(health.Nodes[0].Responsive).To(BeTrue())
+		Expect(health.Nodes[0].LastHeartbeat).ToNot(BeNil())
+	})
+
+	It("should report a component which is rolling out as not ready", func() {
+		deployment := newDeployment(components.VirtControllerName)
+		deployment.Generation = 2
+		deployment.Status.UpdatedReplicas = 1
+		_, err := kubeClient.AppsV1().Deployments(namespace).Update(context.Background(), deployment, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		health, err := app.kubeVirtHealth(namespace, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.Ready).To(BeFalse())
+		Expect(health.Healthy).To(BeTrue())
+		Expect(health.Components).To(ContainElement(v1.KubeVirtComponentHealth{
+			Name:      components.VirtControllerName,
+			Desired:   2,
+			Updated:   1,
+			Available: 2,
+			Message:   "waiting for the rollout to be observed",
+		}))
+	})
+
+	It("should report a version mismatch as not ready", func() {
+		kv, err := kvClient.KubevirtV1().KubeVirts(namespace).Get(context.Background(), "kubevirt", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		kv.Status.TargetKubeVirtVersion = "v1.2.0"
+		_, err = kvClient.KubevirtV1().KubeVirts(namespace).Update(context.Background(), kv, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		health, err := app.kubeVirtHealth(namespace, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.Ready).To(BeFalse())
+	})
+
+	It("should report a missing component as unhealthy", func() {
+		Expect(kubeClient.AppsV1().Deployments(namespace).Delete(context.Background(), components.VirtAPIName, metav1.DeleteOptions{})).To(Succeed())
+
+		health, err := app.kubeVirtHealth(namespace, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.Healthy).To(BeFalse())
+		Expect(health.Ready).To(BeFalse())
+		Expect(health.Components).To(ContainElement(v1.KubeVirtComponentHealth{Name: components.VirtAPIName, Message: "deployment not found"}))
+	})
+
+	It("should report a webhook without ready endpoints as unreachable", func() {
+		endpoints := &k8sv1.Endpoints{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: components.VirtApiServiceName}}
+		_, err := kubeClient.CoreV1().Endpoints(namespace).Update(context.Background(), endpoints, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		health, err := app.kubeVirtHealth(namespace, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.Healthy).To(BeFalse())
+		Expect(health.Webhooks).To(ContainElement(v1.KubeVirtWebhookHealth{
+			Name:    components.VirtAPIMutatingWebhookName,
+			Message: "service kubevirt/virt-api has no ready endpoints",
+		}))
+	})
+
+	It("should report a node with a stale heartbeat as unresponsive", func() {
+		Expect(nodeInformer.GetStore().Update(newNode("node02", now.Add(-10*time.Minute)))).To(Succeed())
+
+		health, err := app.kubeVirtHealth(namespace, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.Healthy).To(BeFalse())
+		Expect(health.Nodes[0].Responsive).To(BeTrue())
+		Expect(health.Nodes[1].Responsive).To(BeFalse())
+	})
+
+	It("should count the outstanding migrations", func() {
+		for name, phase := range map[string]v1.VirtualMachineInstanceMigrationPhase{
+			"new":       v1.MigrationPhaseUnset,
+			"pending":   v1.MigrationPending,
+			"scheduled": v1.MigrationScheduled,
+			"running":   v1.MigrationRunning,
+			"succeeded": v1.MigrationSucceeded,
+			"failed":    v1.MigrationFailed,
+		} {
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+				Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: phase},
+			}
+			Expect(migrationInformer.GetStore().Add(migration)).To(Succeed())
+		}
+
+		health, err := app.kubeVirtHealth(namespace, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(health.Migrations).To(Equal(v1.KubeVirtMigrationsHealth{Pending: 2, Running: 2}))
+	})
+})
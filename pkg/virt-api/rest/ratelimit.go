@@ -0,0 +1,166 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const rateLimiterSweepInterval = time.Minute
+
+// SubresourceRateLimiter holds the per-user and per-namespace token buckets of the expensive
+// subresources. It is shared by the SubresourceAPIApps of all API versions, so that clients
+// can't raise their limits by switching between them.
+type SubresourceRateLimiter struct {
+	clusterConfig *virtconfig.ClusterConfig
+	authorizor    VirtApiAuthorizor
+	now           func() time.Time
+
+	lock      sync.Mutex
+	config    *v1.SubresourceRateLimitsConfiguration
+	limiters  map[string]*rate.Limiter
+	lastSweep time.Time
+}
+
+func NewSubresourceRateLimiter(clusterConfig *virtconfig.ClusterConfig, authorizor VirtApiAuthorizor) *SubresourceRateLimiter {
+	return &SubresourceRateLimiter{
+		clusterConfig: clusterConfig,
+		authorizor:    authorizor,
+		now:           time.Now,
+		limiters:      map[string]*rate.Limiter{},
+	}
+}
+
+// reserve takes a token from the bucket of the user and from the bucket of the namespace.
+// If one of the buckets is empty no token is taken, and the time until the request would
+// be admitted is returned instead.
+func (l *SubresourceRateLimiter) reserve(user, namespace string) time.Duration {
+	config := l.clusterConfig.GetConfig().SubresourceRateLimits
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if !equality.Semantic.DeepEqual(config, l.config) {
+		l.config = config.DeepCopy()
+		l.limiters = map[string]*rate.Limiter{}
+	}
+	if config == nil {
+		return 0
+	}
+
+	now := l.now()
+	l.sweep(now)
+
+	var reservations []*rate.Reservation
+	if rateLimitEnabled(config.PerUser) && user != "" {
+		reservations = append(reservations, l.limiter("user/"+user, config.PerUser).ReserveN(now, 1))
+	}
+	if rateLimitEnabled(config.PerNamespace) && namespace != "" {
+		reservations = append(reservations, l.limiter("namespace/"+namespace, config.PerNamespace).ReserveN(now, 1))
+	}
+
+	var delay time.Duration
+	for _, reservation := range reservations {
+		if d := reservation.DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+	if delay > 0 {
+		for _, reservation := range reservations {
+			reservation.CancelAt(now)
+		}
+	}
+	return delay
+}
+
+func (l *SubresourceRateLimiter) limiter(key string, limit *v1.TokenBucketRateLimiter) *rate.Limiter {
+	limiter, exists := l.limiters[key]
+	if !exists {
+		burst := limit.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(limit.QPS), burst)
+		l.limiters[key] = limiter
+	}
+	return limiter
+}
+
+// sweep drops the buckets which refilled completely, they behave like new ones
+func (l *SubresourceRateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimiterSweepInterval {
+		return
+	}
+	for key, limiter := range l.limiters {
+		if limiter.TokensAt(now) >= float64(limiter.Burst()) {
+			delete(l.limiters, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+func (l *SubresourceRateLimiter) userName(header http.Header) string {
+	for _, key := range l.authorizor.GetUserHeaders() {
+		if user := header.Get(key); user != "" {
+			return user
+		}
+	}
+	return ""
+}
+
+func rateLimitEnabled(limit *v1.TokenBucketRateLimiter) bool {
+	return limit != nil && limit.QPS > 0
+}
+
+// rateLimited rejects the request with 429 Too Many Requests and a Retry-After header if its user
+// or its namespace exceeded the subresource rate limits
+func (app *SubresourceAPIApp) rateLimited(request *restful.Request, response *restful.Response) bool {
+	if app.rateLimiter == nil {
+		return false
+	}
+
+	user := app.rateLimiter.userName(request.Request.Header)
+	namespace := request.PathParameter("namespace")
+	delay := app.rateLimiter.reserve(user, namespace)
+	if delay <= 0 {
+		return false
+	}
+
+	retryAfter := int(math.Ceil(delay.Seconds()))
+	log.Log.V(2).Infof("Rate limited %s request of user %q in namespace %s", request.Request.URL.Path, user, namespace)
+	response.AddHeader("Retry-After", strconv.Itoa(retryAfter))
+	writeError(errors.NewTooManyRequests(fmt.Sprintf("subresource rate limit exceeded, retry after %ds", retryAfter), retryAfter), response)
+	return true
+}
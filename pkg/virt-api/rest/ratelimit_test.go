@@ -0,0 +1,140 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Subresource rate limits", func() {
+	var (
+		kvStore     cache.Store
+		rateLimiter *SubresourceRateLimiter
+		now         time.Time
+	)
+
+	setRateLimits := func(rateLimits *v1.SubresourceRateLimitsConfiguration) {
+		kv := testutils.GetFakeKubeVirtClusterConfig(kvStore)
+		kv.Spec.Configuration.SubresourceRateLimits = rateLimits
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
+	}
+
+	BeforeEach(func() {
+		clusterConfig, _, store := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			SubresourceRateLimits: &v1.SubresourceRateLimitsConfiguration{
+				PerUser:      &v1.TokenBucketRateLimiter{QPS: 1, Burst: 2},
+				PerNamespace: &v1.TokenBucketRateLimiter{QPS: 2, Burst: 3},
+			},
+		})
+		kvStore = store
+		rateLimiter = NewSubresourceRateLimiter(clusterConfig, NewAuthorizorFromClient(nil))
+		now = time.Now()
+		rateLimiter.now = func() time.Time { return now }
+	})
+
+	It("should admit requests up to the burst of the user", func() {
+		Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		Expect(rateLimiter.reserve("alice", "ns2")).To(BeZero())
+		Expect(rateLimiter.reserve("alice", "ns3")).To(Equal(time.Second))
+
+		now = now.Add(time.Second)
+		Expect(rateLimiter.reserve("alice", "ns3")).To(BeZero())
+	})
+
+	It("should limit all users of a namespace together", func() {
+		Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		Expect(rateLimiter.reserve("bob", "ns1")).To(BeZero())
+		Expect(rateLimiter.reserve("carol", "ns1")).To(BeZero())
+		Expect(rateLimiter.reserve("dave", "ns1")).To(Equal(500 * time.Millisecond))
+		Expect(rateLimiter.reserve("dave", "ns2")).To(BeZero())
+	})
+
+	It("should not take tokens of rejected requests", func() {
+		Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		for i := 0; i < 5; i++ {
+			Expect(rateLimiter.reserve("alice", "ns1")).ToNot(BeZero())
+		}
+		Expect(rateLimiter.reserve("bob", "ns1")).To(BeZero())
+	})
+
+	It("should pick up changed limits", func() {
+		Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		Expect(rateLimiter.reserve("alice", "ns1")).ToNot(BeZero())
+
+		setRateLimits(&v1.SubresourceRateLimitsConfiguration{
+			PerUser: &v1.TokenBucketRateLimiter{QPS: 1, Burst: 10},
+		})
+		for i := 0; i < 10; i++ {
+			Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		}
+
+		setRateLimits(nil)
+		for i := 0; i < 20; i++ {
+			Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		}
+	})
+
+	It("should drop refilled buckets", func() {
+		Expect(rateLimiter.reserve("alice", "ns1")).To(BeZero())
+		Expect(rateLimiter.limiters).To(HaveLen(2))
+
+		now = now.Add(rateLimiterSweepInterval)
+		Expect(rateLimiter.reserve("bob", "ns2")).To(BeZero())
+		Expect(rateLimiter.limiters).To(HaveLen(2))
+		Expect(rateLimiter.limiters).To(HaveKey("user/bob"))
+		Expect(rateLimiter.limiters).To(HaveKey("namespace/ns2"))
+	})
+
+	DescribeTable("should reject requests of a rate limited user with 429 and Retry-After", func(handler func(*SubresourceAPIApp, *restful.Request, *restful.Response)) {
+		app := NewSubresourceAPIApp(nil, 0, nil, nil, rateLimiter, nil, nil, nil)
+		request := restful.NewRequest(&http.Request{URL: &url.URL{Path: "/expand-spec"}, Header: http.Header{}})
+		request.Request.Header.Set(userHeader, "alice")
+		request.PathParameters()["namespace"] = "ns1"
+		request.PathParameters()["name"] = "vm"
+		Expect(rateLimiter.reserve("alice", "ns2")).To(BeZero())
+		Expect(rateLimiter.reserve("alice", "ns2")).To(BeZero())
+
+		recorder := httptest.NewRecorder()
+		response := restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+		handler(app, request, response)
+
+		statusErr := ExpectStatusErrorWithCode(recorder, http.StatusTooManyRequests)
+		Expect(statusErr.Status().Details.RetryAfterSeconds).To(BeEquivalentTo(1))
+		Expect(recorder.Header().Get("Retry-After")).To(Equal("1"))
+	},
+		Entry("expand-vm-spec", (*SubresourceAPIApp).ExpandSpecVMRequestHandler),
+		Entry("capacity-forecast", (*SubresourceAPIApp).CapacityForecastRequestHandler),
+	)
+})
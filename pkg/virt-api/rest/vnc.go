@@ -24,6 +24,10 @@ import (
 )
 
 func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, response *restful.Response) {
+	if app.rateLimited(request, response) {
+		return
+	}
+
 	activeConnectionMetric := apimetrics.NewActiveVNCConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
 
@@ -44,6 +48,10 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 // which it returns to the caller. No websocket connection will be forwarded to the client.
 // This is inspired by https://raw.githubusercontent.com/hexylena/vnc-screenshot/9f609b72518d6d6ab5149502a6be1dd3c5b015c8/vnc-screenshot.go.
 func (app *SubresourceAPIApp) VNCScreenshotRequestHandler(request *restful.Request, response *restful.Response) {
+	if app.rateLimited(request, response) {
+		return
+	}
+
 	activeConnectionMetric := apimetrics.NewActiveVNCConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
 
@@ -97,18 +97,12 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	// Set VM defaults
 	log.Log.Object(&vm).V(4).Info("Apply defaults")
 
-	if err = mutator.InstancetypeMethods.InferDefaultInstancetype(&vm); err != nil {
-		log.Log.Reason(err).Error("admission failed, unable to set default instancetype")
-		return &admissionv1.AdmissionResponse{
-			Result: &metav1.Status{
-				Message: err.Error(),
-				Code:    http.StatusBadRequest,
-			},
-		}
+	var original *v1.VirtualMachine
+	if log.Log.Verbosity(5) {
+		original = vm.DeepCopy()
 	}
 
-	if err = mutator.InstancetypeMethods.InferDefaultPreference(&vm); err != nil {
-		log.Log.Reason(err).Error("admission failed, unable to set default preference")
+	if err = mutator.ApplyDefaults(&vm); err != nil {
 		return &admissionv1.AdmissionResponse{
 			Result: &metav1.Status{
 				Message: err.Error(),
@@ -117,12 +111,9 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 		}
 	}
 
-	mutator.setDefaultInstancetypeKind(&vm)
-	mutator.setDefaultPreferenceKind(&vm)
-	preferenceSpec := mutator.getPreferenceSpec(&vm)
-	mutator.setDefaultArchitecture(&vm)
-	mutator.setDefaultMachineType(&vm, preferenceSpec)
-	mutator.setPreferenceStorageClassName(&vm, preferenceSpec)
+	if original != nil {
+		logMutations(original, &vm)
+	}
 
 	patchBytes, err := patch.GeneratePatchPayload(
 		patch.PatchOperation{
@@ -155,6 +146,42 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	}
 }
 
+// ApplyDefaults sets the defaults of the VM, including the ones derived from its preference
+func (mutator *VMsMutator) ApplyDefaults(vm *v1.VirtualMachine) error {
+	if err := mutator.InstancetypeMethods.InferDefaultInstancetype(vm); err != nil {
+		log.Log.Reason(err).Error("admission failed, unable to set default instancetype")
+		return err
+	}
+
+	if err := mutator.InstancetypeMethods.InferDefaultPreference(vm); err != nil {
+		log.Log.Reason(err).Error("admission failed, unable to set default preference")
+		return err
+	}
+
+	mutator.setDefaultInstancetypeKind(vm)
+	mutator.setDefaultPreferenceKind(vm)
+	preferenceSpec := mutator.getPreferenceSpec(vm)
+	mutator.setDefaultArchitecture(vm)
+	mutator.setDefaultMachineType(vm, preferenceSpec)
+	mutator.setPreferenceStorageClassName(vm, preferenceSpec)
+	return nil
+}
+
+// logMutations logs the single mutations applied to the VM, the patch sent back replaces its spec and metadata as a whole
+func logMutations(original, mutated *v1.VirtualMachine) {
+	mutations, err := patch.Diff(original, mutated)
+	if err != nil {
+		log.Log.Object(mutated).Reason(err).Error("failed to determine the mutations of the VM")
+		return
+	}
+	mutationsJSON, err := json.Marshal(mutations)
+	if err != nil {
+		log.Log.Object(mutated).Reason(err).Error("failed to marshal the mutations of the VM")
+		return
+	}
+	log.Log.Object(mutated).V(5).Infof("Mutations: %s", mutationsJSON)
+}
+
 func (mutator *VMsMutator) getPreferenceSpec(vm *v1.VirtualMachine) *instancetypev1beta1.VirtualMachinePreferenceSpec {
 	preferenceSpec, err := mutator.InstancetypeMethods.FindPreferenceSpec(vm)
 	if err != nil {
@@ -26,8 +26,10 @@ import (
 	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -44,6 +46,7 @@ import (
 type VMIsMutator struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	VMIPresetInformer       cache.SharedIndexInformer
+	NamespaceInformer       cache.SharedIndexInformer
 	KubeVirtServiceAccounts map[string]struct{}
 }
 
@@ -79,6 +82,9 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			}
 		}
 
+		// Attach the tools disk before applying the defaults, so that its disk gets a bus
+		mutator.attachToolsDisk(newVMI)
+
 		// Set VirtualMachineInstance defaults
 		log.Log.Object(newVMI).V(4).Info("Apply defaults")
 		if err = webhooks.SetDefaultVirtualMachineInstance(mutator.ClusterConfig, newVMI); err != nil {
@@ -126,6 +132,8 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			util.MarkAsNonroot(newVMI)
 		}
 
+		pinFirmwareImage(mutator.ClusterConfig, newVMI)
+
 		patchSet.AddOption(
 			patch.WithReplace("/spec", newVMI.Spec),
 			patch.WithReplace("/metadata", newVMI.ObjectMeta),
@@ -171,9 +179,112 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 	return response
 }
 
+// pinFirmwareImage records the firmware image selected by the VMI in its status,
+// so that later changes of the KubeVirt configuration don't affect the VMI.
+// A status provided by the user is always dropped.
+func pinFirmwareImage(clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) {
+	vmi.Status.FirmwareImageStatus = nil
+	if vmi.Spec.Domain.Firmware == nil || vmi.Spec.Domain.Firmware.ImageName == "" {
+		return
+	}
+
+	image, exists := clusterConfig.GetFirmwareImage(vmi.Spec.Domain.Firmware.ImageName)
+	if !exists {
+		// rejected by the validating webhook
+		return
+	}
+
+	path := image.Path
+	if path == "" {
+		path = virtconfig.DefaultFirmwareImagePath
+	}
+
+	vmi.Status.FirmwareImageStatus = &v1.FirmwareImageStatus{
+		Name:            image.Name,
+		Image:           image.Image,
+		ImagePullPolicy: image.ImagePullPolicy,
+		Path:            path,
+	}
+}
+
 func addNodeSelector(vmi *v1.VirtualMachineInstance, label string) {
 	if vmi.Spec.NodeSelector == nil {
 		vmi.Spec.NodeSelector = map[string]string{}
 	}
 	vmi.Spec.NodeSelector[label] = ""
 }
+
+// attachToolsDisk attaches the tools disk of the KubeVirt configuration as CD-ROM to VMIs in the selected namespaces.
+// Since it is attached to the VMI only, VMs pick up a changed tools disk on their next start.
+func (mutator *VMIsMutator) attachToolsDisk(vmi *v1.VirtualMachineInstance) {
+	toolsDisk := mutator.ClusterConfig.GetConfig().ToolsDisk
+	if toolsDisk == nil || toolsDisk.Image == "" {
+		return
+	}
+
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == util.ToolsDiskName {
+			return
+		}
+	}
+	for _, disk := range vmi.Spec.Domain.Devices.Disks {
+		if disk.Name == util.ToolsDiskName {
+			return
+		}
+	}
+
+	if !mutator.isNamespaceSelected(vmi.Namespace, toolsDisk.NamespaceLabelSelector) {
+		return
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Attach tools disk %s", toolsDisk.Image)
+	vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+		Name: util.ToolsDiskName,
+		DiskDevice: v1.DiskDevice{
+			CDRom: &v1.CDRomTarget{},
+		},
+	})
+	vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+		Name: util.ToolsDiskName,
+		VolumeSource: v1.VolumeSource{
+			ContainerDisk: &v1.ContainerDiskSource{
+				Image:           toolsDisk.Image,
+				ImagePullPolicy: toolsDisk.ImagePullPolicy,
+			},
+		},
+	})
+}
+
+func (mutator *VMIsMutator) isNamespaceSelected(namespace string, labelSelector *metav1.LabelSelector) bool {
+	if labelSelector == nil {
+		return true
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		log.Log.Reason(err).Warning("invalid tools disk namespace label selector set, assuming none")
+		return false
+	}
+
+	if mutator.NamespaceInformer == nil {
+		log.Log.Warning("empty namespace informer")
+		return false
+	}
+
+	obj, exists, err := mutator.NamespaceInformer.GetStore().GetByKey(namespace)
+	if err != nil {
+		log.Log.Reason(err).Warningf("failed to retrieve namespace %s from informer", namespace)
+		return false
+	} else if !exists {
+		log.Log.Warningf("namespace %s does not exist", namespace)
+		return false
+	}
+
+	ns, ok := obj.(*k8sv1.Namespace)
+	if !ok {
+		log.Log.Errorf("couldn't cast object to Namespace: %+v", obj)
+		return false
+	}
+
+	return selector.Matches(labels.Set(ns.Labels))
+}
@@ -46,6 +46,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	kvpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	nodelabellerutil "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller/util"
@@ -1298,6 +1299,154 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Expect(*status.Memory.GuestRequested).To(Equal(memory))
 	})
 
+	Context("firmware image", func() {
+		BeforeEach(func() {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						FirmwareImages: []v1.FirmwareImage{
+							{Name: "ovmf-stable", Image: "registry:5000/ovmf:stable", ImagePullPolicy: k8sv1.PullAlways},
+							{Name: "ovmf-custom", Image: "registry:5000/ovmf:custom", Path: "/firmware"},
+						},
+					},
+				},
+			})
+		})
+
+		It("should not pin a firmware image if none is selected", func() {
+			_, _, status := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(status.FirmwareImageStatus).To(BeNil())
+		})
+
+		DescribeTable("should pin the selected firmware image in the status", func(imageName string, expectedStatus *v1.FirmwareImageStatus) {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{ImageName: imageName}
+			_, _, status := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(status.FirmwareImageStatus).To(Equal(expectedStatus))
+		},
+			Entry("with the default path", "ovmf-stable", &v1.FirmwareImageStatus{
+				Name:            "ovmf-stable",
+				Image:           "registry:5000/ovmf:stable",
+				ImagePullPolicy: k8sv1.PullAlways,
+				Path:            virtconfig.DefaultFirmwareImagePath,
+			}),
+			Entry("with a custom path", "ovmf-custom", &v1.FirmwareImageStatus{
+				Name:  "ovmf-custom",
+				Image: "registry:5000/ovmf:custom",
+				Path:  "/firmware",
+			}),
+		)
+
+		It("should not pin an unknown firmware image", func() {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{ImageName: "unknown"}
+			_, _, status := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(status.FirmwareImageStatus).To(BeNil())
+		})
+
+		DescribeTable("should drop a firmware image status provided by the user", func(firmware *v1.Firmware) {
+			vmi.Spec.Domain.Firmware = firmware
+			vmi.Status.FirmwareImageStatus = &v1.FirmwareImageStatus{
+				Name:  "ovmf-stable",
+				Image: "registry:5000/evil:latest",
+				Path:  "/evil",
+			}
+			_, _, status := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(status.FirmwareImageStatus).To(BeNil())
+		},
+			Entry("without a firmware", nil),
+			Entry("without a selected firmware image", &v1.Firmware{}),
+			Entry("with an unknown firmware image", &v1.Firmware{ImageName: "unknown"}),
+		)
+
+		It("should replace a firmware image status provided by the user", func() {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{ImageName: "ovmf-stable"}
+			vmi.Status.FirmwareImageStatus = &v1.FirmwareImageStatus{
+				Name:  "ovmf-stable",
+				Image: "registry:5000/evil:latest",
+				Path:  "/evil",
+			}
+			_, _, status := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(status.FirmwareImageStatus).To(Equal(&v1.FirmwareImageStatus{
+				Name:            "ovmf-stable",
+				Image:           "registry:5000/ovmf:stable",
+				ImagePullPolicy: k8sv1.PullAlways,
+				Path:            virtconfig.DefaultFirmwareImagePath,
+			}))
+		})
+	})
+
+	Context("tools disk", func() {
+		const toolsImage = "registry:5000/tools:latest"
+
+		setToolsDisk := func(namespaceLabelSelector *k8smetav1.LabelSelector) {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						ToolsDisk: &v1.ToolsDiskConfiguration{
+							Image:                  toolsImage,
+							NamespaceLabelSelector: namespaceLabelSelector,
+						},
+					},
+				},
+			})
+		}
+
+		BeforeEach(func() {
+			vmi.Namespace = "tools-namespace"
+			namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+			Expect(namespaceInformer.GetStore().Add(&k8sv1.Namespace{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:   vmi.Namespace,
+					Labels: map[string]string{"tools": "true"},
+				},
+			})).To(Succeed())
+			mutator.NamespaceInformer = namespaceInformer
+		})
+
+		It("should not attach a tools disk if none is configured", func() {
+			_, spec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(spec.Volumes).To(BeEmpty())
+			Expect(spec.Domain.Devices.Disks).To(BeEmpty())
+		})
+
+		DescribeTable("should attach the tools disk as read-only CD-ROM", func(namespaceLabelSelector *k8smetav1.LabelSelector) {
+			setToolsDisk(namespaceLabelSelector)
+			_, spec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(spec.Volumes).To(ConsistOf(v1.Volume{
+				Name: util.ToolsDiskName,
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{Image: toolsImage, ImagePullPolicy: k8sv1.PullAlways},
+				},
+			}))
+			Expect(spec.Domain.Devices.Disks).To(HaveLen(1))
+			Expect(spec.Domain.Devices.Disks[0].Name).To(Equal(util.ToolsDiskName))
+			Expect(spec.Domain.Devices.Disks[0].CDRom).ToNot(BeNil())
+			Expect(spec.Domain.Devices.Disks[0].CDRom.ReadOnly).To(HaveValue(BeTrue()))
+			Expect(spec.Domain.Devices.Disks[0].CDRom.Bus).ToNot(BeEmpty())
+		},
+			Entry("in all namespaces", nil),
+			Entry("in the selected namespaces", &k8smetav1.LabelSelector{MatchLabels: map[string]string{"tools": "true"}}),
+		)
+
+		It("should not attach the tools disk in namespaces which are not selected", func() {
+			setToolsDisk(&k8smetav1.LabelSelector{MatchLabels: map[string]string{"tools": "false"}})
+			_, spec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(spec.Volumes).To(BeEmpty())
+		})
+
+		It("should not replace a volume named like the tools disk", func() {
+			setToolsDisk(nil)
+			vmi.Spec.Volumes = []v1.Volume{{
+				Name: util.ToolsDiskName,
+				VolumeSource: v1.VolumeSource{
+					ContainerDisk: &v1.ContainerDiskSource{Image: "registry:5000/own-tools:latest"},
+				},
+			}}
+			_, spec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
+			Expect(spec.Volumes).To(HaveLen(1))
+			Expect(spec.Volumes[0].ContainerDisk.Image).To(Equal("registry:5000/own-tools:latest"))
+		})
+	})
+
 	Context("CPU topology", func() {
 		It("should set default CPU topology in Status when not provided by VMI", func() {
 			vmi.Spec.Domain.CPU = nil
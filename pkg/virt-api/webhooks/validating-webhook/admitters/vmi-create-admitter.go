@@ -26,6 +26,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -37,6 +38,7 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	unversionedvalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -164,6 +166,8 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateGuestMemoryLimit(field, spec, config)...)
 	causes = append(causes, validateEmulatedMachine(field, spec, config)...)
 	causes = append(causes, validateFirmwareSerial(field, spec)...)
+	causes = append(causes, validateFirmwareImageName(field, spec, config)...)
+	causes = append(causes, validateTPM(field, spec)...)
 	causes = append(causes, validateCPURequestNotNegative(field, spec)...)
 	causes = append(causes, validateCPULimitNotNegative(field, spec)...)
 	causes = append(causes, validateCpuRequestDoesNotExceedLimit(field, spec)...)
@@ -173,9 +177,13 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
 	causes = append(causes, validateCPUHotplug(field, spec)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
+	causes = append(causes, validateShutdownPolicy(field, spec)...)
+	causes = append(causes, validateKernelBootVolumeReferences(field, spec)...)
 	causes = append(causes, validateRealtime(field, spec)...)
 	causes = append(causes, validateSpecAffinity(field, spec)...)
 	causes = append(causes, validateSpecTopologySpreadConstraints(field, spec)...)
+	causes = append(causes, validatePodOverlay(field, spec, config)...)
+	causes = append(causes, validateSecurityProfile(field, spec, config)...)
 	causes = append(causes, validateArchitecture(field, spec, config)...)
 
 	netValidator := netadmitter.NewValidator(field, spec, config)
@@ -206,12 +214,14 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateLiveMigration(field, spec, config)...)
 	causes = append(causes, validateGPUsWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
+	causes = append(causes, validateResourceClaims(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
 	causes = append(causes, validatePersistentState(field, spec, config)...)
 	causes = append(causes, validateDownwardMetrics(field, spec, config)...)
+	causes = append(causes, validateEphemeralStorage(field, spec, config)...)
 
 	return causes
 }
@@ -231,6 +241,33 @@ func validateDownwardMetrics(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 	return causes
 }
 
+func validateEphemeralStorage(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.EphemeralStorage == nil || !spec.EphemeralStorage.Encrypted {
+		return causes
+	}
+
+	encryptedField := field.Child("ephemeralStorage", "encrypted")
+	if !config.EncryptedEphemeralStorageEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.EncryptedEphemeralStorageGate),
+			Field:   encryptedField.String(),
+		})
+	}
+
+	// virt-launcher only ships the libvirt secret driver, which holds the key, on amd64
+	if spec.Architecture != "" && spec.Architecture != "amd64" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not supported on architecture %s", encryptedField.String(), spec.Architecture),
+			Field:   encryptedField.String(),
+		})
+	}
+
+	return causes
+}
+
 func validateVirtualMachineInstanceSpecVolumeDisks(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
@@ -449,6 +486,77 @@ func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.Vi
 	return causes
 }
 
+func validateResourceClaims(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	claimNames := make(map[string]struct{}, len(spec.ResourceClaims))
+	for idx, claim := range spec.ResourceClaims {
+		claimField := field.Child("resourceClaims").Index(idx)
+		if _, exists := claimNames[claim.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s has a duplicate name %s", claimField.String(), claim.Name),
+				Field:   claimField.Child("name").String(),
+			})
+		}
+		claimNames[claim.Name] = struct{}{}
+		for _, msg := range validation.IsDNS1123Label(claim.Name) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid name: %s", claimField.Child("name").String(), msg),
+				Field:   claimField.Child("name").String(),
+			})
+		}
+		if (claim.Source.ResourceClaimName == nil) == (claim.Source.ResourceClaimTemplateName == nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must set exactly one of resourceClaimName and resourceClaimTemplateName", claimField.Child("source").String()),
+				Field:   claimField.Child("source").String(),
+			})
+		}
+	}
+
+	validateDevice := func(deviceField *k8sfield.Path, deviceName string, claim *v1.ClaimRequest) {
+		if (deviceName == "") == (claim == nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must set exactly one of deviceName and claim", deviceField.String()),
+				Field:   deviceField.String(),
+			})
+			return
+		}
+		if claim == nil {
+			return
+		}
+		if _, exists := claimNames[claim.ClaimName]; !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s references the resource claim %s which does not exist in %s", deviceField.String(), claim.ClaimName, field.Child("resourceClaims").String()),
+				Field:   deviceField.Child("claim", "claimName").String(),
+			})
+		}
+	}
+	usesClaims := len(spec.ResourceClaims) > 0
+	for idx, gpu := range spec.Domain.Devices.GPUs {
+		validateDevice(field.Child("domain", "devices", "gpus").Index(idx), gpu.DeviceName, gpu.Claim)
+		usesClaims = usesClaims || gpu.Claim != nil
+	}
+	for idx, hostDevice := range spec.Domain.Devices.HostDevices {
+		validateDevice(field.Child("domain", "devices", "hostDevices").Index(idx), hostDevice.DeviceName, hostDevice.Claim)
+		usesClaims = usesClaims || hostDevice.Claim != nil
+	}
+
+	if usesClaims && !config.DynamicResourceAllocationEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.DynamicResourceAllocationGate),
+			Field:   field.Child("resourceClaims").String(),
+		})
+	}
+
+	return causes
+}
+
 func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.Devices.Sound == nil {
@@ -777,6 +885,60 @@ func validateStartStrategy(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 	return causes
 }
 
+func validateShutdownPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	policy := spec.ShutdownPolicy
+	if policy == nil {
+		return causes
+	}
+	policyField := field.Child("shutdownPolicy")
+
+	if policy.ACPIRetryIntervalSeconds != nil && *policy.ACPIRetryIntervalSeconds == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", policyField.Child("acpiRetryIntervalSeconds").String()),
+			Field:   policyField.Child("acpiRetryIntervalSeconds").String(),
+		})
+	}
+
+	if policy.GuestAgentFallback && policy.ACPIRetries == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s requires %s to be set",
+				policyField.Child("guestAgentFallback").String(),
+				policyField.Child("acpiRetries").String(),
+			),
+			Field: policyField.Child("acpiRetries").String(),
+		})
+	}
+
+	if policy.ForceOffTimeoutSeconds != nil {
+		gracePeriodSeconds := v1.DefaultGracePeriodSeconds
+		if spec.TerminationGracePeriodSeconds != nil {
+			gracePeriodSeconds = *spec.TerminationGracePeriodSeconds
+		}
+		if *policy.ForceOffTimeoutSeconds < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", policyField.Child("forceOffTimeoutSeconds").String()),
+				Field:   policyField.Child("forceOffTimeoutSeconds").String(),
+			})
+		} else if *policy.ForceOffTimeoutSeconds > gracePeriodSeconds {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not exceed %s (%d)",
+					policyField.Child("forceOffTimeoutSeconds").String(),
+					field.Child("terminationGracePeriodSeconds").String(),
+					gracePeriodSeconds,
+				),
+				Field: policyField.Child("forceOffTimeoutSeconds").String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 func validateMemoryRequestsAndLimits(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.Resources.Requests.Memory().Value() > 0 && spec.Domain.Resources.Limits.Memory().Value() > 0 && spec.Domain.Resources.Requests.Memory().Value() != spec.Domain.Resources.Limits.Memory().Value() {
@@ -873,6 +1035,23 @@ func validateCPURequestNotNegative(field *k8sfield.Path, spec *v1.VirtualMachine
 	return causes
 }
 
+func validateFirmwareImageName(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Firmware == nil || spec.Domain.Firmware.ImageName == "" {
+		return causes
+	}
+
+	if _, exists := config.GetFirmwareImage(spec.Domain.Firmware.ImageName); !exists {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s references firmware image %q which is not configured in the KubeVirt CR", field.Child("domain", "firmware", "imageName").String(), spec.Domain.Firmware.ImageName),
+			Field:   field.Child("domain", "firmware", "imageName").String(),
+		})
+	}
+
+	return causes
+}
+
 func validateFirmwareSerial(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.Firmware == nil || len(spec.Domain.Firmware.Serial) == 0 {
@@ -2151,6 +2330,51 @@ func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav
 		return causes
 	}
 
+	if kernelBoot.KernelArgs != "" && kernelBoot.KernelArgsFrom != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s and %s are mutually exclusive", field.Child("kernelArgs"), field.Child("kernelArgsFrom")),
+			Field:   field.Child("kernelArgsFrom").String(),
+		})
+	}
+
+	if argsFrom := kernelBoot.KernelArgsFrom; argsFrom != nil {
+		argsFromField := field.Child("kernelArgsFrom")
+		if argsFrom.VolumeName == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must be defined with a volumeName", argsFromField),
+				Field:   argsFromField.Child("volumeName").String(),
+			})
+		}
+		if argsFrom.Key == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must be defined with a key", argsFromField),
+				Field:   argsFromField.Child("key").String(),
+			})
+		} else if strings.Contains(argsFrom.Key, "/") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not contain '/'", argsFromField.Child("key")),
+				Field:   argsFromField.Child("key").String(),
+			})
+		}
+	}
+
+	if kernelBoot.Container != nil && kernelBoot.Volume != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s and %s are mutually exclusive", field.Child("container"), field.Child("volume")),
+			Field:   field.Child("volume").String(),
+		})
+		return causes
+	}
+
+	if kernelBoot.Volume != nil {
+		return append(causes, validateKernelBootVolume(field.Child("volume"), kernelBoot.Volume)...)
+	}
+
 	if kernelBoot.Container == nil {
 		if kernelBoot.KernelArgs != "" {
 			causes = append(causes, metav1.StatusCause{
@@ -2159,6 +2383,13 @@ func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav
 				Field:   field.Child("kernelArgs").String(),
 			})
 		}
+		if kernelBoot.KernelArgsFrom != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "kernel arguments cannot be provided without an external kernel",
+				Field:   field.Child("kernelArgsFrom").String(),
+			})
+		}
 		return causes
 	}
 
@@ -2191,6 +2422,92 @@ func validateKernelBoot(field *k8sfield.Path, kernelBoot *v1.KernelBoot) []metav
 	return causes
 }
 
+func validateKernelBootVolume(field *k8sfield.Path, volume *v1.KernelBootVolume) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if volume.Name == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be defined with a name", field),
+			Field:   field.Child("name").String(),
+		})
+	}
+
+	if volume.InitrdPath == "" && volume.KernelPath == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must be defined with at least one of the following: kernelPath, initrdPath", field),
+			Field:   field.String(),
+		})
+	}
+
+	if volume.KernelPath != "" {
+		causes = append(causes, validatePath(field.Child("kernelPath"), volume.KernelPath)...)
+	}
+	if volume.InitrdPath != "" {
+		causes = append(causes, validatePath(field.Child("initrdPath"), volume.InitrdPath)...)
+	}
+
+	return causes
+}
+
+// Rejects kernel boot artifacts and arguments referencing volumes which are missing or of an unsupported type
+func validateKernelBootVolumeReferences(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.Domain.Firmware == nil || spec.Domain.Firmware.KernelBoot == nil {
+		return causes
+	}
+	kernelBoot := spec.Domain.Firmware.KernelBoot
+	kernelBootField := field.Child("domain", "firmware", "kernelBoot")
+
+	volumes := make(map[string]*v1.Volume)
+	for i := range spec.Volumes {
+		volumes[spec.Volumes[i].Name] = &spec.Volumes[i]
+	}
+
+	if kernelBoot.Volume != nil && kernelBoot.Volume.Name != "" {
+		nameField := kernelBootField.Child("volume", "name")
+		volume, exists := volumes[kernelBoot.Volume.Name]
+		if !exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s '%s' not found.", nameField, kernelBoot.Volume.Name),
+				Field:   nameField.String(),
+			})
+		} else if volume.ConfigMap == nil && volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must reference a ConfigMap, PersistentVolumeClaim or DataVolume volume", nameField),
+				Field:   nameField.String(),
+			})
+		}
+
+		for i, disk := range spec.Domain.Devices.Disks {
+			if disk.Name == kernelBoot.Volume.Name {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s '%s' must not be used by a disk", nameField, kernelBoot.Volume.Name),
+					Field:   field.Child("domain", "devices", "disks").Index(i).Child("name").String(),
+				})
+			}
+		}
+	}
+
+	if kernelBoot.KernelArgsFrom != nil && kernelBoot.KernelArgsFrom.VolumeName != "" {
+		volumeNameField := kernelBootField.Child("kernelArgsFrom", "volumeName")
+		volume, exists := volumes[kernelBoot.KernelArgsFrom.VolumeName]
+		if !exists || volume.ConfigMap == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must reference a ConfigMap volume", volumeNameField),
+				Field:   volumeNameField.String(),
+			})
+		}
+	}
+
+	return causes
+}
+
 // validateSpecAffinity is function that validate spec.affinity
 // instead of bring in the whole kubernetes lib we simply copy it from kubernetes/pkg/apis/core/validation/validation.go
 func validateSpecAffinity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
@@ -2235,6 +2552,95 @@ func validateSpecTopologySpreadConstraints(field *k8sfield.Path, spec *v1.Virtua
 	return causes
 }
 
+// validatePodOverlay validates the labels, tolerations, topology spread constraints and runtime class of spec.podOverlay
+func validatePodOverlay(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.PodOverlay == nil {
+		return causes
+	}
+	overlayField := field.Child("podOverlay")
+
+	errorList := unversionedvalidation.ValidateLabels(spec.PodOverlay.Labels, overlayField.Child("labels"))
+	errorList = append(errorList, validateTolerations(spec.PodOverlay.Tolerations, overlayField.Child("tolerations"))...)
+	errorList = append(errorList, validateTopologySpreadConstraints(spec.PodOverlay.TopologySpreadConstraints, overlayField.Child("topologySpreadConstraints"))...)
+	if spec.PodOverlay.RuntimeClassName != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*spec.PodOverlay.RuntimeClassName) {
+			errorList = append(errorList, k8sfield.Invalid(overlayField.Child("runtimeClassName"), *spec.PodOverlay.RuntimeClassName, msg))
+		}
+	}
+
+	//convert errorList to []metav1.StatusCause
+	for _, validationErr := range errorList {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: validationErr.Error(),
+			Field:   validationErr.Field,
+		})
+	}
+
+	if runtimeClassName := config.GetDefaultRuntimeClass(); runtimeClassName != "" &&
+		spec.PodOverlay.RuntimeClassName != nil && *spec.PodOverlay.RuntimeClassName != runtimeClassName {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("runtimeClassName %s conflicts with the default runtime class %s of the cluster", *spec.PodOverlay.RuntimeClassName, runtimeClassName),
+			Field:   overlayField.Child("runtimeClassName").String(),
+		})
+	}
+
+	for label := range spec.PodOverlay.Labels {
+		if isKubeVirtLabel(label) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("label %s is reserved and cannot be added to the virt-launcher pod", label),
+				Field:   overlayField.Child("labels").Key(label).String(),
+			})
+		}
+	}
+
+	return causes
+}
+
+// validateSecurityProfile ensures that spec.securityProfile only selects seccomp profiles and SELinux types
+// which are safelisted in the KubeVirt configuration
+func validateSecurityProfile(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if spec.SecurityProfile == nil {
+		return causes
+	}
+	profileField := field.Child("securityProfile")
+
+	allowed := config.GetConfig().SecurityProfiles
+	if allowed == nil {
+		allowed = &v1.SecurityProfilesConfiguration{}
+	}
+
+	if seccompProfile := spec.SecurityProfile.SeccompLocalhostProfile; seccompProfile != "" &&
+		!slices.Contains(allowed.AllowedSeccompLocalhostProfiles, seccompProfile) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("seccomp profile %s is not allowed by the KubeVirt configuration", seccompProfile),
+			Field:   profileField.Child("seccompLocalhostProfile").String(),
+		})
+	}
+
+	if selinuxType := spec.SecurityProfile.SELinuxType; selinuxType != "" &&
+		!slices.Contains(allowed.AllowedSELinuxTypes, selinuxType) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("SELinux type %s is not allowed by the KubeVirt configuration", selinuxType),
+			Field:   profileField.Child("selinuxType").String(),
+		})
+	}
+
+	return causes
+}
+
+// isKubeVirtLabel returns true if the label, or its prefix, is in the kubevirt.io domain
+func isKubeVirtLabel(label string) bool {
+	domain, _, _ := strings.Cut(label, "/")
+	return domain == "kubevirt.io" || strings.HasSuffix(domain, ".kubevirt.io")
+}
+
 func validateVSOCK(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if spec.Domain.Devices.AutoattachVSOCK == nil || !*spec.Domain.Devices.AutoattachVSOCK {
@@ -2269,6 +2675,26 @@ func validatePersistentReservation(field *k8sfield.Path, spec *v1.VirtualMachine
 	return causes
 }
 
+func validateTPM(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	tpm := spec.Domain.Devices.TPM
+	if tpm == nil {
+		return causes
+	}
+
+	switch tpm.Version {
+	case "", v1.TPMVersion12, v1.TPMVersion20:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be either %s or %s", field.Child("domain", "devices", "tpm", "version").String(), v1.TPMVersion12, v1.TPMVersion20),
+			Field:   field.Child("domain", "devices", "tpm", "version").String(),
+		})
+	}
+
+	return causes
+}
+
 func validatePersistentState(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	if !backendstorage.IsBackendStorageNeededForVMI(spec) {
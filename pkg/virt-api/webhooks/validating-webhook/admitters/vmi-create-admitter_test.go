@@ -1417,6 +1417,143 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
 			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.livenessProbe should be provided.Pausing VMI with LivenessProbe is not supported"))
 		})
+		DescribeTable("should validate the shutdown policy", func(terminationGracePeriodSeconds *int64, policy *v1.ShutdownPolicy, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.TerminationGracePeriodSeconds = terminationGracePeriodSeconds
+			vmi.Spec.ShutdownPolicy = policy
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("accept a complete policy", pointer.Int64(60), &v1.ShutdownPolicy{
+				ACPIRetries:              pointer.Uint32(3),
+				ACPIRetryIntervalSeconds: pointer.Uint32(10),
+				GuestAgentFallback:       true,
+				ForceOffTimeoutSeconds:   pointer.Int64(60),
+			}, ""),
+			Entry("accept a force off timeout below the default grace period", nil, &v1.ShutdownPolicy{
+				ForceOffTimeoutSeconds: pointer.Int64(20),
+			}, ""),
+			Entry("reject a zero retry interval", nil, &v1.ShutdownPolicy{
+				ACPIRetryIntervalSeconds: pointer.Uint32(0),
+			}, "fake.shutdownPolicy.acpiRetryIntervalSeconds"),
+			Entry("reject the guest agent fallback without ACPI retries", nil, &v1.ShutdownPolicy{
+				GuestAgentFallback: true,
+			}, "fake.shutdownPolicy.acpiRetries"),
+			Entry("reject a negative force off timeout", nil, &v1.ShutdownPolicy{
+				ForceOffTimeoutSeconds: pointer.Int64(-1),
+			}, "fake.shutdownPolicy.forceOffTimeoutSeconds"),
+			Entry("reject a force off timeout above the termination grace period", pointer.Int64(10), &v1.ShutdownPolicy{
+				ForceOffTimeoutSeconds: pointer.Int64(11),
+			}, "fake.shutdownPolicy.forceOffTimeoutSeconds"),
+		)
+		DescribeTable("should validate the pod overlay", func(overlay *v1.PodOverlay, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.PodOverlay = overlay
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("accept a complete overlay", &v1.PodOverlay{
+				Labels:      map[string]string{"example.com/team": "a"},
+				Tolerations: []k8sv1.Toleration{{Key: "dedicated", Operator: k8sv1.TolerationOpEqual, Value: "vms", Effect: k8sv1.TaintEffectNoSchedule}},
+				TopologySpreadConstraints: []k8sv1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       "zone",
+					WhenUnsatisfiable: k8sv1.DoNotSchedule,
+				}},
+				RuntimeClassName: pointer.String("kata"),
+			}, ""),
+			Entry("reject an invalid label value", &v1.PodOverlay{
+				Labels: map[string]string{"team": "not a valid value"},
+			}, "fake.podOverlay.labels"),
+			Entry("reject a reserved kubevirt.io label", &v1.PodOverlay{
+				Labels: map[string]string{v1.AppLabel: "custom"},
+			}, "fake.podOverlay.labels[kubevirt.io]"),
+			Entry("reject a reserved kubevirt.io subdomain label", &v1.PodOverlay{
+				Labels: map[string]string{"vm.kubevirt.io/name": "custom"},
+			}, "fake.podOverlay.labels[vm.kubevirt.io/name]"),
+			Entry("reject a toleration with an invalid effect", &v1.PodOverlay{
+				Tolerations: []k8sv1.Toleration{{Key: "dedicated", Operator: k8sv1.TolerationOpExists, Effect: "Invalid"}},
+			}, "fake.podOverlay.tolerations[0].effect"),
+			Entry("reject an invalid topologySpreadConstraint", &v1.PodOverlay{
+				TopologySpreadConstraints: []k8sv1.TopologySpreadConstraint{{
+					MaxSkew:           0,
+					TopologyKey:       "zone",
+					WhenUnsatisfiable: k8sv1.DoNotSchedule,
+				}},
+			}, "fake.podOverlay.topologySpreadConstraints[0].maxSkew"),
+			Entry("reject an invalid runtimeClassName", &v1.PodOverlay{
+				RuntimeClassName: pointer.String("Invalid_Name"),
+			}, "fake.podOverlay.runtimeClassName"),
+		)
+		DescribeTable("should validate the pod overlay runtimeClassName against the default runtime class", func(runtimeClassName string, expectedCauses int) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DefaultRuntimeClass = "kata"
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.PodOverlay = &v1.PodOverlay{RuntimeClassName: pointer.String(runtimeClassName)}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake.podOverlay.runtimeClassName"))
+				Expect(causes[0].Message).To(ContainSubstring("conflicts with the default runtime class kata"))
+			}
+		},
+			Entry("accept the default runtime class", "kata", 0),
+			Entry("reject a different runtime class", "runc", 1),
+		)
+		DescribeTable("should validate the security profile against the safelist", func(profile *v1.SecurityProfile, expectedFields ...string) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.SecurityProfiles = &v1.SecurityProfilesConfiguration{
+				AllowedSeccompLocalhostProfiles: []string{"kubevirt/hardened.json"},
+				AllowedSELinuxTypes:             []string{"virt_launcher_hardened.process"},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.SecurityProfile = profile
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(len(expectedFields)))
+			for i, expectedField := range expectedFields {
+				Expect(causes[i].Field).To(Equal(expectedField))
+			}
+		},
+			Entry("accept safelisted values", &v1.SecurityProfile{
+				SeccompLocalhostProfile: "kubevirt/hardened.json",
+				SELinuxType:             "virt_launcher_hardened.process",
+			}),
+			Entry("accept an empty profile", &v1.SecurityProfile{}),
+			Entry("reject a seccomp profile which is not safelisted", &v1.SecurityProfile{
+				SeccompLocalhostProfile: "unconfined.json",
+			}, "fake.securityProfile.seccompLocalhostProfile"),
+			Entry("reject an SELinux type which is not safelisted", &v1.SecurityProfile{
+				SELinuxType: "spc_t",
+			}, "fake.securityProfile.selinuxType"),
+		)
+		It("should reject any security profile without a safelist", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.SecurityProfile = &v1.SecurityProfile{
+				SeccompLocalhostProfile: "kubevirt/hardened.json",
+				SELinuxType:             "virt_launcher_hardened.process",
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(2))
+		})
 		Context("with kernel boot defined", func() {
 
 			createKernelBoot := func(kernelArgs, initrdPath, kernelPath, image string) *v1.KernelBoot {
@@ -1481,6 +1618,84 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 					createKernelBoot(validKernelArgs, invalidInitrd, validKernel, validImage), false),
 				Entry("with kernel args, with container that has initrd and kernel defined but without image - should reject",
 					createKernelBoot(validKernelArgs, validInitrd, validKernel, withoutImage), false),
+				Entry("with kernel args, with volume that has name & kernel & initrd defined - should approve",
+					&v1.KernelBoot{KernelArgs: validKernelArgs, Volume: &v1.KernelBootVolume{Name: "kernel", KernelPath: validKernel, InitrdPath: validInitrd}}, true),
+				Entry("with volume that has only kernel defined - should approve",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{Name: "kernel", KernelPath: validKernel}}, true),
+				Entry("with volume that has no name - should reject",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{KernelPath: validKernel}}, false),
+				Entry("with volume that has neither kernel nor initrd defined - should reject",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{Name: "kernel"}}, false),
+				Entry("with volume that has an invalid kernel path - should reject",
+					&v1.KernelBoot{Volume: &v1.KernelBootVolume{Name: "kernel", KernelPath: invalidKernel}}, false),
+				Entry("with both container and volume - should reject",
+					&v1.KernelBoot{
+						Container: &v1.KernelBootContainer{Image: validImage, KernelPath: validKernel},
+						Volume:    &v1.KernelBootVolume{Name: "kernel", KernelPath: validKernel},
+					}, false),
+				Entry("with kernel args from a ConfigMap and a container - should approve",
+					&v1.KernelBoot{
+						KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "args"},
+						Container:      &v1.KernelBootContainer{Image: validImage, KernelPath: validKernel},
+					}, true),
+				Entry("with kernel args from a ConfigMap and null container - should reject",
+					&v1.KernelBoot{KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "args"}}, false),
+				Entry("with both kernel args and kernel args from a ConfigMap - should reject",
+					&v1.KernelBoot{
+						KernelArgs:     validKernelArgs,
+						KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "args"},
+						Container:      &v1.KernelBootContainer{Image: validImage, KernelPath: validKernel},
+					}, false),
+				Entry("with kernel args from a ConfigMap without key - should reject",
+					&v1.KernelBoot{
+						KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline"},
+						Container:      &v1.KernelBootContainer{Image: validImage, KernelPath: validKernel},
+					}, false),
+				Entry("with kernel args from a ConfigMap with a nested key - should reject",
+					&v1.KernelBoot{
+						KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "../args"},
+						Container:      &v1.KernelBootContainer{Image: validImage, KernelPath: validKernel},
+					}, false),
+			)
+
+			DescribeTable("referencing volumes", func(volumes []v1.Volume, disks []v1.Disk, expectedField string) {
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.Volumes = volumes
+				vmi.Spec.Domain.Devices.Disks = disks
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					KernelBoot: &v1.KernelBoot{
+						KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "args"},
+						Volume:         &v1.KernelBootVolume{Name: "kernel", KernelPath: validKernel},
+					},
+				}
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				if expectedField == "" {
+					Expect(causes).To(BeEmpty())
+					return
+				}
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("should accept a PVC kernel volume and a ConfigMap cmdline volume", []v1.Volume{
+					{Name: "kernel", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc"}}}},
+					{Name: "cmdline", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "cm"}}}},
+				}, nil, ""),
+				Entry("should reject a missing kernel volume", []v1.Volume{
+					{Name: "cmdline", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "cm"}}}},
+				}, nil, "fake.domain.firmware.kernelBoot.volume.name"),
+				Entry("should reject a kernel volume of an unsupported type", []v1.Volume{
+					{Name: "kernel", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}},
+					{Name: "cmdline", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "cm"}}}},
+				}, nil, "fake.domain.firmware.kernelBoot.volume.name"),
+				Entry("should reject a kernel volume used by a disk", []v1.Volume{
+					{Name: "kernel", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "cm"}}}},
+					{Name: "cmdline", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "cm"}}}},
+				}, []v1.Disk{{Name: "kernel"}}, "fake.domain.devices.disks[0].name"),
+				Entry("should reject a cmdline volume which is not a ConfigMap", []v1.Volume{
+					{Name: "kernel", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: k8sv1.LocalObjectReference{Name: "cm"}}}},
+					{Name: "cmdline", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc"}}}},
+				}, nil, "fake.domain.firmware.kernelBoot.kernelArgsFrom.volumeName"),
 			)
 		})
 
@@ -2523,6 +2738,112 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with encrypted ephemeral storage", func() {
+		var vmi *v1.VirtualMachineInstance
+		validate := func() []metav1.StatusCause {
+			return validateEphemeralStorage(k8sfield.NewPath("fake"), &vmi.Spec, config)
+		}
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.EphemeralStorage = &v1.EphemeralStorage{Encrypted: true}
+		})
+
+		It("should accept it if the feature gate is enabled", func() {
+			enableFeatureGate(virtconfig.EncryptedEphemeralStorageGate)
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should accept unencrypted ephemeral storage if the feature gate is not enabled", func() {
+			vmi.Spec.EphemeralStorage.Encrypted = false
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should reject it if the feature gate is not enabled", func() {
+			causes := validate()
+			Expect(causes).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake.ephemeralStorage.encrypted",
+				Message: "EncryptedEphemeralStorage feature gate is not enabled in kubevirt-config"}))
+		})
+
+		It("should reject it on architectures other than amd64", func() {
+			enableFeatureGate(virtconfig.EncryptedEphemeralStorageGate)
+			vmi.Spec.Architecture = "arm64"
+			causes := validate()
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Type).To(Equal(metav1.CauseTypeFieldValueNotSupported))
+			Expect(causes[0].Field).To(Equal("fake.ephemeralStorage.encrypted"))
+		})
+	})
+
+	Context("with resource claims", func() {
+		var vmi *v1.VirtualMachineInstance
+		validate := func() []metav1.StatusCause {
+			return validateResourceClaims(k8sfield.NewPath("fake"), &vmi.Spec, config)
+		}
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.ResourceClaims = []k8sv1.PodResourceClaim{{
+				Name:   "accelerators",
+				Source: k8sv1.ClaimSource{ResourceClaimTemplateName: kubevirtpointer.P("gpu-template")},
+			}}
+			vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{
+				Name:  "gpu1",
+				Claim: &v1.ClaimRequest{ClaimName: "accelerators"},
+			}}
+		})
+
+		It("should accept devices allocated from a claim if the feature gate is enabled", func() {
+			enableFeatureGate(virtconfig.DynamicResourceAllocationGate)
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should accept devices of device plugins if the feature gate is not enabled", func() {
+			vmi.Spec.ResourceClaims = nil
+			vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1", DeviceName: "vendor.com/gpu"}}
+			Expect(validate()).To(BeEmpty())
+		})
+
+		It("should reject claims if the feature gate is not enabled", func() {
+			Expect(validate()).To(ConsistOf(metav1.StatusCause{Type: metav1.CauseTypeFieldValueInvalid,
+				Field:   "fake.resourceClaims",
+				Message: "DynamicResourceAllocation feature gate is not enabled in kubevirt-config"}))
+		})
+
+		DescribeTable("should reject", func(mutate func(*v1.VirtualMachineInstance), expectedField string) {
+			enableFeatureGate(virtconfig.DynamicResourceAllocationGate)
+			mutate(vmi)
+			causes := validate()
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("a device referencing an unknown claim", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.GPUs[0].Claim.ClaimName = "unknown"
+			}, "fake.domain.devices.gpus[0].claim.claimName"),
+			Entry("a device with both a device name and a claim", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{
+					Name:       "hostdev1",
+					DeviceName: "vendor.com/nic",
+					Claim:      &v1.ClaimRequest{ClaimName: "accelerators"},
+				}}
+			}, "fake.domain.devices.hostDevices[0]"),
+			Entry("a device with neither a device name nor a claim", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "hostdev1"}}
+			}, "fake.domain.devices.hostDevices[0]"),
+			Entry("a claim without source", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.ResourceClaims[0].Source = k8sv1.ClaimSource{}
+			}, "fake.resourceClaims[0].source"),
+			Entry("a claim with an invalid name", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.ResourceClaims[0].Name = "Accelerators"
+				vmi.Spec.Domain.Devices.GPUs = nil
+			}, "fake.resourceClaims[0].name"),
+			Entry("claims with the same name", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.ResourceClaims = append(vmi.Spec.ResourceClaims, vmi.Spec.ResourceClaims[0])
+			}, "fake.resourceClaims[1].name"),
+		)
+	})
+
 	Context("with volume", func() {
 		It("should accept a single downwardmetrics volume", func() {
 			enableFeatureGate(virtconfig.DownwardMetricsFeatureGate)
@@ -3899,6 +4220,21 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	DescribeTable("should validate the TPM version", func(version v1.TPMVersion, expectedCauses int) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{Version: version}
+		causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+		Expect(causes).To(HaveLen(expectedCauses))
+		if expectedCauses > 0 {
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.tpm.version"))
+		}
+	},
+		Entry("accept the default version", v1.TPMVersion(""), 0),
+		Entry("accept version 1.2", v1.TPMVersion12, 0),
+		Entry("accept version 2.0", v1.TPMVersion20, 0),
+		Entry("reject an unknown version", v1.TPMVersion("1.0"), 1),
+	)
+
 	Context("with multi-threaded QEMU migrations", func() {
 		DescribeTable("should", func(threadCountStr string, isValid bool) {
 			meta := metav1.ObjectMeta{Annotations: map[string]string{cmdclient.MultiThreadedQemuMigrationAnnotation: threadCountStr}}
@@ -4177,6 +4513,27 @@ var _ = Describe("Function getNumberOfPodInterfaces()", func() {
 		Expect(causes).To(BeEmpty())
 	})
 
+	DescribeTable("should validate the firmware image name", func(imageName string, expectedCauses int) {
+		firmwareConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			FirmwareImages: []v1.FirmwareImage{
+				{Name: "ovmf-stable", Image: "registry:5000/ovmf:stable"},
+			},
+		})
+
+		spec := &v1.VirtualMachineInstanceSpec{}
+		spec.Domain.Firmware = &v1.Firmware{ImageName: imageName}
+
+		causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), spec, firmwareConfig)
+		Expect(causes).To(HaveLen(expectedCauses))
+		if expectedCauses > 0 {
+			Expect(causes[0].Field).To(Equal("fake.domain.firmware.imageName"))
+		}
+	},
+		Entry("accept no image name", "", 0),
+		Entry("accept a configured image", "ovmf-stable", 0),
+		Entry("reject an image which is not configured", "ovmf-unknown", 1),
+	)
+
 	It("Should validate VMIs without HyperV configuration", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		Expect(vmi.Spec.Domain.Features).To(BeNil())
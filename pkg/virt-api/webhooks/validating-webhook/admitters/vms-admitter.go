@@ -43,6 +43,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	migrationutil "kubevirt.io/kubevirt/pkg/util/migrations"
 
 	"kubevirt.io/kubevirt/pkg/instancetype"
@@ -207,6 +208,11 @@ func (admitter *VMsAdmitter) Admit(ar *admissionv1.AdmissionReview) *admissionv1
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	causes = validateTPMVersionUpdate(ar.Request, &vm)
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	isDryRun := ar.Request.DryRun != nil && *ar.Request.DryRun
 	if !isDryRun && ar.Request.Operation == admissionv1.Create {
 		metrics.NewVMCreated(&vm)
@@ -732,6 +738,46 @@ func validateRestoreStatus(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachi
 	return nil
 }
 
+// validateTPMVersionUpdate rejects changing the version of a persistent TPM. libvirt keeps the
+// state of TPM 1.2 and 2.0 in separate directories, so the guest would silently lose its TPM state.
+func validateTPMVersionUpdate(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
+	if ar.Operation != admissionv1.Update || vm.Spec.Template == nil {
+		return nil
+	}
+
+	oldVM := &v1.VirtualMachine{}
+	if err := json.Unmarshal(ar.OldObject.Raw, oldVM); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeUnexpectedServerResponse,
+			Message: "Could not fetch old VM",
+		}}
+	}
+
+	if oldVM.Spec.Template == nil || !backendstorage.HasPersistentTPMDevice(&oldVM.Spec.Template.Spec) ||
+		!backendstorage.HasPersistentTPMDevice(&vm.Spec.Template.Spec) {
+		return nil
+	}
+
+	oldVersion := tpmVersion(oldVM.Spec.Template.Spec.Domain.Devices.TPM)
+	newVersion := tpmVersion(vm.Spec.Template.Spec.Domain.Devices.TPM)
+	if oldVersion != newVersion {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("Cannot change the version of a persistent TPM from %s to %s", oldVersion, newVersion),
+			Field:   k8sfield.NewPath("spec", "template", "spec", "domain", "devices", "tpm", "version").String(),
+		}}
+	}
+
+	return nil
+}
+
+func tpmVersion(tpm *v1.TPMDevice) v1.TPMVersion {
+	if tpm.Version == "" {
+		return v1.TPMVersion20
+	}
+	return tpm.Version
+}
+
 func validateSnapshotStatus(ar *admissionv1.AdmissionRequest, vm *v1.VirtualMachine) []metav1.StatusCause {
 	if ar.Operation != admissionv1.Update || vm.Status.SnapshotInProgress == nil {
 		return nil
@@ -1700,6 +1700,58 @@ var _ = Describe("Validating VM Admitter", func() {
 		}, false),
 	)
 
+	DescribeTable("when updating the TPM version, should", func(oldTPM, newTPM *v1.TPMDevice, allow bool) {
+		enableFeatureGate(virtconfig.VMPersistentState)
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.Devices.TPM = oldTPM
+		vm := &v1.VirtualMachine{
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy: &runStrategyHalted,
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: vmi.Spec,
+				},
+			},
+		}
+		oldObjectBytes, _ := json.Marshal(vm)
+
+		vm.Spec.Template.Spec.Domain.Devices.TPM = newTPM
+		objectBytes, _ := json.Marshal(vm)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				Resource:  webhooks.VirtualMachineGroupVersionResource,
+				OldObject: runtime.RawExtension{
+					Raw: oldObjectBytes,
+				},
+				Object: runtime.RawExtension{
+					Raw: objectBytes,
+				},
+			},
+		}
+
+		resp := vmsAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(Equal(allow))
+
+		if !allow {
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.template.spec.domain.devices.tpm.version"))
+		}
+	},
+		Entry("reject changing the version of a persistent TPM",
+			&v1.TPMDevice{Persistent: pointer.P(true)},
+			&v1.TPMDevice{Persistent: pointer.P(true), Version: v1.TPMVersion12}, false),
+		Entry("accept setting the default version on a persistent TPM",
+			&v1.TPMDevice{Persistent: pointer.P(true)},
+			&v1.TPMDevice{Persistent: pointer.P(true), Version: v1.TPMVersion20}, true),
+		Entry("accept changing the version of a non-persistent TPM",
+			&v1.TPMDevice{},
+			&v1.TPMDevice{Version: v1.TPMVersion12}, true),
+		Entry("accept changing the version while making the TPM persistent",
+			&v1.TPMDevice{},
+			&v1.TPMDevice{Persistent: pointer.P(true), Version: v1.TPMVersion12}, true),
+	)
+
 	Context("Instancetype", func() {
 		var (
 			vm *v1.VirtualMachine
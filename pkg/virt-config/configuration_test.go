@@ -4,12 +4,14 @@ import (
 	"encoding/json"
 	"strings"
 	"sync"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	kubev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/rand"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -354,6 +356,70 @@ var _ = Describe("test configuration", func() {
 		Entry("is unset, GetMaxHotplugRatio should return the default", 0, virtconfig.DefaultMaxHotplugRatio),
 	)
 
+	DescribeTable(" when firmwareImages", func(value []v1.FirmwareImage, name string, expectedImage *v1.FirmwareImage) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			FirmwareImages: value,
+		})
+		image, exists := clusterConfig.GetFirmwareImage(name)
+		Expect(exists).To(Equal(expectedImage != nil))
+		Expect(image).To(Equal(expectedImage))
+	},
+		Entry("is unset, no image should be returned", nil, "ovmf", nil),
+		Entry("does not contain the name, no image should be returned", []v1.FirmwareImage{
+			{Name: "other", Image: "registry:5000/firmware:other"},
+		}, "ovmf", nil),
+		Entry("contains the name, the image should be returned", []v1.FirmwareImage{
+			{Name: "other", Image: "registry:5000/firmware:other"},
+			{Name: "ovmf", Image: "registry:5000/firmware:ovmf", Path: "/firmware"},
+		}, "ovmf", &v1.FirmwareImage{Name: "ovmf", Image: "registry:5000/firmware:ovmf", Path: "/firmware"}),
+	)
+
+	DescribeTable(" when crashLoopBackOff", func(value *v1.CrashLoopBackOffConfiguration, expectedMaxDelay int, expectedMinRunDuration time.Duration, expectedAlwaysLimit int, expectAlwaysLimit bool) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			CrashLoopBackOff: value,
+		})
+		Expect(clusterConfig.GetCrashLoopBackOffMaxDelaySeconds()).To(Equal(expectedMaxDelay))
+		Expect(clusterConfig.GetCrashLoopBackOffMinRunDuration()).To(Equal(expectedMinRunDuration))
+		limit, exists := clusterConfig.GetCrashLoopBackOffRestartLimit(v1.RunStrategyAlways)
+		Expect(exists).To(Equal(expectAlwaysLimit))
+		Expect(limit).To(Equal(expectedAlwaysLimit))
+	},
+		Entry("is unset, the defaults should be returned", nil, virtconfig.DefaultCrashLoopBackOffMaxDelaySeconds, time.Duration(0), 0, false),
+		Entry("is set, the set values should be returned", &v1.CrashLoopBackOffConfiguration{
+			MaxDelaySeconds:       pointer.P(600),
+			MinRunDurationSeconds: pointer.P(int64(30)),
+			RestartLimits: []v1.RestartLimit{
+				{RunStrategy: v1.RunStrategyAlways, MaxConsecutiveFailures: 5},
+			},
+		}, 600, 30*time.Second, 5, true),
+		Entry("has only limits for other run strategies, no limit should be returned", &v1.CrashLoopBackOffConfiguration{
+			RestartLimits: []v1.RestartLimit{
+				{RunStrategy: v1.RunStrategyRerunOnFailure, MaxConsecutiveFailures: 5},
+			},
+		}, virtconfig.DefaultCrashLoopBackOffMaxDelaySeconds, time.Duration(0), 0, false),
+	)
+
+	DescribeTable(" when instancetypeUpdateStrategy", func(value *v1.InstancetypeUpdateStrategy, total int, expectedMethods []v1.InstancetypeUpdateMethod, expectedMaxUnavailable int) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			InstancetypeUpdateStrategy: value,
+		})
+		Expect(clusterConfig.GetInstancetypeUpdateMethods()).To(Equal(expectedMethods))
+		Expect(clusterConfig.GetInstancetypeUpdateMaxUnavailable(total)).To(Equal(expectedMaxUnavailable))
+	},
+		Entry("is unset, the defaults should be returned", nil, 10, nil, virtconfig.DefaultInstancetypeUpdateMaxUnavailable),
+		Entry("has an absolute maxUnavailable, it should be returned", &v1.InstancetypeUpdateStrategy{
+			Methods:        []v1.InstancetypeUpdateMethod{v1.InstancetypeUpdateMethodLiveMigrate},
+			MaxUnavailable: pointer.P(intstr.FromInt32(3)),
+		}, 10, []v1.InstancetypeUpdateMethod{v1.InstancetypeUpdateMethodLiveMigrate}, 3),
+		Entry("has a percentage maxUnavailable, it should be scaled down", &v1.InstancetypeUpdateStrategy{
+			Methods:        []v1.InstancetypeUpdateMethod{v1.InstancetypeUpdateMethodRestart},
+			MaxUnavailable: pointer.P(intstr.FromString("25%")),
+		}, 10, []v1.InstancetypeUpdateMethod{v1.InstancetypeUpdateMethodRestart}, 2),
+		Entry("has a percentage maxUnavailable scaling to zero, at least one should be returned", &v1.InstancetypeUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromString("10%")),
+		}, 5, nil, 1),
+	)
+
 	// deprecated
 	DescribeTable(" when supportedGuestAgentVersions", func(value []string, result []string) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
@@ -754,6 +820,18 @@ var _ = Describe("test configuration", func() {
 			`{"defaultNetworkInterface":"bridge","permitSlirpInterface":true,"permitBridgeInterfaceOnPodNetwork":false}`),
 	)
 
+	DescribeTable("stream drain timeout", func(streamDrainSeconds *int64, expected time.Duration) {
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			StreamDrainSeconds: streamDrainSeconds,
+		})
+
+		Expect(clusterConfig.GetStreamDrainTimeout()).To(Equal(expected))
+	},
+		Entry("should be zero when not set", nil, time.Duration(0)),
+		Entry("should be zero when set to 0", pointer.P(int64(0)), time.Duration(0)),
+		Entry("should be the configured seconds", pointer.P(int64(120)), 120*time.Second),
+	)
+
 	DescribeTable("when ClusterProfiler feature-gate", func(openFeatureGates []string, isEnabled bool) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
 			DeveloperConfiguration: &v1.DeveloperConfiguration{
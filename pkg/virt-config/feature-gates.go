@@ -93,6 +93,20 @@ const (
 	// This feature requires following Kubernetes feature gate "ServiceAccountTokenPodNodeInfo". The feature gate is available
 	// in Kubernetes 1.30 as Beta.
 	NodeRestrictionGate = "NodeRestriction"
+	// EncryptedEphemeralStorageGate allows encrypting the node-local overlays and scratch disks of a VMI with a per VMI key.
+	EncryptedEphemeralStorageGate = "EncryptedEphemeralStorage"
+	// ContainerDiskLazyPullingGate supports containerDisk images pulled lazily by a streaming snapshotter
+	// (eStargz, zstd:chunked) configured on the nodes. KubeVirt does not pull images lazily itself, that is
+	// up to the container runtime. virt-handler only defers reading the whole images to compute their
+	// checksums until after the VMI is started, so that the images are fetched while the guest boots.
+	ContainerDiskLazyPullingGate = "ContainerDiskLazyPulling"
+	// ContainerDiskCacheGate lets virt-handler share containerDisks with identical content between the VMIs of a node
+	// through a node-local, content-addressed cache. Only containerDisks on filesystems with reflink support are cached,
+	// and none while ContainerDiskLazyPulling is enabled.
+	ContainerDiskCacheGate = "ContainerDiskCache"
+	// DynamicResourceAllocationGate allows GPUs and host devices to be allocated through ResourceClaims
+	// of the Kubernetes Dynamic Resource Allocation instead of device plugins.
+	DynamicResourceAllocationGate = "DynamicResourceAllocation"
 )
 
 func (config *ClusterConfig) isFeatureGateEnabled(featureGate string) bool {
@@ -268,3 +282,19 @@ func (config *ClusterConfig) VolumeMigrationEnabled() bool {
 func (config *ClusterConfig) NodeRestrictionEnabled() bool {
 	return config.isFeatureGateEnabled(NodeRestrictionGate)
 }
+
+func (config *ClusterConfig) EncryptedEphemeralStorageEnabled() bool {
+	return config.isFeatureGateEnabled(EncryptedEphemeralStorageGate)
+}
+
+func (config *ClusterConfig) ContainerDiskLazyPullingEnabled() bool {
+	return config.isFeatureGateEnabled(ContainerDiskLazyPullingGate)
+}
+
+func (config *ClusterConfig) ContainerDiskCacheEnabled() bool {
+	return config.isFeatureGateEnabled(ContainerDiskCacheGate)
+}
+
+func (config *ClusterConfig) DynamicResourceAllocationEnabled() bool {
+	return config.isFeatureGateEnabled(DynamicResourceAllocationGate)
+}
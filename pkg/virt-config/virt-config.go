@@ -26,11 +26,13 @@ package virtconfig
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"kubevirt.io/client-go/log"
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	v1 "kubevirt.io/api/core/v1"
 )
@@ -90,6 +92,14 @@ const (
 
 	DefaultMaxHotplugRatio   = 4
 	DefaultVMRolloutStrategy = v1.VMRolloutStrategyStage
+
+	DefaultCrashLoopBackOffMaxDelaySeconds = 300
+
+	DefaultFirmwareImagePath = "/usr/share/OVMF"
+
+	DefaultInstancetypeUpdateMaxUnavailable = 1
+
+	MaxStreamDrainSeconds = 300
 )
 
 func IsAMD64(arch string) bool {
@@ -495,3 +505,89 @@ func (c *ClusterConfig) GetNetworkBindings() map[string]v1.InterfaceBindingPlugi
 	}
 	return nil
 }
+
+func (c *ClusterConfig) GetCrashLoopBackOffMaxDelaySeconds() int {
+	crashLoopConfig := c.GetConfig().CrashLoopBackOff
+	if crashLoopConfig == nil || crashLoopConfig.MaxDelaySeconds == nil {
+		return DefaultCrashLoopBackOffMaxDelaySeconds
+	}
+
+	return *crashLoopConfig.MaxDelaySeconds
+}
+
+func (c *ClusterConfig) GetCrashLoopBackOffMinRunDuration() time.Duration {
+	crashLoopConfig := c.GetConfig().CrashLoopBackOff
+	if crashLoopConfig == nil || crashLoopConfig.MinRunDurationSeconds == nil {
+		return 0
+	}
+
+	return time.Duration(*crashLoopConfig.MinRunDurationSeconds) * time.Second
+}
+
+// GetCrashLoopBackOffRestartLimit returns the maximum number of consecutive start
+// failures tolerated for the given run strategy, and whether such a limit is set.
+func (c *ClusterConfig) GetCrashLoopBackOffRestartLimit(runStrategy v1.VirtualMachineRunStrategy) (int, bool) {
+	crashLoopConfig := c.GetConfig().CrashLoopBackOff
+	if crashLoopConfig == nil {
+		return 0, false
+	}
+
+	for _, limit := range crashLoopConfig.RestartLimits {
+		if limit.RunStrategy == runStrategy {
+			return limit.MaxConsecutiveFailures, true
+		}
+	}
+
+	return 0, false
+}
+
+// GetFirmwareImage returns the configured firmware image with the given name,
+// and whether such an image exists.
+func (c *ClusterConfig) GetFirmwareImage(name string) (*v1.FirmwareImage, bool) {
+	for _, image := range c.GetConfig().FirmwareImages {
+		if image.Name == name {
+			return image.DeepCopy(), true
+		}
+	}
+
+	return nil, false
+}
+
+// GetInstancetypeUpdateMethods returns the methods which can be used to move running
+// VirtualMachines onto new instancetype and preference revisions.
+func (c *ClusterConfig) GetInstancetypeUpdateMethods() []v1.InstancetypeUpdateMethod {
+	updateStrategy := c.GetConfig().InstancetypeUpdateStrategy
+	if updateStrategy == nil {
+		return nil
+	}
+
+	return updateStrategy.Methods
+}
+
+// GetInstancetypeUpdateMaxUnavailable returns how many of the given number of running
+// VirtualMachines on outdated revisions can be updated at the same time.
+func (c *ClusterConfig) GetInstancetypeUpdateMaxUnavailable(total int) int {
+	updateStrategy := c.GetConfig().InstancetypeUpdateStrategy
+	if updateStrategy == nil || updateStrategy.MaxUnavailable == nil {
+		return DefaultInstancetypeUpdateMaxUnavailable
+	}
+
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(updateStrategy.MaxUnavailable, total, false)
+	if err != nil {
+		log.Log.Reason(err).Warningf("invalid instancetype update maxUnavailable, using %d", DefaultInstancetypeUpdateMaxUnavailable)
+		return DefaultInstancetypeUpdateMaxUnavailable
+	}
+
+	return max(maxUnavailable, 1)
+}
+
+// GetStreamDrainTimeout returns how long virt-api and virt-handler keep serving the open
+// streams while they shut down.
+func (c *ClusterConfig) GetStreamDrainTimeout() time.Duration {
+	streamDrainSeconds := c.GetConfig().StreamDrainSeconds
+	if streamDrainSeconds == nil {
+		return 0
+	}
+
+	return time.Duration(*streamDrainSeconds) * time.Second
+}
@@ -249,7 +249,10 @@ func WithGPUs(gpus []v1.GPU) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
 		for _, gpu := range gpus {
-			requestResource(&resources, gpu.DeviceName)
+			// GPUs allocated through a resource claim are not requested from a device plugin
+			if gpu.DeviceName != "" {
+				requestResource(&resources, gpu.DeviceName)
+			}
 		}
 		copyResources(resources.Limits, renderer.calculatedLimits)
 		copyResources(resources.Requests, renderer.calculatedRequests)
@@ -260,7 +263,9 @@ func WithHostDevices(hostDevices []v1.HostDevice) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
 		for _, hostDev := range hostDevices {
-			requestResource(&resources, hostDev.DeviceName)
+			if hostDev.DeviceName != "" {
+				requestResource(&resources, hostDev.DeviceName)
+			}
 		}
 		copyResources(resources.Limits, renderer.calculatedLimits)
 		copyResources(resources.Requests, renderer.calculatedRequests)
@@ -498,11 +503,17 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 			supportedHostDevicesMap[dev.ResourceName] = true
 		}
 		for _, hostDev := range spec.Domain.Devices.GPUs {
+			if hostDev.Claim != nil {
+				continue
+			}
 			if _, exist := supportedHostDevicesMap[hostDev.DeviceName]; !exist {
 				errors = append(errors, fmt.Sprintf("GPU %s is not permitted in permittedHostDevices configuration", hostDev.DeviceName))
 			}
 		}
 		for _, hostDev := range spec.Domain.Devices.HostDevices {
+			if hostDev.Claim != nil {
+				continue
+			}
 			if _, exist := supportedHostDevicesMap[hostDev.DeviceName]; !exist {
 				errors = append(errors, fmt.Sprintf("HostDevice %s is not permitted in permittedHostDevices configuration", hostDev.DeviceName))
 			}
@@ -213,6 +213,43 @@ func withVMIConfigVolumes(vmiDisks []v1.Disk, vmiVolumes []v1.Volume) VolumeRend
 	}
 }
 
+// withKernelBootConfigVolumes mounts the ConfigMap volumes providing the kernel boot artifacts
+// or arguments. They are not referenced by a disk, so withVMIConfigVolumes does not mount them.
+func withKernelBootConfigVolumes(vmiDisks []v1.Disk, vmiVolumes []v1.Volume, firmware *v1.Firmware) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		if firmware == nil || firmware.KernelBoot == nil {
+			return nil
+		}
+		kernelBoot := firmware.KernelBoot
+
+		mounted := make(map[string]bool)
+		for _, disk := range vmiDisks {
+			mounted[disk.Name] = true
+		}
+
+		var volumeNames []string
+		if kernelBoot.Volume != nil {
+			volumeNames = append(volumeNames, kernelBoot.Volume.Name)
+		}
+		if kernelBoot.KernelArgsFrom != nil {
+			volumeNames = append(volumeNames, kernelBoot.KernelArgsFrom.VolumeName)
+		}
+
+		for _, volumeName := range volumeNames {
+			if mounted[volumeName] {
+				continue
+			}
+			for _, volume := range vmiVolumes {
+				if volume.Name == volumeName && volume.ConfigMap != nil {
+					renderer.addConfigMapVolumeMount(volume)
+					mounted[volumeName] = true
+				}
+			}
+		}
+		return nil
+	}
+}
+
 func (vr *VolumeRenderer) handleCloudInitConfigDrive(volume v1.Volume) {
 	if volume.CloudInitConfigDrive != nil {
 		if volume.CloudInitConfigDrive.UserDataSecretRef != nil {
@@ -351,6 +351,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		return nil, err
 	}
 	resources := resourceRenderer.ResourceRequirements()
+	metrics.SetVmiLaucherMemoryOverhead(vmi, t.getMemoryOverhead(vmi))
 
 	ovmfPath := t.clusterConfig.GetOVMFPath(vmi.Spec.Architecture)
 
@@ -463,6 +464,12 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		containers = append(containers, *kernelBootContainer)
 	}
 
+	firmwareContainer := containerdisk.GenerateFirmwareContainer(vmi, t.clusterConfig, imageIDs, containerDisks, virtBinDir)
+	if firmwareContainer != nil {
+		log.Log.Object(vmi).Infof("firmware container generated")
+		containers = append(containers, *firmwareContainer)
+	}
+
 	virtiofsContainers := generateVirtioFSContainers(vmi, t.launcherImage, t.clusterConfig)
 	if virtiofsContainers != nil {
 		containers = append(containers, virtiofsContainers...)
@@ -528,7 +535,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 
 	var initContainers []k8sv1.Container
 
-	if HaveContainerDiskVolume(vmi.Spec.Volumes) || util.HasKernelBootContainerImage(vmi) {
+	if HaveContainerDiskVolume(vmi.Spec.Volumes) || util.HasKernelBootContainerImage(vmi) || util.HasFirmwareImage(vmi) {
 		initContainerCommand := []string{"/usr/bin/cp",
 			"/usr/bin/container-disk",
 			"/init/usr/bin/container-disk",
@@ -548,6 +555,11 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 		if kernelBootInitContainer != nil {
 			initContainers = append(initContainers, *kernelBootInitContainer)
 		}
+
+		firmwareInitContainer := containerdisk.GenerateFirmwareInitContainer(vmi, t.clusterConfig, imageIDs, containerDisks, virtBinDir)
+		if firmwareInitContainer != nil {
+			initContainers = append(initContainers, *firmwareInitContainer)
+		}
 	}
 
 	hostName := dns.SanitizeHostname(vmi)
@@ -597,20 +609,25 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			SchedulerName:                 vmi.Spec.SchedulerName,
 			Tolerations:                   vmi.Spec.Tolerations,
 			TopologySpreadConstraints:     vmi.Spec.TopologySpreadConstraints,
+			ResourceClaims:                vmi.Spec.ResourceClaims,
 		},
 	}
 
 	alignPodMultiCategorySecurity(&pod, t.clusterConfig.GetSELinuxLauncherType(), t.clusterConfig.DockerSELinuxMCSWorkaroundEnabled())
 
-	// If we have a runtime class specified, use it, otherwise don't set a runtimeClassName
+	if vmi.Spec.PriorityClassName != "" {
+		pod.Spec.PriorityClassName = vmi.Spec.PriorityClassName
+	}
+
+	applyPodOverlay(vmi.Spec.PodOverlay, &pod)
+
+	// If we have a runtime class specified, use it, otherwise don't set a runtimeClassName.
+	// It is applied after the podOverlay so that the cluster policy always wins.
 	runtimeClassName := t.clusterConfig.GetDefaultRuntimeClass()
 	if runtimeClassName != "" {
 		pod.Spec.RuntimeClassName = &runtimeClassName
 	}
-
-	if vmi.Spec.PriorityClassName != "" {
-		pod.Spec.PriorityClassName = vmi.Spec.PriorityClassName
-	}
+	applySecurityProfile(vmi.Spec.SecurityProfile, &pod)
 
 	if vmi.Spec.Affinity != nil {
 		pod.Spec.Affinity = vmi.Spec.Affinity.DeepCopy()
@@ -724,6 +741,10 @@ func (t *templateService) newInitContainerRenderer(vmiSpec *v1.VirtualMachineIns
 }
 
 func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstance, volumeRenderer *VolumeRenderer, resources k8sv1.ResourceRequirements, userId int64) *ContainerSpecRenderer {
+	// the devices allocated for the resource claims are handed to the compute container
+	for _, claim := range vmi.Spec.ResourceClaims {
+		resources.Claims = append(resources.Claims, k8sv1.ResourceClaim{Name: claim.Name})
+	}
 	computeContainerOpts := []Option{
 		WithVolumeDevices(volumeRenderer.VolumeDevices()...),
 		WithVolumeMounts(volumeRenderer.Mounts()...),
@@ -755,6 +776,7 @@ func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstanc
 func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, namespace string, requestedHookSidecarList hooks.HookSidecarList) (*VolumeRenderer, error) {
 	volumeOpts := []VolumeRendererOption{
 		withVMIConfigVolumes(vmi.Spec.Domain.Devices.Disks, vmi.Spec.Volumes),
+		withKernelBootConfigVolumes(vmi.Spec.Domain.Devices.Disks, vmi.Spec.Volumes, vmi.Spec.Domain.Firmware),
 		withVMIVolumes(t.persistentVolumeClaimStore, vmi.Spec.Volumes, vmi.Status.VolumeStatus),
 		withAccessCredentials(vmi.Spec.AccessCredentials),
 		withBackendStorage(vmi),
@@ -812,6 +834,26 @@ func (t *templateService) newResourceRenderer(vmi *v1.VirtualMachineInstance, ne
 	return NewResourceRenderer(vmiResources.Limits, vmiResources.Requests, options...), nil
 }
 
+// RenderLauncherResources renders the resource requirements of the compute container of the
+// virt-launcher pod of the VMI, without rendering the rest of the pod.
+func RenderLauncherResources(virtClient kubecli.KubevirtClient, clusterConfig *virtconfig.ClusterConfig, vmi *v1.VirtualMachineInstance) (k8sv1.ResourceRequirements, error) {
+	networkToResourceMap, err := network.GetNetworkToResourceMap(virtClient, vmi)
+	if err != nil {
+		return k8sv1.ResourceRequirements{}, err
+	}
+	// Resource quotas only affect the limits, which are of no interest here
+	t := &templateService{
+		virtClient:         virtClient,
+		clusterConfig:      clusterConfig,
+		resourceQuotaStore: cache.NewStore(cache.MetaNamespaceKeyFunc),
+	}
+	resourceRenderer, err := t.newResourceRenderer(vmi, networkToResourceMap)
+	if err != nil {
+		return k8sv1.ResourceRequirements{}, err
+	}
+	return resourceRenderer.ResourceRequirements(), nil
+}
+
 func sidecarVolumeMount() k8sv1.VolumeMount {
 	return k8sv1.VolumeMount{
 		Name:      hookSidecarSocks,
@@ -845,6 +887,11 @@ func sidecarContainerName(i int) string {
 	return fmt.Sprintf("hook-sidecar-%d", i)
 }
 
+// IsHotplugAttachmentPod returns true if the pod carries the label of the hotplug attachment pods.
+func IsHotplugAttachmentPod(pod *k8sv1.Pod) bool {
+	return pod.Labels[v1.AppLabel] == hotplugDisk
+}
+
 func (t *templateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, claimMap map[string]*k8sv1.PersistentVolumeClaim) (*k8sv1.Pod, error) {
 	zero := int64(0)
 	runUser := int64(util.NonRootUID)
@@ -1449,14 +1496,17 @@ func (t *templateService) doesVMIRequireAutoCPULimits(vmi *v1.VirtualMachineInst
 	return false
 }
 
-func (t *templateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance, networkToResourceMap map[string]string) VMIResourcePredicates {
+func (t *templateService) getMemoryOverhead(vmi *v1.VirtualMachineInstance) resource.Quantity {
 	// Set default with vmi Architecture. compatible with multi-architecture hybrid environments
 	vmiCPUArch := vmi.Spec.Architecture
 	if vmiCPUArch == "" {
 		vmiCPUArch = t.clusterConfig.GetClusterCPUArch()
 	}
-	memoryOverhead := GetMemoryOverhead(vmi, vmiCPUArch, t.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio)
-	metrics.SetVmiLaucherMemoryOverhead(vmi, memoryOverhead)
+	return GetMemoryOverhead(vmi, vmiCPUArch, t.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio)
+}
+
+func (t *templateService) VMIResourcePredicates(vmi *v1.VirtualMachineInstance, networkToResourceMap map[string]string) VMIResourcePredicates {
+	memoryOverhead := t.getMemoryOverhead(vmi)
 	withCPULimits := t.doesVMIRequireAutoCPULimits(vmi)
 	return VMIResourcePredicates{
 		vmi: vmi,
@@ -1516,12 +1566,94 @@ func podLabels(vmi *v1.VirtualMachineInstance, hostName string) map[string]strin
 	for k, v := range vmi.Labels {
 		labels[k] = v
 	}
+	if vmi.Spec.PodOverlay != nil {
+		for k, v := range vmi.Spec.PodOverlay.Labels {
+			labels[k] = v
+		}
+	}
 	labels[v1.AppLabel] = "virt-launcher"
 	labels[v1.CreatedByLabel] = string(vmi.UID)
 	labels[v1.VirtualMachineNameLabel] = hostName
 	return labels
 }
 
+// applyPodOverlay adds the tolerations, topology spread constraints and runtime class of the overlay to the pod.
+func applyPodOverlay(overlay *v1.PodOverlay, pod *k8sv1.Pod) {
+	if overlay == nil {
+		return
+	}
+
+	if len(overlay.Tolerations) > 0 {
+		tolerations := make([]k8sv1.Toleration, 0, len(pod.Spec.Tolerations)+len(overlay.Tolerations))
+		tolerations = append(tolerations, pod.Spec.Tolerations...)
+		pod.Spec.Tolerations = append(tolerations, overlay.Tolerations...)
+	}
+
+	if len(overlay.TopologySpreadConstraints) > 0 {
+		pod.Spec.TopologySpreadConstraints = mergeTopologySpreadConstraints(pod.Spec.TopologySpreadConstraints, overlay.TopologySpreadConstraints)
+	}
+
+	if overlay.RuntimeClassName != nil {
+		runtimeClassName := *overlay.RuntimeClassName
+		pod.Spec.RuntimeClassName = &runtimeClassName
+	}
+}
+
+// applySecurityProfile sets the seccomp profile and SELinux type selected by the VMI on the compute container.
+// The values were checked against the safelist of the KubeVirt configuration on admission.
+func applySecurityProfile(profile *v1.SecurityProfile, pod *k8sv1.Pod) {
+	if profile == nil {
+		return
+	}
+
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		if container.Name != "compute" {
+			continue
+		}
+		if container.SecurityContext == nil {
+			container.SecurityContext = &k8sv1.SecurityContext{}
+		}
+		if profile.SeccompLocalhostProfile != "" {
+			localhostProfile := profile.SeccompLocalhostProfile
+			container.SecurityContext.SeccompProfile = &k8sv1.SeccompProfile{
+				Type:             k8sv1.SeccompProfileTypeLocalhost,
+				LocalhostProfile: &localhostProfile,
+			}
+		}
+		if profile.SELinuxType != "" {
+			if container.SecurityContext.SELinuxOptions == nil {
+				container.SecurityContext.SELinuxOptions = &k8sv1.SELinuxOptions{}
+			}
+			container.SecurityContext.SELinuxOptions.Type = profile.SELinuxType
+		}
+	}
+}
+
+// mergeTopologySpreadConstraints merges the overlay constraints into the given ones. Like the map list of the
+// VMI spec, constraints are keyed by topologyKey and whenUnsatisfiable, the overlay wins on conflicts.
+func mergeTopologySpreadConstraints(constraints, overlay []k8sv1.TopologySpreadConstraint) []k8sv1.TopologySpreadConstraint {
+	type constraintKey struct {
+		topologyKey       string
+		whenUnsatisfiable k8sv1.UnsatisfiableConstraintAction
+	}
+
+	merged := make([]k8sv1.TopologySpreadConstraint, 0, len(constraints)+len(overlay))
+	indexes := map[constraintKey]int{}
+	for _, list := range [][]k8sv1.TopologySpreadConstraint{constraints, overlay} {
+		for _, constraint := range list {
+			key := constraintKey{constraint.TopologyKey, constraint.WhenUnsatisfiable}
+			if i, exists := indexes[key]; exists {
+				merged[i] = *constraint.DeepCopy()
+				continue
+			}
+			indexes[key] = len(merged)
+			merged = append(merged, *constraint.DeepCopy())
+		}
+	}
+	return merged
+}
+
 func readinessGates() []k8sv1.PodReadinessGate {
 	return []k8sv1.PodReadinessGate{
 		{
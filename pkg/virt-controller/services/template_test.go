@@ -3128,6 +3128,58 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with kernel boot ConfigMap volumes", func() {
+			It("Should mount the ConfigMaps not used by a disk into the compute container", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				newConfigMapVolume := func(name string) v1.Volume {
+					return v1.Volume{
+						Name: name,
+						VolumeSource: v1.VolumeSource{
+							ConfigMap: &v1.ConfigMapVolumeSource{
+								LocalObjectReference: k8sv1.LocalObjectReference{Name: name},
+							},
+						},
+					}
+				}
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "testvmi", Namespace: "default", UID: "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{newConfigMapVolume("kernel"), newConfigMapVolume("cmdline")},
+						Domain: v1.DomainSpec{
+							Firmware: &v1.Firmware{
+								KernelBoot: &v1.KernelBoot{
+									KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "args"},
+									Volume:         &v1.KernelBootVolume{Name: "kernel", KernelPath: "/vmlinuz"},
+								},
+							},
+							Devices: v1.Devices{
+								DisableHotplug: true,
+								Disks:          []v1.Disk{{Name: "cmdline"}},
+							},
+						},
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pod.Spec.Containers[0].VolumeMounts).To(ContainElement(k8sv1.VolumeMount{
+					Name:      "kernel",
+					MountPath: "/var/run/kubevirt-private/config-map/kernel",
+					ReadOnly:  true,
+				}))
+				cmdlineMounts := 0
+				for _, mount := range pod.Spec.Containers[0].VolumeMounts {
+					if mount.Name == "cmdline" {
+						cmdlineMounts++
+					}
+				}
+				Expect(cmdlineMounts).To(Equal(1))
+			})
+		})
+
 		Context("with a Sysprep volume source", func() {
 			Context("with a ConfigMap", func() {
 				It("Should add the Sysprep ConfigMap to template", func() {
@@ -3404,6 +3456,47 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with resource claims", func() {
+			It("should hand the claims to the compute container instead of requesting device plugin resources", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				resourceClaims := []k8sv1.PodResourceClaim{{
+					Name:   "accelerators",
+					Source: k8sv1.ClaimSource{ResourceClaimTemplateName: pointer.String("gpu-template")},
+				}}
+				vmi := v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvmi",
+						Namespace: "default",
+						UID:       "1234",
+					},
+					Spec: v1.VirtualMachineInstanceSpec{
+						Domain: v1.DomainSpec{
+							Devices: v1.Devices{
+								DisableHotplug: true,
+								GPUs: []v1.GPU{
+									{
+										Name:  "gpu1",
+										Claim: &v1.ClaimRequest{ClaimName: "accelerators"},
+									},
+								},
+							},
+						},
+						ResourceClaims: resourceClaims,
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(&vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.ResourceClaims).To(Equal(resourceClaims))
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+
+				resources := pod.Spec.Containers[0].Resources
+				Expect(resources.Claims).To(ConsistOf(k8sv1.ResourceClaim{Name: "accelerators"}))
+				Expect(resources.Requests).ToNot(HaveKey(k8sv1.ResourceName("")))
+				Expect(resources.Limits).ToNot(HaveKey(k8sv1.ResourceName("")))
+			})
+		})
+
 		Context("with HostDevice device interface", func() {
 			It("should not run privileged", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -3746,6 +3839,33 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with a pinned firmware image", func() {
+			It("should add the firmware container and init container with the pinned image", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi := api.NewMinimalVMI("testvmi-firmware")
+				vmi.Namespace = "default"
+				vmi.UID = "1234"
+				vmi.Spec.Domain.Firmware = &v1.Firmware{ImageName: "ovmf"}
+				vmi.Status.FirmwareImageStatus = &v1.FirmwareImageStatus{
+					Name:  "ovmf",
+					Image: "registry:5000/ovmf:stable",
+					Path:  "/usr/share/OVMF",
+				}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+
+				var firmwareImages []string
+				for _, container := range append(pod.Spec.InitContainers, pod.Spec.Containers...) {
+					if strings.Contains(container.Name, "firmware") {
+						firmwareImages = append(firmwareImages, container.Image)
+					}
+				}
+				Expect(firmwareImages).To(Equal([]string{"registry:5000/ovmf:stable", "registry:5000/ovmf:stable"}))
+				Expect(pod.Spec.InitContainers[0].Name).To(Equal("container-disk-binary"))
+			})
+		})
+
 		Context("Using defaultRuntimeClass", func() {
 			It("Should set a runtimeClassName on launcher pod, if configured", func() {
 				config, kvStore, svc = configFactory(defaultArch)
@@ -3785,6 +3905,141 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		Context("with a podOverlay", func() {
+			var vmi *v1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi = api.NewMinimalVMI("testvmi")
+				vmi.Labels = map[string]string{"vmi-label": "vmi"}
+				vmi.Spec.Tolerations = []k8sv1.Toleration{{Key: "vmi-toleration", Operator: k8sv1.TolerationOpExists}}
+				vmi.Spec.TopologySpreadConstraints = []k8sv1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       "zone",
+					WhenUnsatisfiable: k8sv1.DoNotSchedule,
+				}}
+			})
+
+			It("should add labels to the launcher pod without overriding reserved labels", func() {
+				vmi.Spec.PodOverlay = &v1.PodOverlay{
+					Labels: map[string]string{
+						"overlay-label": "overlay",
+						"vmi-label":     "overlay",
+					},
+				}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Labels).To(HaveKeyWithValue("overlay-label", "overlay"))
+				Expect(pod.Labels).To(HaveKeyWithValue("vmi-label", "overlay"))
+				Expect(pod.Labels).To(HaveKeyWithValue(v1.AppLabel, "virt-launcher"))
+				Expect(vmi.Labels).ToNot(HaveKey("overlay-label"))
+			})
+
+			It("should append tolerations and topologySpreadConstraints to the ones of the VMI", func() {
+				overlayToleration := k8sv1.Toleration{Key: "overlay-toleration", Operator: k8sv1.TolerationOpExists}
+				overlayConstraint := k8sv1.TopologySpreadConstraint{
+					MaxSkew:           1,
+					TopologyKey:       "rack",
+					WhenUnsatisfiable: k8sv1.ScheduleAnyway,
+				}
+				vmi.Spec.PodOverlay = &v1.PodOverlay{
+					Tolerations:               []k8sv1.Toleration{overlayToleration},
+					TopologySpreadConstraints: []k8sv1.TopologySpreadConstraint{overlayConstraint},
+				}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Tolerations).To(Equal([]k8sv1.Toleration{vmi.Spec.Tolerations[0], overlayToleration}))
+				Expect(pod.Spec.TopologySpreadConstraints).To(Equal([]k8sv1.TopologySpreadConstraint{vmi.Spec.TopologySpreadConstraints[0], overlayConstraint}))
+				Expect(vmi.Spec.Tolerations).To(HaveLen(1))
+				Expect(vmi.Spec.TopologySpreadConstraints).To(HaveLen(1))
+			})
+
+			It("should replace topologySpreadConstraints of the VMI with the same topologyKey and whenUnsatisfiable", func() {
+				vmi.Spec.TopologySpreadConstraints = append(vmi.Spec.TopologySpreadConstraints, k8sv1.TopologySpreadConstraint{
+					MaxSkew:           1,
+					TopologyKey:       "rack",
+					WhenUnsatisfiable: k8sv1.DoNotSchedule,
+				})
+				zoneConstraint := k8sv1.TopologySpreadConstraint{
+					MaxSkew:           2,
+					TopologyKey:       "zone",
+					WhenUnsatisfiable: k8sv1.DoNotSchedule,
+				}
+				scheduleAnywayConstraint := k8sv1.TopologySpreadConstraint{
+					MaxSkew:           3,
+					TopologyKey:       "zone",
+					WhenUnsatisfiable: k8sv1.ScheduleAnyway,
+				}
+				vmi.Spec.PodOverlay = &v1.PodOverlay{
+					TopologySpreadConstraints: []k8sv1.TopologySpreadConstraint{zoneConstraint, scheduleAnywayConstraint},
+				}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.TopologySpreadConstraints).To(Equal([]k8sv1.TopologySpreadConstraint{
+					zoneConstraint,
+					vmi.Spec.TopologySpreadConstraints[1],
+					scheduleAnywayConstraint,
+				}))
+				Expect(vmi.Spec.TopologySpreadConstraints[0].MaxSkew).To(Equal(int32(1)))
+			})
+
+			It("should set the runtimeClassName without a default runtimeClassName", func() {
+				vmi.Spec.PodOverlay = &v1.PodOverlay{RuntimeClassName: pointer.String("overlayRuntime")}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.RuntimeClassName).To(HaveValue(Equal("overlayRuntime")))
+			})
+
+			It("should not override the default runtimeClassName", func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DefaultRuntimeClass = "customRuntime"
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kvConfig)
+
+				vmi.Spec.PodOverlay = &v1.PodOverlay{RuntimeClassName: pointer.String("overlayRuntime")}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.RuntimeClassName).To(HaveValue(Equal("customRuntime")))
+			})
+		})
+
+		Context("with a securityProfile", func() {
+			BeforeEach(func() {
+				config, kvStore, svc = configFactory(defaultArch)
+			})
+
+			It("should set the seccomp profile and SELinux type of the compute container", func() {
+				vmi := api.NewMinimalVMI("testvmi")
+				vmi.Spec.SecurityProfile = &v1.SecurityProfile{
+					SeccompLocalhostProfile: "kubevirt/hardened.json",
+					SELinuxType:             "virt_launcher_hardened.process",
+				}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				computeContainer := pod.Spec.Containers[0]
+				Expect(computeContainer.Name).To(Equal("compute"))
+				Expect(computeContainer.SecurityContext.SeccompProfile).To(Equal(&k8sv1.SeccompProfile{
+					Type:             k8sv1.SeccompProfileTypeLocalhost,
+					LocalhostProfile: pointer.String("kubevirt/hardened.json"),
+				}))
+				Expect(computeContainer.SecurityContext.SELinuxOptions.Type).To(Equal("virt_launcher_hardened.process"))
+			})
+
+			It("should leave the compute container untouched without a profile", func() {
+				vmi := api.NewMinimalVMI("testvmi")
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers[0].SecurityContext.SeccompProfile).To(BeNil())
+				Expect(pod.Spec.Containers[0].SecurityContext.SELinuxOptions).To(BeNil())
+			})
+		})
+
 		DescribeTable("should require NET_BIND_SERVICE", func(interfaceType string) {
 			vmi := api.NewMinimalVMI("fake-vmi")
 			switch interfaceType {
@@ -84,6 +84,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	instancetypeupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/instancetype-updater"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 
 	"kubevirt.io/kubevirt/pkg/network/netbinding"
@@ -176,6 +177,8 @@ type VirtControllerApp struct {
 
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
+	instancetypeUpdateController *instancetypeupdater.InstancetypeUpdateController
+
 	caExportConfigMapInformer    cache.SharedIndexInformer
 	exportRouteConfigMapInformer cache.SharedInformer
 	exportServiceInformer        cache.SharedIndexInformer
@@ -443,6 +446,7 @@ func Execute() {
 	app.initRestoreController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
+	app.initInstancetypeUpdaterController()
 	app.initCloneController()
 	go app.Run()
 
@@ -532,6 +536,10 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			golog.Fatalf("failed to add vmi phase transition handler: %v", err)
 		}
 
+		if err := metrics.AddHotplugVolumeHandlers(vca.vmiInformer); err != nil {
+			golog.Fatalf("failed to add hotplug volume handler: %v", err)
+		}
+
 		if vca.migrationInformer == nil {
 			vca.migrationInformer = vca.informerFactory.VirtualMachineInstanceMigration()
 			metrics.UpdateVMIMigrationInformer(vca.migrationInformer)
@@ -566,6 +574,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			}
 		}()
 		go vca.workloadUpdateController.Run(stop)
+		go vca.instancetypeUpdateController.Run(stop)
 		go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
 		go func() {
 			if err := vca.vmCloneController.Run(vca.cloneControllerThreads, stop); err != nil {
@@ -757,6 +766,37 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 	}
 }
 
+func (vca *VirtControllerApp) initInstancetypeUpdaterController() {
+	var err error
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "instancetype-update-controller")
+
+	instancetypeMethods := &instancetype.InstancetypeMethods{
+		InstancetypeStore:        vca.instancetypeInformer.GetStore(),
+		ClusterInstancetypeStore: vca.clusterInstancetypeInformer.GetStore(),
+		PreferenceStore:          vca.preferenceInformer.GetStore(),
+		ClusterPreferenceStore:   vca.clusterPreferenceInformer.GetStore(),
+		ControllerRevisionStore:  vca.controllerRevisionInformer.GetStore(),
+		Clientset:                vca.clientSet,
+	}
+
+	vca.instancetypeUpdateController, err = instancetypeupdater.NewInstancetypeUpdateController(
+		vca.vmInformer,
+		vca.vmiInformer,
+		vca.instancetypeInformer,
+		vca.clusterInstancetypeInformer,
+		vca.preferenceInformer,
+		vca.clusterPreferenceInformer,
+		vca.controllerRevisionInformer,
+		vca.kubeVirtInformer,
+		instancetypeMethods,
+		recorder,
+		vca.clientSet,
+		vca.clusterConfig)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initEvacuationController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "evacuation-controller")
@@ -0,0 +1,543 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package instancetypeupdater
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/instancetype"
+	migrationutils "kubevirt.io/kubevirt/pkg/util/migrations"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// SuccessfulUpdateRevisionReason is added in an event if a VirtualMachine was moved onto a new revision
+	SuccessfulUpdateRevisionReason = "SuccessfulInstancetypeUpdate"
+	// FailedUpdateRevisionReason is added in an event if a VirtualMachine could not be moved onto a new revision
+	FailedUpdateRevisionReason = "FailedInstancetypeUpdate"
+	// SuccessfulRestartReason is added in an event if a VirtualMachine was restarted to apply a new revision
+	SuccessfulRestartReason = "SuccessfulInstancetypeUpdateRestart"
+	// FailedRestartReason is added in an event if a VirtualMachine could not be restarted to apply a new revision
+	FailedRestartReason = "FailedInstancetypeUpdateRestart"
+	// StagedUpdateReason is added in an event if a new revision can only be applied by restarting the VirtualMachine
+	StagedUpdateReason = "StagedInstancetypeUpdate"
+)
+
+// time to wait before re-enqueing when VirtualMachines are still being updated
+const periodicReEnqueueInterval = 30 * time.Second
+
+// ensures we don't execute more than once every 5 seconds
+const defaultThrottleInterval = 5 * time.Second
+
+// the controller works on all pending VirtualMachines at once, so a single key is used
+const updateKey = "instancetype-update"
+
+const (
+	instancetypeIndex = "instancetype"
+	preferenceIndex   = "preference"
+)
+
+type InstancetypeUpdateController struct {
+	clientset                   kubecli.KubevirtClient
+	queue                       workqueue.RateLimitingInterface
+	vmInformer                  cache.SharedIndexInformer
+	vmiInformer                 cache.SharedIndexInformer
+	instancetypeInformer        cache.SharedIndexInformer
+	clusterInstancetypeInformer cache.SharedIndexInformer
+	preferenceInformer          cache.SharedIndexInformer
+	clusterPreferenceInformer   cache.SharedIndexInformer
+	controllerRevisionInformer  cache.SharedIndexInformer
+	kubeVirtInformer            cache.SharedIndexInformer
+	instancetypeMethods         instancetype.Methods
+	recorder                    record.EventRecorder
+	clusterConfig               *virtconfig.ClusterConfig
+
+	// pendingVMs holds the keys of the VirtualMachines which need to be checked on the next run
+	pendingLock sync.Mutex
+	pendingVMs  map[string]struct{}
+}
+
+type updateData struct {
+	inProgressVMs      []*virtv1.VirtualMachine
+	outdatedRunningVMs []*virtv1.VirtualMachine
+	outdatedStoppedVMs []*virtv1.VirtualMachine
+}
+
+func NewInstancetypeUpdateController(
+	vmInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	instancetypeInformer cache.SharedIndexInformer,
+	clusterInstancetypeInformer cache.SharedIndexInformer,
+	preferenceInformer cache.SharedIndexInformer,
+	clusterPreferenceInformer cache.SharedIndexInformer,
+	controllerRevisionInformer cache.SharedIndexInformer,
+	kubeVirtInformer cache.SharedIndexInformer,
+	instancetypeMethods instancetype.Methods,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) (*InstancetypeUpdateController, error) {
+
+	rl := workqueue.NewMaxOfRateLimiter(
+		workqueue.NewItemExponentialFailureRateLimiter(defaultThrottleInterval, 300*time.Second),
+		&workqueue.BucketRateLimiter{Limiter: rate.NewLimiter(rate.Every(defaultThrottleInterval), 1)},
+	)
+
+	c := &InstancetypeUpdateController{
+		queue:                       workqueue.NewNamedRateLimitingQueue(rl, "virt-controller-instancetype-update"),
+		vmInformer:                  vmInformer,
+		vmiInformer:                 vmiInformer,
+		instancetypeInformer:        instancetypeInformer,
+		clusterInstancetypeInformer: clusterInstancetypeInformer,
+		preferenceInformer:          preferenceInformer,
+		clusterPreferenceInformer:   clusterPreferenceInformer,
+		controllerRevisionInformer:  controllerRevisionInformer,
+		kubeVirtInformer:            kubeVirtInformer,
+		instancetypeMethods:         instancetypeMethods,
+		recorder:                    recorder,
+		clientset:                   clientset,
+		clusterConfig:               clusterConfig,
+		pendingVMs:                  map[string]struct{}{},
+	}
+
+	for _, h := range []struct {
+		informer cache.SharedIndexInformer
+		enqueue  func(interface{})
+	}{
+		{c.vmInformer, c.enqueueVM},
+		{c.instancetypeInformer, c.enqueueVMsByIndex(instancetypeIndex)},
+		{c.clusterInstancetypeInformer, c.enqueueVMsByIndex(instancetypeIndex)},
+		{c.preferenceInformer, c.enqueueVMsByIndex(preferenceIndex)},
+		{c.clusterPreferenceInformer, c.enqueueVMsByIndex(preferenceIndex)},
+		{c.kubeVirtInformer, func(_ interface{}) { c.enqueueAllVMs() }},
+	} {
+		enqueue := h.enqueue
+		_, err := h.informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    enqueue,
+			UpdateFunc: func(_, obj interface{}) { enqueue(obj) },
+			DeleteFunc: enqueue,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (c *InstancetypeUpdateController) enqueueVM(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from VirtualMachine.")
+		return
+	}
+	c.enqueue(key)
+}
+
+// enqueueVMsByIndex returns a handler which enqueues the VirtualMachines referencing the changed object
+func (c *InstancetypeUpdateController) enqueueVMsByIndex(indexName string) func(interface{}) {
+	return func(obj interface{}) {
+		key, err := controller.KeyFunc(obj)
+		if err != nil {
+			log.Log.Reason(err).Errorf("Failed to extract key from object referenced through the %s index.", indexName)
+			return
+		}
+		objs, err := c.vmInformer.GetIndexer().ByIndex(indexName, key)
+		if err != nil {
+			log.Log.Reason(err).Errorf("Failed to look up VirtualMachines through the %s index.", indexName)
+			return
+		}
+		keys := make([]string, 0, len(objs))
+		for _, obj := range objs {
+			vm := obj.(*virtv1.VirtualMachine)
+			keys = append(keys, controller.NamespacedKey(vm.Namespace, vm.Name))
+		}
+		c.enqueue(keys...)
+	}
+}
+
+// enqueueAllVMs is used when the update configuration changes, as it can affect every VirtualMachine
+func (c *InstancetypeUpdateController) enqueueAllVMs() {
+	c.enqueue(c.vmInformer.GetStore().ListKeys()...)
+}
+
+func (c *InstancetypeUpdateController) enqueue(vmKeys ...string) {
+	if len(vmKeys) == 0 {
+		return
+	}
+	c.pendingLock.Lock()
+	for _, key := range vmKeys {
+		c.pendingVMs[key] = struct{}{}
+	}
+	c.pendingLock.Unlock()
+	c.queue.AddAfter(updateKey, defaultThrottleInterval)
+}
+
+func (c *InstancetypeUpdateController) pendingVMKeys() []string {
+	c.pendingLock.Lock()
+	defer c.pendingLock.Unlock()
+	keys := make([]string, 0, len(c.pendingVMs))
+	for key := range c.pendingVMs {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// settleVM drops a VirtualMachine from the pending ones, it is picked up again on its next change
+func (c *InstancetypeUpdateController) settleVM(key string) {
+	c.pendingLock.Lock()
+	defer c.pendingLock.Unlock()
+	delete(c.pendingVMs, key)
+}
+
+// Run runs the passed in InstancetypeUpdateController.
+func (c *InstancetypeUpdateController) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting instancetype update controller.")
+
+	// Wait for cache sync before we start the controller
+	cache.WaitForCacheSync(stopCh,
+		c.vmInformer.HasSynced,
+		c.vmiInformer.HasSynced,
+		c.instancetypeInformer.HasSynced,
+		c.clusterInstancetypeInformer.HasSynced,
+		c.preferenceInformer.HasSynced,
+		c.clusterPreferenceInformer.HasSynced,
+		c.controllerRevisionInformer.HasSynced,
+		c.kubeVirtInformer.HasSynced,
+	)
+
+	// The queue only ever holds a single key, so there is no point in running more than one worker
+	go wait.Until(c.runWorker, time.Second, stopCh)
+
+	<-stopCh
+	log.Log.Info("Stopping instancetype update controller.")
+}
+
+func (c *InstancetypeUpdateController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *InstancetypeUpdateController) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+	err := c.execute()
+
+	if err != nil {
+		log.Log.Reason(err).Info("reenqueuing instancetype updates")
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Info("processed instancetype updates")
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *InstancetypeUpdateController) execute() error {
+	methods := c.clusterConfig.GetInstancetypeUpdateMethods()
+	if len(methods) == 0 {
+		return nil
+	}
+
+	liveMigrateAllowed := false
+	restartAllowed := false
+	for _, method := range methods {
+		switch method {
+		case virtv1.InstancetypeUpdateMethodLiveMigrate:
+			liveMigrateAllowed = true
+		case virtv1.InstancetypeUpdateMethodRestart:
+			restartAllowed = true
+		}
+	}
+
+	data := c.getUpdateData()
+
+	// Rather than watching all VMI activity, periodically re-enqueue until all VMs are updated
+	if len(data.inProgressVMs) != 0 || len(data.outdatedRunningVMs) != 0 {
+		c.queue.AddAfter(updateKey, periodicReEnqueueInterval)
+	}
+
+	var errs []error
+
+	inFlight := 0
+	for _, vm := range data.inProgressVMs {
+		settled, err := c.progressUpdate(vm, restartAllowed)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		if !settled {
+			inFlight++
+		}
+	}
+
+	// VMs which are not running can be moved onto the new revisions without any disruption
+	for _, vm := range data.outdatedStoppedVMs {
+		if err := c.updateRevisions(vm, ""); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	maxUnavailable := c.clusterConfig.GetInstancetypeUpdateMaxUnavailable(inFlight + len(data.outdatedRunningVMs))
+	for _, vm := range data.outdatedRunningVMs {
+		if inFlight >= maxUnavailable {
+			break
+		}
+		inFlight++
+
+		if liveMigrateAllowed {
+			if err := c.updateRevisions(vm, virtv1.InstancetypeUpdateMethodLiveMigrate); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		if err := c.updateRevisions(vm, virtv1.InstancetypeUpdateMethodRestart); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if err := c.restart(vm); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return nil
+}
+
+func (c *InstancetypeUpdateController) getUpdateData() *updateData {
+	data := &updateData{}
+
+	for _, key := range c.pendingVMKeys() {
+		obj, exists, err := c.vmInformer.GetStore().GetByKey(key)
+		if err != nil {
+			log.Log.Reason(err).Warningf("failed to look up VirtualMachine %s", key)
+			continue
+		}
+		if !exists {
+			c.settleVM(key)
+			continue
+		}
+		vm := obj.(*virtv1.VirtualMachine)
+		if vm.DeletionTimestamp != nil {
+			c.settleVM(key)
+			continue
+		}
+
+		if _, inProgress := vm.Annotations[virtv1.InstancetypeUpdateAnnotation]; inProgress {
+			data.inProgressVMs = append(data.inProgressVMs, vm)
+			continue
+		}
+
+		outdated, err := c.instancetypeMethods.IsOutdated(vm)
+		if err != nil {
+			log.Log.Object(vm).Reason(err).Warning("failed to check if the instancetype or preference revisions are outdated")
+			continue
+		}
+		if !outdated {
+			c.settleVM(key)
+			continue
+		}
+
+		if vmi := c.getVMI(vm); vmi != nil && !vmi.IsFinal() {
+			data.outdatedRunningVMs = append(data.outdatedRunningVMs, vm)
+		} else {
+			data.outdatedStoppedVMs = append(data.outdatedStoppedVMs, vm)
+		}
+	}
+
+	return data
+}
+
+func (c *InstancetypeUpdateController) getVMI(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
+	obj, exists, err := c.vmiInformer.GetStore().GetByKey(controller.NamespacedKey(vm.Namespace, vm.Name))
+	if err != nil || !exists {
+		return nil
+	}
+	return obj.(*virtv1.VirtualMachineInstance)
+}
+
+// progressUpdate drives a VirtualMachine which is being updated and returns true
+// once it no longer counts towards the VirtualMachines being unavailable.
+func (c *InstancetypeUpdateController) progressUpdate(vm *virtv1.VirtualMachine, restartAllowed bool) (bool, error) {
+	if !revisionsCaptured(vm) {
+		return false, nil
+	}
+
+	vmi := c.getVMI(vm)
+	method := virtv1.InstancetypeUpdateMethod(vm.Annotations[virtv1.InstancetypeUpdateAnnotation])
+	if controller.NewVirtualMachineConditionManager().HasCondition(vm, virtv1.VirtualMachineRestartRequired) {
+		switch {
+		case method == virtv1.InstancetypeUpdateMethodLiveMigrate && !restartAllowed:
+			// The new revisions are staged until the VM is restarted by its owner
+			c.recorder.Eventf(vm, k8sv1.EventTypeNormal, StagedUpdateReason, "The new instancetype or preference revisions require a restart to be applied")
+			return true, c.removeUpdateAnnotation(vm)
+		case method == virtv1.InstancetypeUpdateMethodLiveMigrate:
+			if err := c.setUpdateAnnotation(vm, virtv1.InstancetypeUpdateMethodRestart); err != nil {
+				return false, err
+			}
+			return false, c.restart(vm)
+		case isRestartPending(vm, vmi):
+			return false, nil
+		default:
+			// A previous restart did not go through
+			return false, c.restart(vm)
+		}
+	}
+
+	if !isUpdateSettled(vm, vmi) {
+		return false, nil
+	}
+
+	log.Log.Object(vm).Info("VirtualMachine moved onto new instancetype revisions")
+	return true, c.removeUpdateAnnotation(vm)
+}
+
+func revisionsCaptured(vm *virtv1.VirtualMachine) bool {
+	return (vm.Spec.Instancetype == nil || vm.Spec.Instancetype.RevisionName != "") &&
+		(vm.Spec.Preference == nil || vm.Spec.Preference.RevisionName != "")
+}
+
+func isRestartPending(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	return len(vm.Status.StateChangeRequests) > 0 || vmi == nil || vmi.DeletionTimestamp != nil
+}
+
+func isUpdateSettled(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	if vm.Status.PrintableStatus == virtv1.VirtualMachineStatusStopped {
+		return true
+	}
+
+	if vmi == nil || !vmi.IsRunning() || migrationutils.IsMigrating(vmi) || isLiveUpdateInProgress(vmi) {
+		return false
+	}
+
+	return vm.Status.Ready
+}
+
+func isLiveUpdateInProgress(vmi *virtv1.VirtualMachineInstance) bool {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	return condManager.HasCondition(vmi, virtv1.VirtualMachineInstanceVCPUChange) ||
+		condManager.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceMemoryChange, k8sv1.ConditionTrue)
+}
+
+// updateRevisions clears the captured revisions of the VirtualMachine, causing the VM controller to
+// capture new revisions of the referenced instancetype and preference. When a method is passed
+// the VirtualMachine is marked as being updated until the new revisions are applied.
+func (c *InstancetypeUpdateController) updateRevisions(vm *virtv1.VirtualMachine, method virtv1.InstancetypeUpdateMethod) error {
+	patchSet := patch.New()
+	if vm.Spec.Instancetype != nil && vm.Spec.Instancetype.RevisionName != "" {
+		patchSet.AddOption(
+			patch.WithTest("/spec/instancetype/revisionName", vm.Spec.Instancetype.RevisionName),
+			patch.WithRemove("/spec/instancetype/revisionName"),
+		)
+	}
+	if vm.Spec.Preference != nil && vm.Spec.Preference.RevisionName != "" {
+		patchSet.AddOption(
+			patch.WithTest("/spec/preference/revisionName", vm.Spec.Preference.RevisionName),
+			patch.WithRemove("/spec/preference/revisionName"),
+		)
+	}
+	if method != "" {
+		addUpdateAnnotation(patchSet, vm, method)
+	}
+
+	if err := c.patchVM(vm, patchSet); err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to move VirtualMachine onto new instancetype revisions")
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedUpdateRevisionReason, "Error moving VirtualMachine onto new instancetype or preference revisions: %v", err)
+		return err
+	}
+
+	log.Log.Object(vm).Infof("Moving VirtualMachine onto new instancetype revisions")
+	c.recorder.Eventf(vm, k8sv1.EventTypeNormal, SuccessfulUpdateRevisionReason, "Moving VirtualMachine onto new instancetype or preference revisions")
+	return nil
+}
+
+func (c *InstancetypeUpdateController) restart(vm *virtv1.VirtualMachine) error {
+	if err := c.clientset.VirtualMachine(vm.Namespace).Restart(context.Background(), vm.Name, &virtv1.RestartOptions{}); err != nil {
+		log.Log.Object(vm).Reason(err).Error("Failed to restart VirtualMachine as part of instancetype update")
+		c.recorder.Eventf(vm, k8sv1.EventTypeWarning, FailedRestartReason, "Error restarting VirtualMachine to apply new instancetype or preference revisions: %v", err)
+		return err
+	}
+
+	log.Log.Object(vm).Info("Restarted VirtualMachine as part of instancetype update")
+	c.recorder.Eventf(vm, k8sv1.EventTypeNormal, SuccessfulRestartReason, "Restarted VirtualMachine to apply new instancetype or preference revisions")
+	return nil
+}
+
+func addUpdateAnnotation(patchSet *patch.PatchSet, vm *virtv1.VirtualMachine, method virtv1.InstancetypeUpdateMethod) {
+	if vm.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{virtv1.InstancetypeUpdateAnnotation: string(method)}))
+		return
+	}
+	patchSet.AddOption(patch.WithAdd(annotationPath(), string(method)))
+}
+
+func (c *InstancetypeUpdateController) setUpdateAnnotation(vm *virtv1.VirtualMachine, method virtv1.InstancetypeUpdateMethod) error {
+	patchSet := patch.New()
+	addUpdateAnnotation(patchSet, vm, method)
+	return c.patchVM(vm, patchSet)
+}
+
+func (c *InstancetypeUpdateController) removeUpdateAnnotation(vm *virtv1.VirtualMachine) error {
+	return c.patchVM(vm, patch.New(
+		patch.WithTest(annotationPath(), vm.Annotations[virtv1.InstancetypeUpdateAnnotation]),
+		patch.WithRemove(annotationPath()),
+	))
+}
+
+func annotationPath() string {
+	return "/metadata/annotations/" + patch.EscapeJSONPointer(virtv1.InstancetypeUpdateAnnotation)
+}
+
+func (c *InstancetypeUpdateController) patchVM(vm *virtv1.VirtualMachine, patchSet *patch.PatchSet) error {
+	if patchSet.IsEmpty() {
+		return nil
+	}
+	payload, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+	return err
+}
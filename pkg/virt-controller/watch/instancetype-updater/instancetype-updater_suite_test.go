@@ -0,0 +1,11 @@
+package instancetypeupdater
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestInstancetypeUpdater(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,377 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package instancetypeupdater
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+
+	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Instancetype Updater", func() {
+	var (
+		ctrl                *gomock.Controller
+		vmInterface         *kubecli.MockVirtualMachineInterface
+		instancetypeMethods *testutils.MockInstancetypeMethods
+		recorder            *record.FakeRecorder
+		controller          *InstancetypeUpdateController
+		outdatedVMs         map[string]bool
+	)
+
+	newController := func(strategy *virtv1.InstancetypeUpdateStrategy) {
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		virtClient.EXPECT().VirtualMachine(k8sv1.NamespaceDefault).Return(vmInterface).AnyTimes()
+
+		vmInformer, _ := testutils.NewFakeInformerWithIndexersFor(&virtv1.VirtualMachine{}, kvcontroller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ := testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
+		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+		preferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
+		clusterPreferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
+		controllerRevisionInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+		kubeVirtInformer, _ := testutils.NewFakeInformerFor(&virtv1.KubeVirt{})
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{
+			InstancetypeUpdateStrategy: strategy,
+		})
+
+		var err error
+		controller, err = NewInstancetypeUpdateController(
+			vmInformer,
+			vmiInformer,
+			instancetypeInformer,
+			clusterInstancetypeInformer,
+			preferenceInformer,
+			clusterPreferenceInformer,
+			controllerRevisionInformer,
+			kubeVirtInformer,
+			instancetypeMethods,
+			recorder,
+			virtClient,
+			config,
+		)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newVM := func(name string, outdated bool) *virtv1.VirtualMachine {
+		vm := kubecli.NewMinimalVM(name)
+		vm.Namespace = k8sv1.NamespaceDefault
+		vm.Spec.Template = &virtv1.VirtualMachineInstanceTemplateSpec{}
+		vm.Spec.Instancetype = &virtv1.InstancetypeMatcher{
+			Name:         "instancetype",
+			RevisionName: "instancetype-revision",
+		}
+		vm.Spec.Preference = &virtv1.PreferenceMatcher{
+			Name:         "preference",
+			RevisionName: "preference-revision",
+		}
+		outdatedVMs[name] = outdated
+		return vm
+	}
+
+	addVM := func(vm *virtv1.VirtualMachine) {
+		Expect(controller.vmInformer.GetStore().Add(vm)).To(Succeed())
+		controller.enqueueVM(vm)
+	}
+
+	addRunningVMI := func(vm *virtv1.VirtualMachine) {
+		vmi := &virtv1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vm.Name,
+				Namespace: vm.Namespace,
+			},
+			Status: virtv1.VirtualMachineInstanceStatus{
+				Phase: virtv1.Running,
+			},
+		}
+		Expect(controller.vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	expectRevisionsCleared := func(name interface{}, method virtv1.InstancetypeUpdateMethod) *gomock.Call {
+		return vmInterface.EXPECT().Patch(context.Background(), name, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{}).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachine, error) {
+				payload := string(data)
+				Expect(payload).To(ContainSubstring(`{"op":"remove","path":"/spec/instancetype/revisionName"`))
+				Expect(payload).To(ContainSubstring(`{"op":"remove","path":"/spec/preference/revisionName"`))
+				if method == "" {
+					Expect(payload).ToNot(ContainSubstring(virtv1.InstancetypeUpdateAnnotation))
+				} else {
+					Expect(payload).To(ContainSubstring(fmt.Sprintf(`{%q:%q}`, virtv1.InstancetypeUpdateAnnotation, method)))
+				}
+				return nil, nil
+			})
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		outdatedVMs = map[string]bool{}
+		instancetypeMethods = testutils.NewMockInstancetypeMethods()
+		instancetypeMethods.IsOutdatedFunc = func(vm *virtv1.VirtualMachine) (bool, error) {
+			return outdatedVMs[vm.Name], nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should do nothing without any update methods", func() {
+		newController(nil)
+		addVM(newVM("testvm", true))
+
+		Expect(controller.execute()).To(Succeed())
+	})
+
+	Context("pending VirtualMachines", func() {
+		BeforeEach(func() {
+			newController(nil)
+		})
+
+		addVMToStore := func(vm *virtv1.VirtualMachine) {
+			Expect(controller.vmInformer.GetStore().Add(vm)).To(Succeed())
+		}
+
+		It("should only enqueue VirtualMachines referencing a changed instancetype", func() {
+			referencing := newVM("referencing", true)
+			addVMToStore(referencing)
+			other := newVM("other", true)
+			other.Spec.Instancetype.Name = "other"
+			addVMToStore(other)
+			namespaced := newVM("namespaced", true)
+			namespaced.Spec.Instancetype.Kind = "VirtualMachineInstancetype"
+			addVMToStore(namespaced)
+
+			controller.enqueueVMsByIndex(instancetypeIndex)(&instancetypev1beta1.VirtualMachineClusterInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "instancetype"},
+			})
+			Expect(controller.pendingVMKeys()).To(ConsistOf("default/referencing"))
+
+			controller.enqueueVMsByIndex(instancetypeIndex)(&instancetypev1beta1.VirtualMachineInstancetype{
+				ObjectMeta: metav1.ObjectMeta{Name: "instancetype", Namespace: k8sv1.NamespaceDefault},
+			})
+			Expect(controller.pendingVMKeys()).To(ConsistOf("default/referencing", "default/namespaced"))
+		})
+
+		It("should only enqueue VirtualMachines referencing a changed preference", func() {
+			referencing := newVM("referencing", true)
+			addVMToStore(referencing)
+			other := newVM("other", true)
+			other.Spec.Preference.Name = "other"
+			addVMToStore(other)
+
+			controller.enqueueVMsByIndex(preferenceIndex)(&instancetypev1beta1.VirtualMachineClusterPreference{
+				ObjectMeta: metav1.ObjectMeta{Name: "preference"},
+			})
+			Expect(controller.pendingVMKeys()).To(ConsistOf("default/referencing"))
+		})
+
+		It("should enqueue all VirtualMachines when the KubeVirt configuration changes", func() {
+			addVMToStore(newVM("first", true))
+			addVMToStore(newVM("second", false))
+
+			controller.enqueueAllVMs()
+			Expect(controller.pendingVMKeys()).To(ConsistOf("default/first", "default/second"))
+		})
+
+		It("should drop up-to-date and removed VirtualMachines while keeping outdated ones", func() {
+			addVM(newVM("outdated", true))
+			addVM(newVM("up-to-date", false))
+			controller.enqueue("default/removed")
+
+			data := controller.getUpdateData()
+			Expect(data.outdatedStoppedVMs).To(HaveLen(1))
+			Expect(controller.pendingVMKeys()).To(ConsistOf("default/outdated"))
+		})
+	})
+
+	It("should move stopped VirtualMachines onto new revisions", func() {
+		newController(&virtv1.InstancetypeUpdateStrategy{
+			Methods: []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodRestart},
+		})
+		addVM(newVM("outdated", true))
+		addVM(newVM("up-to-date", false))
+
+		expectRevisionsCleared("outdated", "")
+
+		Expect(controller.execute()).To(Succeed())
+		testutils.ExpectEvent(recorder, SuccessfulUpdateRevisionReason)
+	})
+
+	It("should restart running VirtualMachines up to maxUnavailable", func() {
+		maxUnavailable := intstr.FromInt(2)
+		newController(&virtv1.InstancetypeUpdateStrategy{
+			Methods:        []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodRestart},
+			MaxUnavailable: &maxUnavailable,
+		})
+		for i := 0; i < 5; i++ {
+			vm := newVM(fmt.Sprintf("testvm-%d", i), true)
+			addVM(vm)
+			addRunningVMI(vm)
+		}
+
+		expectRevisionsCleared(gomock.Any(), virtv1.InstancetypeUpdateMethodRestart).Times(2)
+		vmInterface.EXPECT().Restart(context.Background(), gomock.Any(), &virtv1.RestartOptions{}).Return(nil).Times(2)
+
+		Expect(controller.execute()).To(Succeed())
+		testutils.ExpectEvents(recorder,
+			SuccessfulUpdateRevisionReason, SuccessfulRestartReason,
+			SuccessfulUpdateRevisionReason, SuccessfulRestartReason,
+		)
+	})
+
+	It("should count VirtualMachines being updated towards maxUnavailable", func() {
+		newController(&virtv1.InstancetypeUpdateStrategy{
+			Methods: []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodRestart},
+		})
+		inProgressVM := newVM("in-progress", false)
+		inProgressVM.Annotations = map[string]string{virtv1.InstancetypeUpdateAnnotation: string(virtv1.InstancetypeUpdateMethodRestart)}
+		inProgressVM.Status.StateChangeRequests = []virtv1.VirtualMachineStateChangeRequest{{Action: virtv1.StartRequest}}
+		inProgressVM.Status.Conditions = []virtv1.VirtualMachineCondition{{
+			Type:   virtv1.VirtualMachineRestartRequired,
+			Status: k8sv1.ConditionTrue,
+		}}
+		addVM(inProgressVM)
+
+		outdatedVM := newVM("outdated", true)
+		addVM(outdatedVM)
+		addRunningVMI(outdatedVM)
+
+		Expect(controller.execute()).To(Succeed())
+	})
+
+	It("should live migrate running VirtualMachines when allowed", func() {
+		newController(&virtv1.InstancetypeUpdateStrategy{
+			Methods: []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodLiveMigrate, virtv1.InstancetypeUpdateMethodRestart},
+		})
+		vm := newVM("testvm", true)
+		addVM(vm)
+		addRunningVMI(vm)
+
+		expectRevisionsCleared(vm.Name, virtv1.InstancetypeUpdateMethodLiveMigrate)
+
+		Expect(controller.execute()).To(Succeed())
+		testutils.ExpectEvent(recorder, SuccessfulUpdateRevisionReason)
+	})
+
+	Context("with a VirtualMachine being updated", func() {
+		var vm *virtv1.VirtualMachine
+
+		expectAnnotationPatch := func(op string, method virtv1.InstancetypeUpdateMethod) {
+			vmInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{}).DoAndReturn(
+				func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*virtv1.VirtualMachine, error) {
+					Expect(string(data)).To(ContainSubstring(fmt.Sprintf(`{"op":%q,"path":"/metadata/annotations/kubevirt.io~1instancetypeUpdate","value":%q}`, op, method)))
+					return nil, nil
+				})
+		}
+
+		setRestartRequired := func() {
+			vm.Status.Conditions = []virtv1.VirtualMachineCondition{{
+				Type:   virtv1.VirtualMachineRestartRequired,
+				Status: k8sv1.ConditionTrue,
+			}}
+		}
+
+		BeforeEach(func() {
+			vm = newVM("testvm", false)
+		})
+
+		DescribeTable("should remove the annotation once settled", func(method virtv1.InstancetypeUpdateMethod) {
+			newController(&virtv1.InstancetypeUpdateStrategy{
+				Methods: []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodLiveMigrate},
+			})
+			vm.Annotations = map[string]string{virtv1.InstancetypeUpdateAnnotation: string(method)}
+			vm.Status.Ready = true
+			addVM(vm)
+			addRunningVMI(vm)
+
+			expectAnnotationPatch("test", method)
+
+			Expect(controller.execute()).To(Succeed())
+		},
+			Entry("after a live update", virtv1.InstancetypeUpdateMethodLiveMigrate),
+			Entry("after a restart", virtv1.InstancetypeUpdateMethodRestart),
+		)
+
+		It("should not remove the annotation before new revisions are captured", func() {
+			newController(&virtv1.InstancetypeUpdateStrategy{
+				Methods: []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodLiveMigrate},
+			})
+			vm.Annotations = map[string]string{virtv1.InstancetypeUpdateAnnotation: string(virtv1.InstancetypeUpdateMethodLiveMigrate)}
+			vm.Spec.Instancetype.RevisionName = ""
+			vm.Status.Ready = true
+			addVM(vm)
+			addRunningVMI(vm)
+
+			Expect(controller.execute()).To(Succeed())
+		})
+
+		It("should restart when a restart is required and allowed", func() {
+			newController(&virtv1.InstancetypeUpdateStrategy{
+				Methods: []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodLiveMigrate, virtv1.InstancetypeUpdateMethodRestart},
+			})
+			vm.Annotations = map[string]string{virtv1.InstancetypeUpdateAnnotation: string(virtv1.InstancetypeUpdateMethodLiveMigrate)}
+			setRestartRequired()
+			addVM(vm)
+			addRunningVMI(vm)
+
+			expectAnnotationPatch("add", virtv1.InstancetypeUpdateMethodRestart)
+			vmInterface.EXPECT().Restart(context.Background(), vm.Name, &virtv1.RestartOptions{}).Return(nil)
+
+			Expect(controller.execute()).To(Succeed())
+			testutils.ExpectEvent(recorder, SuccessfulRestartReason)
+		})
+
+		It("should stage the update when a restart is required but not allowed", func() {
+			newController(&virtv1.InstancetypeUpdateStrategy{
+				Methods: []virtv1.InstancetypeUpdateMethod{virtv1.InstancetypeUpdateMethodLiveMigrate},
+			})
+			vm.Annotations = map[string]string{virtv1.InstancetypeUpdateAnnotation: string(virtv1.InstancetypeUpdateMethodLiveMigrate)}
+			setRestartRequired()
+			addVM(vm)
+			addRunningVMI(vm)
+
+			expectAnnotationPatch("test", virtv1.InstancetypeUpdateMethodLiveMigrate)
+
+			Expect(controller.execute()).To(Succeed())
+			testutils.ExpectEvent(recorder, StagedUpdateReason)
+		})
+	})
+})
@@ -0,0 +1,217 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package watch
+
+import (
+	"context"
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+// pciBusIDAttribute is the attribute of the ResourceSlice instances holding the PCI address of a device
+const pciBusIDAttribute = "pciBusID"
+
+// allocatedDevice is a device allocated for a resource claim
+type allocatedDevice struct {
+	name       string
+	pciAddress string
+}
+
+// resourceSliceKey identifies the ResourceSlice of a driver on a node
+type resourceSliceKey struct {
+	nodeName   string
+	driverName string
+}
+
+// updateDeviceStatus records the devices allocated through resource claims for the GPUs and host devices of the VMI.
+// virt-launcher can't read the ResourceClaims, it takes the PCI addresses of the devices from the VMI status.
+func (c *VMIController) updateDeviceStatus(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) error {
+	if vmi.Status.DeviceStatus != nil || !hasClaimedDevices(vmi) {
+		return nil
+	}
+
+	resolver := &claimResolver{
+		c:         c,
+		vmi:       vmi,
+		pod:       pod,
+		allocated: map[string][]allocatedDevice{},
+		slices:    map[resourceSliceKey][]resourcev1alpha2.ResourceSlice{},
+	}
+	deviceStatus := &virtv1.DeviceStatus{}
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if gpu.Claim == nil {
+			continue
+		}
+		status, err := resolver.resolve(gpu.Name, gpu.Claim)
+		if err != nil {
+			return err
+		}
+		deviceStatus.GPUStatuses = append(deviceStatus.GPUStatuses, status)
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if hostDevice.Claim == nil {
+			continue
+		}
+		status, err := resolver.resolve(hostDevice.Name, hostDevice.Claim)
+		if err != nil {
+			return err
+		}
+		deviceStatus.HostDeviceStatuses = append(deviceStatus.HostDeviceStatuses, status)
+	}
+	vmi.Status.DeviceStatus = deviceStatus
+	return nil
+}
+
+func hasClaimedDevices(vmi *virtv1.VirtualMachineInstance) bool {
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if gpu.Claim != nil {
+			return true
+		}
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if hostDevice.Claim != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// claimResolver hands out the devices allocated for the resource claims of a VMI.
+// The devices referencing the same claim are assigned the allocated devices in order.
+type claimResolver struct {
+	c   *VMIController
+	vmi *virtv1.VirtualMachineInstance
+	pod *k8sv1.Pod
+
+	allocated map[string][]allocatedDevice
+	slices    map[resourceSliceKey][]resourcev1alpha2.ResourceSlice
+}
+
+func (r *claimResolver) resolve(name string, claim *virtv1.ClaimRequest) (virtv1.DeviceStatusInfo, error) {
+	resourceClaimName, err := r.resourceClaimName(claim.ClaimName)
+	if err != nil {
+		return virtv1.DeviceStatusInfo{}, err
+	}
+	devices, exists := r.allocated[claim.ClaimName]
+	if !exists {
+		devices, err = r.allocatedDevices(resourceClaimName)
+		if err != nil {
+			return virtv1.DeviceStatusInfo{}, err
+		}
+	}
+	if len(devices) == 0 {
+		return virtv1.DeviceStatusInfo{}, fmt.Errorf("no device left in resource claim %s for %s", resourceClaimName, name)
+	}
+	r.allocated[claim.ClaimName] = devices[1:]
+	return virtv1.DeviceStatusInfo{
+		Name:              name,
+		ResourceClaimName: resourceClaimName,
+		DeviceName:        devices[0].name,
+		PCIAddress:        devices[0].pciAddress,
+	}, nil
+}
+
+// resourceClaimName returns the name of the ResourceClaim of a claim of the VMI, claims created
+// from a template are looked up in the pod status.
+func (r *claimResolver) resourceClaimName(claimName string) (string, error) {
+	for _, podClaim := range r.vmi.Spec.ResourceClaims {
+		if podClaim.Name != claimName {
+			continue
+		}
+		if podClaim.Source.ResourceClaimName != nil {
+			return *podClaim.Source.ResourceClaimName, nil
+		}
+		for _, claimStatus := range r.pod.Status.ResourceClaimStatuses {
+			if claimStatus.Name == claimName && claimStatus.ResourceClaimName != nil {
+				return *claimStatus.ResourceClaimName, nil
+			}
+		}
+		return "", fmt.Errorf("resource claim %s of pod %s has not been created yet", claimName, r.pod.Name)
+	}
+	return "", fmt.Errorf("resource claim %s does not exist", claimName)
+}
+
+// allocatedDevices returns the devices allocated for a ResourceClaim with their PCI addresses
+func (r *claimResolver) allocatedDevices(resourceClaimName string) ([]allocatedDevice, error) {
+	resourceClaim, err := r.c.clientset.ResourceV1alpha2().ResourceClaims(r.vmi.Namespace).Get(context.Background(), resourceClaimName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resource claim %s: %v", resourceClaimName, err)
+	}
+	if resourceClaim.Status.Allocation == nil {
+		return nil, fmt.Errorf("resource claim %s is not allocated", resourceClaimName)
+	}
+
+	var devices []allocatedDevice
+	for _, handle := range resourceClaim.Status.Allocation.ResourceHandles {
+		// only the structured allocation results name the allocated devices
+		if handle.StructuredData == nil {
+			return nil, fmt.Errorf("resource claim %s is not allocated through structured parameters", resourceClaimName)
+		}
+		for _, result := range handle.StructuredData.Results {
+			if result.NamedResources == nil {
+				continue
+			}
+			pciAddress, err := r.pciAddress(resourceSliceKey{handle.StructuredData.NodeName, handle.DriverName}, result.NamedResources.Name)
+			if err != nil {
+				return nil, err
+			}
+			devices = append(devices, allocatedDevice{name: result.NamedResources.Name, pciAddress: pciAddress})
+		}
+	}
+	return devices, nil
+}
+
+// pciAddress returns the PCI address a driver publishes for a device in its ResourceSlices
+func (r *claimResolver) pciAddress(key resourceSliceKey, deviceName string) (string, error) {
+	slices, exists := r.slices[key]
+	if !exists {
+		sliceList, err := r.c.clientset.ResourceV1alpha2().ResourceSlices().List(context.Background(), metav1.ListOptions{
+			FieldSelector: fmt.Sprintf("nodeName=%s,driverName=%s", key.nodeName, key.driverName),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to list the resource slices of driver %s: %v", key.driverName, err)
+		}
+		slices = sliceList.Items
+		r.slices[key] = slices
+	}
+
+	for _, slice := range slices {
+		if slice.NodeName != key.nodeName || slice.DriverName != key.driverName || slice.NamedResources == nil {
+			continue
+		}
+		for _, instance := range slice.NamedResources.Instances {
+			if instance.Name != deviceName {
+				continue
+			}
+			for _, attribute := range instance.Attributes {
+				if attribute.Name == pciBusIDAttribute && attribute.StringValue != nil {
+					return *attribute.StringValue, nil
+				}
+			}
+			return "", fmt.Errorf("device %s of driver %s has no %s attribute", deviceName, key.driverName, pciBusIDAttribute)
+		}
+	}
+	return "", fmt.Errorf("device %s of driver %s not found on node %s", deviceName, key.driverName, key.nodeName)
+}
@@ -120,10 +120,9 @@ const (
 	AffinityChangeErrorReason          = "AffinityChangeError"
 	HotPlugMemoryErrorReason           = "HotPlugMemoryError"
 	VolumesUpdateErrorReason           = "VolumesUpdateError"
+	RestartLimitReachedReason          = "RestartLimitReached"
 )
 
-const defaultMaxCrashLoopBackoffDelaySeconds = 300
-
 func NewVMController(vmiInformer cache.SharedIndexInformer,
 	vmInformer cache.SharedIndexInformer,
 	dataVolumeInformer cache.SharedIndexInformer,
@@ -942,7 +941,8 @@ func (c *VMController) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi
 		}
 		vmCopy.Spec.Template.Spec.Volumes[i].ContainerDisk.ImagePullPolicy = vmiVol.ContainerDisk.ImagePullPolicy
 	}
-	if equality.Semantic.DeepEqual(vmi.Spec.Volumes, vmCopy.Spec.Template.Spec.Volumes) {
+	// The tools disk is attached to the VMI on creation and is not part of the VM spec
+	if equality.Semantic.DeepEqual(withoutToolsDisk(vmi.Spec.Volumes), withoutToolsDisk(vmCopy.Spec.Template.Spec.Volumes)) {
 		return nil
 	}
 	vmConditions := controller.NewVirtualMachineConditionManager()
@@ -989,6 +989,16 @@ func (c *VMController) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi
 	return nil
 }
 
+func withoutToolsDisk(volumes []virtv1.Volume) []virtv1.Volume {
+	filtered := make([]virtv1.Volume, 0, len(volumes))
+	for _, volume := range volumes {
+		if volume.Name != util.ToolsDiskName {
+			filtered = append(filtered, volume)
+		}
+	}
+	return filtered
+}
+
 func (c *VMController) addStartRequest(vm *virtv1.VirtualMachine) error {
 	addRequest := []virtv1.VirtualMachineStateChangeRequest{{Action: virtv1.StartRequest}}
 	req, err := json.Marshal(addRequest)
@@ -1023,6 +1033,12 @@ func (c *VMController) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 				log.Log.Object(vm).Infof("processing forced restart request for VMI with phase %s and VM runStrategy: %s", vmi.Status.Phase, runStrategy)
 			}
 
+			if !forceRestart && vmi.IsFinal() && c.isRestartLimitReached(vm, vmi, runStrategy) {
+				// Keep the final VMI around, a restart request is needed to start the VM again
+				log.Log.Object(vm).V(4).Infof("Not restarting VMI in phase %s, the restart limit of VM runStrategy: %s is reached", vmi.Status.Phase, runStrategy)
+				return vm, nil
+			}
+
 			if forceRestart || vmi.IsFinal() {
 				log.Log.Object(vm).Infof("%s with VMI in phase %s and VM runStrategy: %s", stoppingVmMsg, vmi.Status.Phase, runStrategy)
 
@@ -1041,6 +1057,10 @@ func (c *VMController) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 			return vm, nil
 		}
 
+		if !c.allowStartAfterRestartLimit(vm, runStrategy) {
+			return vm, nil
+		}
+
 		timeLeft := startFailureBackoffTimeLeft(vm)
 		if timeLeft > 0 {
 			log.Log.Object(vm).Infof("Delaying start of VM %s with 'runStrategy: %s' due to start failure backoff. Waiting %d more seconds before starting.", startingVmMsg, runStrategy, timeLeft)
@@ -1075,7 +1095,7 @@ func (c *VMController) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 					return vm, &syncErrorImpl{fmt.Errorf(failureDeletingVmiErrFormat, err), VMIFailedDeleteReason}
 				}
 
-				if vmiFailed {
+				if vmiFailed && !c.isRestartLimitReached(vm, vmi, runStrategy) {
 					if err := c.addStartRequest(vm); err != nil {
 						return vm, &syncErrorImpl{fmt.Errorf("failed to patch VM with start action: %v", err), VMIFailedDeleteReason}
 					}
@@ -1090,6 +1110,10 @@ func (c *VMController) syncRunStrategy(vm *virtv1.VirtualMachine, vmi *virtv1.Vi
 			return vm, nil
 		}
 
+		if !c.allowStartAfterRestartLimit(vm, runStrategy) {
+			return vm, nil
+		}
+
 		timeLeft := startFailureBackoffTimeLeft(vm)
 		if timeLeft > 0 {
 			log.Log.Object(vm).Infof("Delaying start of VM %s with 'runStrategy: %s' due to start failure backoff. Waiting %d more seconds before starting.", startingVmMsg, runStrategy, timeLeft)
@@ -1492,13 +1516,45 @@ func wasVMIInRunningPhase(vmi *virtv1.VirtualMachineInstance) bool {
 	return false
 }
 
-// Reports if vmi failed before ever hitting a running state
-func vmiFailedEarly(vmi *virtv1.VirtualMachineInstance) bool {
+// vmiRunningDuration reports how long the vmi stayed in the running phase.
+// For a vmi which is still running, the time until now is reported.
+func vmiRunningDuration(vmi *virtv1.VirtualMachineInstance) time.Duration {
+	var runningSince, runningUntil *metav1.Time
+	for i, ts := range vmi.Status.PhaseTransitionTimestamps {
+		switch ts.Phase {
+		case virtv1.Running:
+			runningSince = &vmi.Status.PhaseTransitionTimestamps[i].PhaseTransitionTimestamp
+		case virtv1.Succeeded, virtv1.Failed:
+			runningUntil = &vmi.Status.PhaseTransitionTimestamps[i].PhaseTransitionTimestamp
+		}
+	}
+
+	if runningSince == nil {
+		return 0
+	}
+	if runningUntil == nil {
+		return time.Since(runningSince.Time)
+	}
+	return runningUntil.Sub(runningSince.Time)
+}
+
+// Reports if vmi was running for at least minRunDuration
+func wasVMIRunningStable(vmi *virtv1.VirtualMachineInstance, minRunDuration time.Duration) bool {
+	if !wasVMIInRunningPhase(vmi) {
+		return false
+	}
+
+	return vmiRunningDuration(vmi) >= minRunDuration
+}
+
+// Reports if vmi failed before ever hitting a running state,
+// or terminated before it was running for at least minRunDuration
+func vmiFailedEarly(vmi *virtv1.VirtualMachineInstance, minRunDuration time.Duration) bool {
 	if vmi == nil || !vmi.IsFinal() {
 		return false
 	}
 
-	if wasVMIInRunningPhase(vmi) {
+	if wasVMIRunningStable(vmi, minRunDuration) {
 		return false
 	}
 
@@ -1506,11 +1562,11 @@ func vmiFailedEarly(vmi *virtv1.VirtualMachineInstance) bool {
 }
 
 // clear start failure tracking if...
-// 1. VMI exists and ever hit running phase
+// 1. VMI exists and was running for at least minRunDuration
 // 2. run strategy is not set to automatically restart failed VMIs
-func shouldClearStartFailure(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+func shouldClearStartFailure(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, minRunDuration time.Duration) bool {
 
-	if wasVMIInRunningPhase(vmi) {
+	if wasVMIRunningStable(vmi, minRunDuration) {
 		return true
 	}
 
@@ -1546,12 +1602,25 @@ func startFailureBackoffTimeLeft(vm *virtv1.VirtualMachine) int64 {
 	return 0
 }
 
-func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
-	if shouldClearStartFailure(vm, vmi) {
-		// if a vmi associated with the vm hits a running phase, then reset the start failure counter
+// startFailureStableRunTimeLeft reports how long a running vmi still has to run
+// before an existing start failure of the vm can be cleared
+func startFailureStableRunTimeLeft(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, minRunDuration time.Duration) time.Duration {
+	if vm.Status.StartFailure == nil || vmi == nil || vmi.IsFinal() || !wasVMIInRunningPhase(vmi) {
+		return 0
+	}
+
+	if timeLeft := minRunDuration - vmiRunningDuration(vmi); timeLeft > 0 {
+		return timeLeft
+	}
+	return 0
+}
+
+func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, minRunDuration time.Duration, maxDelaySeconds int) {
+	if shouldClearStartFailure(vm, vmi, minRunDuration) {
+		// if a vmi associated with the vm was running long enough, then reset the start failure counter
 		vm.Status.StartFailure = nil
 
-	} else if vmi != nil && vmiFailedEarly(vmi) {
+	} else if vmi != nil && vmiFailedEarly(vmi, minRunDuration) {
 		// if the VMI failed without ever hitting running successfully,
 		// record this as a start failure so we can back off retrying
 		if vm.Status.StartFailure != nil && vm.Status.StartFailure.LastFailedVMIUID == vmi.UID {
@@ -1565,7 +1634,7 @@ func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 		}
 
 		now := metav1.NewTime(time.Now())
-		delaySeconds := calculateStartBackoffTime(count, defaultMaxCrashLoopBackoffDelaySeconds)
+		delaySeconds := calculateStartBackoffTime(count, maxDelaySeconds)
 		retryAfter := metav1.NewTime(now.Time.Add(time.Duration(int64(delaySeconds)) * time.Second))
 
 		vm.Status.StartFailure = &virtv1.VirtualMachineStartFailure{
@@ -1576,6 +1645,65 @@ func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 	}
 }
 
+// consecutiveStartFailures reports the number of consecutive start failures of the vm,
+// including a failure of vmi which was not recorded yet
+func consecutiveStartFailures(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, minRunDuration time.Duration) int {
+	if wasVMIRunningStable(vmi, minRunDuration) {
+		return 0
+	}
+
+	count := 0
+	if vm.Status.StartFailure != nil {
+		count = vm.Status.StartFailure.ConsecutiveFailCount
+	}
+	if vmiFailedEarly(vmi, minRunDuration) && (vm.Status.StartFailure == nil || vm.Status.StartFailure.LastFailedVMIUID != vmi.UID) {
+		count++
+	}
+	return count
+}
+
+// isRestartLimitReached reports if the vm failed to start as often as the
+// restart limit configured for the run strategy allows
+func (c *VMController) isRestartLimitReached(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, runStrategy virtv1.VirtualMachineRunStrategy) bool {
+	limit, exists := c.clusterConfig.GetCrashLoopBackOffRestartLimit(runStrategy)
+	if !exists {
+		return false
+	}
+
+	return consecutiveStartFailures(vm, vmi, c.clusterConfig.GetCrashLoopBackOffMinRunDuration()) >= limit
+}
+
+// allowStartAfterRestartLimit reports if a vm without vmi may be started. Once the restart
+// limit is reached, only a start or restart request starts the vm again. Such a request
+// resets the start failure tracking, and with it the restart limit and the backoff.
+func (c *VMController) allowStartAfterRestartLimit(vm *virtv1.VirtualMachine, runStrategy virtv1.VirtualMachineRunStrategy) bool {
+	if !c.isRestartLimitReached(vm, nil, runStrategy) {
+		return true
+	}
+
+	if !hasStartRequest(vm) {
+		log.Log.Object(vm).V(4).Infof("Not starting VM with 'runStrategy: %s', the restart limit is reached", runStrategy)
+		return false
+	}
+
+	log.Log.Object(vm).Infof("Resetting start failures of VM with 'runStrategy: %s' due to start request", runStrategy)
+	vm.Status.StartFailure = nil
+	return true
+}
+
+// syncRestartLimitEvent emits an event once the start failures of the vm reach the restart limit
+func (c *VMController) syncRestartLimitEvent(vm, vmOrig *virtv1.VirtualMachine, runStrategy virtv1.VirtualMachineRunStrategy) {
+	limit, exists := c.clusterConfig.GetCrashLoopBackOffRestartLimit(runStrategy)
+	if !exists || vm.Status.StartFailure == nil || vm.Status.StartFailure.ConsecutiveFailCount < limit {
+		return
+	}
+	if vmOrig.Status.StartFailure != nil && vmOrig.Status.StartFailure.ConsecutiveFailCount >= limit {
+		return
+	}
+
+	c.recorder.Eventf(vm, k8score.EventTypeWarning, RestartLimitReachedReason, "VirtualMachine failed to start %d consecutive times and will not be restarted until requested", vm.Status.StartFailure.ConsecutiveFailCount)
+}
+
 // here is stop
 func (c *VMController) stopVMI(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) (*virtv1.VirtualMachine, error) {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
@@ -2424,7 +2552,13 @@ func (c *VMController) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virt
 		popStateChangeRequest(vm)
 	}
 
-	syncStartFailureStatus(vm, vmi)
+	minRunDuration := c.clusterConfig.GetCrashLoopBackOffMinRunDuration()
+	syncStartFailureStatus(vm, vmi, minRunDuration, c.clusterConfig.GetCrashLoopBackOffMaxDelaySeconds())
+	c.syncRestartLimitEvent(vm, vmOrig, runStrategy)
+	if timeLeft := startFailureStableRunTimeLeft(vm, vmi, minRunDuration); timeLeft > 0 {
+		// re-evaluate the start failure once the vmi has been running long enough
+		c.Queue.AddAfter(key, timeLeft)
+	}
 	syncConditions(vm, vmi, syncErr)
 	c.setPrintableStatus(vm, vmi)
 
@@ -2474,6 +2608,7 @@ func (c *VMController) setPrintableStatus(vm *virtv1.VirtualMachine, vmi *virtv1
 		{virtv1.VirtualMachineStatusErrImagePull, c.isVirtualMachineStatusErrImagePull},
 		{virtv1.VirtualMachineStatusImagePullBackOff, c.isVirtualMachineStatusImagePullBackOff},
 		{virtv1.VirtualMachineStatusStarting, c.isVirtualMachineStatusStarting},
+		{virtv1.VirtualMachineStatusRestartLimitReached, c.isVirtualMachineStatusRestartLimitReached},
 		{virtv1.VirtualMachineStatusCrashLoopBackOff, c.isVirtualMachineStatusCrashLoopBackOff},
 		{virtv1.VirtualMachineStatusStopped, c.isVirtualMachineStatusStopped},
 	}
@@ -2511,6 +2646,23 @@ func (c *VMController) isVirtualMachineStatusCrashLoopBackOff(vm *virtv1.Virtual
 	return false
 }
 
+// isVirtualMachineStatusRestartLimitReached determines whether the VM status field should be set to "RestartLimitReached".
+func (c *VMController) isVirtualMachineStatusRestartLimitReached(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
+	if vmi != nil && !vmi.IsFinal() {
+		return false
+	} else if c.isVMIStartExpected(vm) {
+		return false
+	}
+
+	runStrategy, err := vm.RunStrategy()
+	if err != nil {
+		log.Log.Object(vm).Errorf(fetchingRunStrategyErrFmt, err)
+		return false
+	}
+
+	return c.isRestartLimitReached(vm, nil, runStrategy)
+}
+
 // isVirtualMachineStatusStopped determines whether the VM status field should be set to "Stopped".
 func (c *VMController) isVirtualMachineStatusStopped(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) bool {
 	if vmi != nil {
@@ -44,6 +44,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/instancetype"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
 
@@ -1511,6 +1512,287 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("should track start failures when VMIs terminate before reaching the minimum run duration", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							CrashLoopBackOff: &v1.CrashLoopBackOffConfiguration{
+								MinRunDurationSeconds: pointer.P(int64(60)),
+							},
+						},
+					},
+				})
+
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.UID = "123"
+				vmi.Status.Phase = v1.Failed
+				now := time.Now()
+				vmi.Status.PhaseTransitionTimestamps = []v1.VirtualMachineInstancePhaseTransitionTimestamp{
+					{
+						Phase:                    v1.Running,
+						PhaseTransitionTimestamp: metav1.NewTime(now.Add(-10 * time.Second)),
+					},
+					{
+						Phase:                    v1.Failed,
+						PhaseTransitionTimestamp: metav1.NewTime(now),
+					},
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				shouldExpectVMIFinalizerRemoval()
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+
+				Expect(vm.Status.StartFailure).ToNot(BeNil())
+				Expect(vm.Status.StartFailure.LastFailedVMIUID).To(Equal(vmi.UID))
+				Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(1))
+			})
+
+			It("should not clear start failures when VMI is running shorter than the minimum run duration", func() {
+				testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+					Spec: v1.KubeVirtSpec{
+						Configuration: v1.KubeVirtConfiguration{
+							CrashLoopBackOff: &v1.CrashLoopBackOffConfiguration{
+								MinRunDurationSeconds: pointer.P(int64(60)),
+							},
+						},
+					},
+				})
+
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.UID = "456"
+				vmi.Status.Phase = v1.Running
+				vmi.Status.PhaseTransitionTimestamps = []v1.VirtualMachineInstancePhaseTransitionTimestamp{
+					{
+						Phase:                    v1.Running,
+						PhaseTransitionTimestamp: metav1.Now(),
+					},
+				}
+
+				vm.Status.StartFailure = &v1.VirtualMachineStartFailure{
+					LastFailedVMIUID:     "123",
+					ConsecutiveFailCount: 1,
+					RetryAfterTimestamp: &metav1.Time{
+						Time: time.Now().Add(-300 * time.Second),
+					},
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.StartFailure).ToNot(BeNil())
+				Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(1))
+			})
+
+			Context("with restart limits", func() {
+				const restartLimit = 3
+
+				BeforeEach(func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								CrashLoopBackOff: &v1.CrashLoopBackOffConfiguration{
+									RestartLimits: []v1.RestartLimit{
+										{RunStrategy: v1.RunStrategyAlways, MaxConsecutiveFailures: restartLimit},
+										{RunStrategy: v1.RunStrategyRerunOnFailure, MaxConsecutiveFailures: restartLimit},
+									},
+								},
+							},
+						},
+					})
+				})
+
+				newVMWithStartFailures := func(runStrategy v1.VirtualMachineRunStrategy, failCount int) (*v1.VirtualMachine, *v1.VirtualMachineInstance) {
+					vm, vmi := DefaultVirtualMachine(true)
+					vm.Spec.Running = nil
+					vm.Spec.RunStrategy = &runStrategy
+					vm.Status.RunStrategy = runStrategy
+					vm.Status.StartFailure = &v1.VirtualMachineStartFailure{
+						LastFailedVMIUID:     "123",
+						ConsecutiveFailCount: failCount,
+						RetryAfterTimestamp: &metav1.Time{
+							Time: time.Now().Add(300 * time.Second),
+						},
+					}
+					return vm, vmi
+				}
+
+				createVM := func(vm *v1.VirtualMachine) *v1.VirtualMachine {
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+					addVirtualMachine(vm)
+					return vm
+				}
+
+				createFailedVMI := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstance {
+					vmi.UID = "456"
+					vmi.Status.Phase = v1.Failed
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.TODO(), vmi, metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					controller.vmiIndexer.Add(vmi)
+					return vmi
+				}
+
+				getVM := func(vm *v1.VirtualMachine) *v1.VirtualMachine {
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).To(Succeed())
+					return vm
+				}
+
+				listVMIs := func(vm *v1.VirtualMachine) []v1.VirtualMachineInstance {
+					vmis, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).List(context.TODO(), metav1.ListOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					return vmis.Items
+				}
+
+				DescribeTable("should not start a VM without VMI once the limit is reached", func(runStrategy v1.VirtualMachineRunStrategy) {
+					vm, _ := newVMWithStartFailures(runStrategy, restartLimit)
+					if runStrategy == v1.RunStrategyRerunOnFailure {
+						vm.Status.RunStrategy = v1.RunStrategyRerunOnFailure
+					}
+					vm = createVM(vm)
+
+					sanityExecute(vm)
+
+					Expect(listVMIs(vm)).To(BeEmpty())
+					Expect(recorder.Events).To(BeEmpty())
+					vm = getVM(vm)
+					Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(restartLimit))
+					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusRestartLimitReached))
+				},
+					Entry("with runStrategy Always", v1.RunStrategyAlways),
+					Entry("with runStrategy RerunOnFailure", v1.RunStrategyRerunOnFailure),
+				)
+
+				It("should start a VM with runStrategy Always below the limit after the backoff", func() {
+					vm, _ := newVMWithStartFailures(v1.RunStrategyAlways, restartLimit-1)
+					vm.Status.StartFailure.RetryAfterTimestamp = &metav1.Time{Time: time.Now().Add(-300 * time.Second)}
+					vm = createVM(vm)
+
+					sanityExecute(vm)
+
+					Expect(listVMIs(vm)).To(HaveLen(1))
+					testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+				})
+
+				It("should keep the failed VMI of a VM with runStrategy Always reaching the limit", func() {
+					vm, vmi := newVMWithStartFailures(v1.RunStrategyAlways, restartLimit-1)
+					vm = createVM(vm)
+					vmi = createFailedVMI(vmi)
+
+					shouldExpectVMIFinalizerRemoval()
+
+					sanityExecute(vm)
+
+					testutils.ExpectEvent(recorder, RestartLimitReachedReason)
+					Expect(listVMIs(vm)).To(HaveLen(1))
+					vm = getVM(vm)
+					Expect(vm.Status.StartFailure.LastFailedVMIUID).To(Equal(vmi.UID))
+					Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(restartLimit))
+					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusRestartLimitReached))
+				})
+
+				It("should not emit the event again once the limit is reached", func() {
+					vm, vmi := newVMWithStartFailures(v1.RunStrategyAlways, restartLimit)
+					vm.Status.StartFailure.LastFailedVMIUID = "456"
+					vm = createVM(vm)
+					createFailedVMI(vmi)
+
+					shouldExpectVMIFinalizerRemoval()
+
+					sanityExecute(vm)
+
+					Expect(recorder.Events).To(BeEmpty())
+					Expect(listVMIs(vm)).To(HaveLen(1))
+				})
+
+				It("should stop the kept VMI of a VM with runStrategy Always on a restart request", func() {
+					vm, vmi := newVMWithStartFailures(v1.RunStrategyAlways, restartLimit)
+					vm.Status.StartFailure.LastFailedVMIUID = "456"
+					vm.Status.StateChangeRequests = []v1.VirtualMachineStateChangeRequest{
+						{Action: v1.StopRequest, UID: pointer.P(types.UID("456"))},
+						{Action: v1.StartRequest},
+					}
+					vm = createVM(vm)
+					createFailedVMI(vmi)
+
+					sanityExecute(vm)
+
+					testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+					Expect(listVMIs(vm)).To(BeEmpty())
+				})
+
+				DescribeTable("should start a VM reaching the limit on a start request", func(runStrategy v1.VirtualMachineRunStrategy) {
+					vm, _ := newVMWithStartFailures(runStrategy, restartLimit)
+					vm.Status.StateChangeRequests = []v1.VirtualMachineStateChangeRequest{{Action: v1.StartRequest}}
+					vm = createVM(vm)
+
+					sanityExecute(vm)
+
+					testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineReason)
+					Expect(listVMIs(vm)).To(HaveLen(1))
+					vm = getVM(vm)
+					Expect(vm.Status.StartFailure).To(BeNil())
+					Expect(vm.Status.PrintableStatus).ToNot(Equal(v1.VirtualMachineStatusRestartLimitReached))
+				},
+					Entry("with runStrategy Always after a restart request", v1.RunStrategyAlways),
+					Entry("with runStrategy RerunOnFailure", v1.RunStrategyRerunOnFailure),
+				)
+
+				It("should not request a start of a VM with runStrategy RerunOnFailure reaching the limit", func() {
+					vm, vmi := newVMWithStartFailures(v1.RunStrategyRerunOnFailure, restartLimit-1)
+					vm = createVM(vm)
+					vmi = createFailedVMI(vmi)
+
+					shouldExpectVMIFinalizerRemoval()
+
+					sanityExecute(vm)
+
+					testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+					testutils.ExpectEvent(recorder, RestartLimitReachedReason)
+					vm = getVM(vm)
+					Expect(vm.Status.StateChangeRequests).To(BeEmpty())
+					Expect(vm.Status.StartFailure.LastFailedVMIUID).To(Equal(vmi.UID))
+					Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(restartLimit))
+				})
+
+				It("should request a start of a VM with runStrategy RerunOnFailure below the limit", func() {
+					vm, vmi := newVMWithStartFailures(v1.RunStrategyRerunOnFailure, restartLimit-2)
+					vm = createVM(vm)
+					createFailedVMI(vmi)
+
+					shouldExpectVMIFinalizerRemoval()
+
+					sanityExecute(vm)
+
+					testutils.ExpectEvent(recorder, SuccessfulDeleteVirtualMachineReason)
+					vm = getVM(vm)
+					Expect(vm.Status.StateChangeRequests).To(ConsistOf(v1.VirtualMachineStateChangeRequest{Action: v1.StartRequest}))
+					Expect(vm.Status.StartFailure.ConsecutiveFailCount).To(Equal(restartLimit - 1))
+					Expect(vm.Status.PrintableStatus).To(Equal(v1.VirtualMachineStatusCrashLoopBackOff))
+				})
+			})
+
 			DescribeTable("should clear existing start failures when runStrategy is halted or manual", func(runStrategy v1.VirtualMachineRunStrategy) {
 				vm, vmi := DefaultVirtualMachine(true)
 				vmi.UID = "456"
@@ -1569,7 +1851,7 @@ var _ = Describe("VirtualMachine", func() {
 			DescribeTable("should calculated expected backoff delay", func(failCount, minExpectedDelay int, maxExpectedDelay int) {
 
 				for i := 0; i < 1000; i++ {
-					delay := calculateStartBackoffTime(failCount, defaultMaxCrashLoopBackoffDelaySeconds)
+					delay := calculateStartBackoffTime(failCount, virtconfig.DefaultCrashLoopBackOffMaxDelaySeconds)
 
 					// check that minExpectedDelay <= delay <= maxExpectedDelay
 					Expect(delay).To(And(BeNumerically(">=", minExpectedDelay), BeNumerically("<=", maxExpectedDelay)))
@@ -6269,6 +6551,31 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(cond.Status).To(Equal(k8sv1.ConditionTrue))
 					Expect(cond.Message).To(ContainSubstring("invalid volumes to update with migration:"))
 				})
+
+				It("should not set the restart condition because of the tools disk", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+								DeveloperConfiguration: &v1.DeveloperConfiguration{
+									FeatureGates: []string{
+										virtconfig.VMLiveUpdateFeaturesGate,
+										virtconfig.VolumesUpdateStrategy,
+									},
+								},
+							},
+						},
+					})
+					vm, vmi := DefaultVirtualMachine(true)
+					vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+						Name: util.ToolsDiskName,
+						VolumeSource: v1.VolumeSource{
+							ContainerDisk: &v1.ContainerDiskSource{Image: "registry:5000/tools:latest"},
+						},
+					})
+					controller.handleVolumeUpdateRequest(vm, vmi)
+					Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, v1.VirtualMachineRestartRequired)).To(BeFalse())
+				})
 			})
 
 			Context("Instance Types and Preferences", func() {
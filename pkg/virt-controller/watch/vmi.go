@@ -73,6 +73,10 @@ const (
 
 const failedToRenderLaunchManifestErrFormat = "failed to render launch manifest: %v"
 
+// orphanedAttachmentPodsCheckInterval is how often attachment pods whose owner is gone are looked for.
+// Attachment pods younger than that are left alone, the pod cache may not know their owner yet.
+const orphanedAttachmentPodsCheckInterval = 5 * time.Minute
+
 func NewVMIController(templateService services.TemplateService,
 	vmiInformer cache.SharedIndexInformer,
 	vmInformer cache.SharedIndexInformer,
@@ -238,6 +242,7 @@ func (c *VMIController) Run(threadiness int, stopCh <-chan struct{}) {
 	for i := 0; i < threadiness; i++ {
 		go wait.Until(c.runWorker, time.Second, stopCh)
 	}
+	go wait.Until(c.deleteAttachmentPodsOfGoneOwners, orphanedAttachmentPodsCheckInterval, stopCh)
 
 	<-stopCh
 	log.Log.Info("Stopping vmi controller.")
@@ -603,6 +608,12 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 					return err
 				}
 
+				// Record the devices allocated through resource claims,
+				// virt-launcher takes their PCI addresses from the status
+				if err := c.updateDeviceStatus(vmiCopy, pod); err != nil {
+					return err
+				}
+
 				if err := c.updateInterfaceStatus(vmiCopy, pod); err != nil {
 					log.Log.Errorf("failed to update the interface status: %v", err)
 				}
@@ -1990,6 +2001,92 @@ func (c *VMIController) deleteOrphanedAttachmentPods(vmi *virtv1.VirtualMachineI
 	return nil
 }
 
+// deleteAttachmentPodsOfGoneOwners deletes the attachment pods left behind by interrupted operations, which the
+// VMI sync loop does not see anymore: the ones whose virt-launcher pod does not exist anymore, and the ones whose
+// virt-launcher pod is down while its VMI is gone or final. Deleting them also releases their PVCs, which are kept
+// from being deleted by the pvc-protection finalizer as long as a pod uses them.
+func (c *VMIController) deleteAttachmentPodsOfGoneOwners() {
+	for _, obj := range c.podIndexer.List() {
+		attachmentPod := obj.(*k8sv1.Pod)
+		if !services.IsHotplugAttachmentPod(attachmentPod) || attachmentPod.DeletionTimestamp != nil {
+			continue
+		}
+		ownerRef := controller.GetControllerOf(attachmentPod)
+		if ownerRef == nil || ownerRef.Kind != "Pod" {
+			continue
+		}
+		if time.Since(attachmentPod.CreationTimestamp.Time) < orphanedAttachmentPodsCheckInterval {
+			continue
+		}
+
+		ownerPod, err := c.getPod(attachmentPod.Namespace, ownerRef.Name)
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to get owner of attachment pod %s", controller.PodKey(attachmentPod))
+			continue
+		}
+		if ownerPod != nil && ownerPod.UID != ownerRef.UID {
+			ownerPod = nil
+		}
+		if ownerPod != nil && !controller.PodIsDown(ownerPod) {
+			continue
+		}
+
+		vmi := c.getVMIOfPod(ownerPod)
+		if vmi != nil && !vmi.IsFinal() && ownerPod != nil {
+			// the VMI sync loop takes care of it
+			continue
+		}
+
+		if err := c.deleteOrphanedAttachmentPod(vmi, attachmentPod); err != nil && !k8serrors.IsNotFound(err) {
+			log.Log.Reason(err).Errorf("failed to delete orphaned attachment pod %s", controller.PodKey(attachmentPod))
+		}
+	}
+}
+
+func (c *VMIController) getPod(namespace, name string) (*k8sv1.Pod, error) {
+	obj, exists, err := c.podIndexer.GetByKey(controller.NamespacedKey(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*k8sv1.Pod), nil
+}
+
+func (c *VMIController) getVMIOfPod(pod *k8sv1.Pod) *virtv1.VirtualMachineInstance {
+	if pod == nil {
+		return nil
+	}
+	ownerRef := controller.GetControllerOf(pod)
+	if ownerRef == nil || ownerRef.Kind != virtv1.VirtualMachineInstanceGroupVersionKind.Kind {
+		return nil
+	}
+	obj, exists, err := c.vmiIndexer.GetByKey(controller.NamespacedKey(pod.Namespace, ownerRef.Name))
+	if err != nil || !exists {
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if vmi.UID != ownerRef.UID {
+		return nil
+	}
+	return vmi
+}
+
+func (c *VMIController) deleteOrphanedAttachmentPod(vmi *virtv1.VirtualMachineInstance, attachmentPod *k8sv1.Pod) error {
+	if vmi != nil {
+		return c.deleteAttachmentPodForVolume(vmi, attachmentPod)
+	}
+
+	zero := int64(0)
+	err := c.clientset.CoreV1().Pods(attachmentPod.Namespace).Delete(context.Background(), attachmentPod.Name, v1.DeleteOptions{
+		GracePeriodSeconds: &zero,
+	})
+	if err != nil {
+		c.recorder.Eventf(attachmentPod, k8sv1.EventTypeWarning, controller.FailedDeletePodReason, "Failed to delete orphaned attachment pod %s", attachmentPod.Name)
+		return err
+	}
+	c.recorder.Eventf(attachmentPod, k8sv1.EventTypeNormal, controller.SuccessfulDeletePodReason, "Deleted orphaned attachment pod %s", attachmentPod.Name)
+	return nil
+}
+
 func (c *VMIController) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) error {
 	oldStatus := vmi.Status.DeepCopy().VolumeStatus
 	oldStatusMap := make(map[string]virtv1.VolumeStatus)
@@ -35,6 +35,7 @@ import (
 	gomegaTypes "github.com/onsi/gomega/types"
 
 	k8sv1 "k8s.io/api/core/v1"
+	resourcev1alpha2 "k8s.io/api/resource/v1alpha2"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -303,6 +304,7 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		).AnyTimes()
 		kubeClient = fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().ResourceV1alpha2().Return(kubeClient.ResourceV1alpha2()).AnyTimes()
 		networkClient = fakenetworkclient.NewSimpleClientset()
 		virtClient.EXPECT().NetworkClient().Return(networkClient).AnyTimes()
 
@@ -1493,6 +1495,138 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			controller.Execute()
 			expectVMIScheduledState(vmi)
 		})
+		Context("with devices allocated through resource claims", func() {
+			const (
+				driverName = "gpu.example.com"
+				nodeName   = "testnode"
+			)
+
+			newClaimedGPUVirtualMachine := func() *virtv1.VirtualMachineInstance {
+				vmi := NewPendingVirtualMachine("testvmi")
+				setReadyCondition(vmi, k8sv1.ConditionFalse, virtv1.GuestNotRunningReason)
+				vmi.Status.Phase = virtv1.Scheduling
+				vmi.Spec.ResourceClaims = []k8sv1.PodResourceClaim{{
+					Name:   "gpus",
+					Source: k8sv1.ClaimSource{ResourceClaimTemplateName: pointer.P("gpu-template")},
+				}}
+				vmi.Spec.Domain.Devices.GPUs = []virtv1.GPU{
+					{Name: "gpu0", Claim: &virtv1.ClaimRequest{ClaimName: "gpus"}},
+					{Name: "gpu1", Claim: &virtv1.ClaimRequest{ClaimName: "gpus"}},
+				}
+				return vmi
+			}
+
+			newClaimedGPUPod := func(vmi *virtv1.VirtualMachineInstance) *k8sv1.Pod {
+				pod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				pod.Spec.NodeName = nodeName
+				pod.Status.ResourceClaimStatuses = []k8sv1.PodResourceClaimStatus{{
+					Name:              "gpus",
+					ResourceClaimName: pointer.P("testvmi-gpus"),
+				}}
+				return pod
+			}
+
+			addAllocatedResourceClaim := func(namespace string, devices ...string) {
+				var results []resourcev1alpha2.DriverAllocationResult
+				for _, device := range devices {
+					results = append(results, resourcev1alpha2.DriverAllocationResult{
+						AllocationResultModel: resourcev1alpha2.AllocationResultModel{
+							NamedResources: &resourcev1alpha2.NamedResourcesAllocationResult{Name: device},
+						},
+					})
+				}
+				_, err := kubeClient.ResourceV1alpha2().ResourceClaims(namespace).Create(context.Background(), &resourcev1alpha2.ResourceClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "testvmi-gpus", Namespace: namespace},
+					Status: resourcev1alpha2.ResourceClaimStatus{
+						Allocation: &resourcev1alpha2.AllocationResult{
+							ResourceHandles: []resourcev1alpha2.ResourceHandle{{
+								DriverName:     driverName,
+								StructuredData: &resourcev1alpha2.StructuredResourceHandle{NodeName: nodeName, Results: results},
+							}},
+						},
+					},
+				}, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			addResourceSlice := func(pciAddressByDevice map[string]string) {
+				slice := &resourcev1alpha2.ResourceSlice{
+					ObjectMeta: metav1.ObjectMeta{Name: "testnode-gpus"},
+					NodeName:   nodeName,
+					DriverName: driverName,
+					ResourceModel: resourcev1alpha2.ResourceModel{
+						NamedResources: &resourcev1alpha2.NamedResourcesResources{},
+					},
+				}
+				for device, pciAddress := range pciAddressByDevice {
+					slice.NamedResources.Instances = append(slice.NamedResources.Instances, resourcev1alpha2.NamedResourcesInstance{
+						Name: device,
+						Attributes: []resourcev1alpha2.NamedResourcesAttribute{{
+							Name:                         "pciBusID",
+							NamedResourcesAttributeValue: resourcev1alpha2.NamedResourcesAttributeValue{StringValue: pointer.P(pciAddress)},
+						}},
+					})
+				}
+				_, err := kubeClient.ResourceV1alpha2().ResourceSlices().Create(context.Background(), slice, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			expectVMIUnscheduledWithoutDeviceStatus := func(vmi *virtv1.VirtualMachineInstance) {
+				expectVMIBeInPhase(vmi.Namespace, vmi.Name, virtv1.Scheduling)
+				updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVMI.Status.DeviceStatus).To(BeNil())
+			}
+
+			It("should record the PCI addresses of the allocated devices when the pod is ready", func() {
+				vmi := newClaimedGPUVirtualMachine()
+				pod := newClaimedGPUPod(vmi)
+
+				addVirtualMachine(vmi)
+				addPod(pod)
+				addAllocatedResourceClaim(vmi.Namespace, "gpu-0", "gpu-1")
+				addResourceSlice(map[string]string{"gpu-0": "0000:81:00.0", "gpu-1": "0000:82:00.0"})
+
+				controller.Execute()
+				expectVMIScheduledState(vmi)
+
+				updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(updatedVMI.Status.DeviceStatus).To(Equal(&virtv1.DeviceStatus{
+					GPUStatuses: []virtv1.DeviceStatusInfo{
+						{Name: "gpu0", ResourceClaimName: "testvmi-gpus", DeviceName: "gpu-0", PCIAddress: "0000:81:00.0"},
+						{Name: "gpu1", ResourceClaimName: "testvmi-gpus", DeviceName: "gpu-1", PCIAddress: "0000:82:00.0"},
+					},
+				}))
+			})
+
+			It("should not schedule the virtual machine when the allocated device has no PCI address", func() {
+				vmi := newClaimedGPUVirtualMachine()
+				vmi.Spec.Domain.Devices.GPUs = vmi.Spec.Domain.Devices.GPUs[:1]
+				pod := newClaimedGPUPod(vmi)
+
+				addVirtualMachine(vmi)
+				addPod(pod)
+				addAllocatedResourceClaim(vmi.Namespace, "gpu-0")
+				addResourceSlice(map[string]string{"gpu-1": "0000:82:00.0"})
+
+				controller.Execute()
+				expectVMIUnscheduledWithoutDeviceStatus(vmi)
+			})
+
+			It("should not schedule the virtual machine when a claim has less devices allocated than referenced", func() {
+				vmi := newClaimedGPUVirtualMachine()
+				pod := newClaimedGPUPod(vmi)
+
+				addVirtualMachine(vmi)
+				addPod(pod)
+				addAllocatedResourceClaim(vmi.Namespace, "gpu-0")
+				addResourceSlice(map[string]string{"gpu-0": "0000:81:00.0"})
+
+				controller.Execute()
+				expectVMIUnscheduledWithoutDeviceStatus(vmi)
+			})
+		})
 		It("should update the virtual machine QOS class if the pod finally has a QOS class assigned", func() {
 			vmi := NewPendingVirtualMachine("testvmi")
 			setReadyCondition(vmi, k8sv1.ConditionFalse, virtv1.GuestNotRunningReason)
@@ -2195,6 +2329,90 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			expectPodDoesNotExist(attachmentPod2.Namespace, attachmentPod2.Name)
 		})
 
+		Context("with orphaned attachment pods", func() {
+			var (
+				vmi             *virtv1.VirtualMachineInstance
+				virtlauncherPod *k8sv1.Pod
+				attachmentPod   *k8sv1.Pod
+			)
+
+			BeforeEach(func() {
+				vmi = NewPendingVirtualMachine("testvmi")
+				virtlauncherPod = NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+				virtlauncherPod.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind)}
+				attachmentPod = NewPodForVirtlauncher(virtlauncherPod, "hp-test", "abcd", k8sv1.PodRunning)
+				attachmentPod.Labels = map[string]string{virtv1.AppLabel: "hotplug-disk"}
+				attachmentPod.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * orphanedAttachmentPodsCheckInterval))
+			})
+
+			It("should delete the attachment pod if the virt-launcher pod is gone", func() {
+				addVirtualMachine(vmi)
+				addPod(attachmentPod)
+
+				controller.deleteAttachmentPodsOfGoneOwners()
+
+				testutils.ExpectEvent(recorder, kvcontroller.SuccessfulDeletePodReason)
+				expectPodDoesNotExist(attachmentPod.Namespace, attachmentPod.Name)
+			})
+
+			It("should delete the attachment pod if the virt-launcher pod is down and the VMI is gone", func() {
+				virtlauncherPod.Status.Phase = k8sv1.PodSucceeded
+				addPod(virtlauncherPod)
+				addPod(attachmentPod)
+
+				controller.deleteAttachmentPodsOfGoneOwners()
+
+				testutils.ExpectEvent(recorder, kvcontroller.SuccessfulDeletePodReason)
+				expectPodDoesNotExist(attachmentPod.Namespace, attachmentPod.Name)
+			})
+
+			It("should delete the attachment pod if the virt-launcher pod is down and the VMI is final", func() {
+				vmi.Status.Phase = virtv1.Failed
+				virtlauncherPod.Status.Phase = k8sv1.PodFailed
+				addVirtualMachine(vmi)
+				addPod(virtlauncherPod)
+				addPod(attachmentPod)
+
+				controller.deleteAttachmentPodsOfGoneOwners()
+
+				testutils.ExpectEvent(recorder, kvcontroller.SuccessfulDeletePodReason)
+				expectPodDoesNotExist(attachmentPod.Namespace, attachmentPod.Name)
+			})
+
+			DescribeTable("should keep the attachment pod", func(launcherPhase k8sv1.PodPhase) {
+				virtlauncherPod.Status.Phase = launcherPhase
+				addVirtualMachine(vmi)
+				addPod(virtlauncherPod)
+				addPod(attachmentPod)
+
+				controller.deleteAttachmentPodsOfGoneOwners()
+
+				expectPodExists(attachmentPod.Namespace, attachmentPod.Name)
+			},
+				Entry("if the virt-launcher pod is running", k8sv1.PodRunning),
+				Entry("if the virt-launcher pod is down but the VMI is not final", k8sv1.PodFailed),
+			)
+
+			It("should keep a pod owned by a gone pod which is not an attachment pod", func() {
+				delete(attachmentPod.Labels, virtv1.AppLabel)
+				addVirtualMachine(vmi)
+				addPod(attachmentPod)
+
+				controller.deleteAttachmentPodsOfGoneOwners()
+
+				expectPodExists(attachmentPod.Namespace, attachmentPod.Name)
+			})
+
+			It("should keep a just created attachment pod", func() {
+				attachmentPod.CreationTimestamp = metav1.NewTime(time.Now())
+				addPod(attachmentPod)
+
+				controller.deleteAttachmentPodsOfGoneOwners()
+
+				expectPodExists(attachmentPod.Namespace, attachmentPod.Name)
+			})
+		})
+
 		It("CreateAttachmentPodTemplate should return error if volume is not DV or PVC", func() {
 			vmi := NewPendingVirtualMachine("testvmi")
 			virtlauncherPod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// AsyncChecksumResult is the outcome of a background checksum computation.
+type AsyncChecksumResult struct {
+	Checksums *DiskChecksums
+	Err       error
+	Started   time.Time
+	Finished  time.Time
+}
+
+// AsyncChecksums computes the checksums of the containerDisks of VMIs in the background.
+// Computing the checksums reads the whole images, which for lazily pulled images means
+// fetching them completely. Doing it in the background lets the VMI boot in the meantime.
+// At most maxConcurrent computations run at the same time, the others wait for a free slot.
+type AsyncChecksums struct {
+	lock    sync.Mutex
+	results map[types.UID]*asyncChecksum
+	slots   chan struct{}
+}
+
+type asyncChecksum struct {
+	done   bool
+	result AsyncChecksumResult
+}
+
+func NewAsyncChecksums(maxConcurrent int) *AsyncChecksums {
+	return &AsyncChecksums{
+		results: map[types.UID]*asyncChecksum{},
+		slots:   make(chan struct{}, maxConcurrent),
+	}
+}
+
+// Result returns the result of the computation for the given VMI UID once it is done.
+// The first call starts compute in the background, onDone is called once it returns.
+func (a *AsyncChecksums) Result(uid types.UID, compute func() (*DiskChecksums, error), onDone func()) (*AsyncChecksumResult, bool) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if entry, exists := a.results[uid]; exists {
+		if !entry.done {
+			return nil, false
+		}
+		result := entry.result
+		return &result, true
+	}
+
+	entry := &asyncChecksum{}
+	a.results[uid] = entry
+
+	go func() {
+		a.slots <- struct{}{}
+		started := time.Now()
+		checksums, err := compute()
+		<-a.slots
+
+		a.lock.Lock()
+		entry.result.Started = started
+		entry.result.Checksums = checksums
+		entry.result.Err = err
+		entry.result.Finished = time.Now()
+		entry.done = true
+		a.lock.Unlock()
+
+		onDone()
+	}()
+
+	return nil, false
+}
+
+// Forget drops the computation of the given VMI UID, a running one is not reported anymore.
+func (a *AsyncChecksums) Forget(uid types.UID) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	delete(a.results, uid)
+}
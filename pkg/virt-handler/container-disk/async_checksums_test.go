@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("AsyncChecksums", func() {
+	const uid = types.UID("1234")
+
+	var (
+		asyncChecksums *AsyncChecksums
+		release        chan struct{}
+		done           chan struct{}
+		calls          int
+	)
+
+	BeforeEach(func() {
+		asyncChecksums = NewAsyncChecksums(1)
+		release = make(chan struct{})
+		done = make(chan struct{}, 1)
+		calls = 0
+	})
+
+	computeWith := func(checksums *DiskChecksums, err error) func() (*DiskChecksums, error) {
+		return func() (*DiskChecksums, error) {
+			calls++
+			<-release
+			return checksums, err
+		}
+	}
+	onDone := func() {
+		done <- struct{}{}
+	}
+
+	It("should return the checksums once they are computed in the background", func() {
+		checksums := &DiskChecksums{ContainerDiskChecksums: map[string]uint32{"disk": 42}}
+		compute := computeWith(checksums, nil)
+
+		_, finished := asyncChecksums.Result(uid, compute, onDone)
+		Expect(finished).To(BeFalse())
+		_, finished = asyncChecksums.Result(uid, compute, onDone)
+		Expect(finished).To(BeFalse())
+
+		close(release)
+		Eventually(done).Should(Receive())
+
+		result, finished := asyncChecksums.Result(uid, compute, onDone)
+		Expect(finished).To(BeTrue())
+		Expect(result.Err).ToNot(HaveOccurred())
+		Expect(result.Checksums).To(Equal(checksums))
+		Expect(result.Finished).ToNot(BeTemporally("<", result.Started))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("should return the error of the computation", func() {
+		_, finished := asyncChecksums.Result(uid, computeWith(nil, fmt.Errorf("gone")), onDone)
+		Expect(finished).To(BeFalse())
+
+		close(release)
+		Eventually(done).Should(Receive())
+
+		result, finished := asyncChecksums.Result(uid, computeWith(nil, nil), onDone)
+		Expect(finished).To(BeTrue())
+		Expect(result.Err).To(MatchError("gone"))
+	})
+
+	It("should start a new computation once the previous one is forgotten", func() {
+		close(release)
+		asyncChecksums.Result(uid, computeWith(nil, nil), onDone)
+		Eventually(done).Should(Receive())
+
+		asyncChecksums.Forget(uid)
+
+		_, finished := asyncChecksums.Result(uid, computeWith(nil, nil), onDone)
+		Expect(finished).To(BeFalse())
+		Eventually(done).Should(Receive())
+		Expect(calls).To(Equal(2))
+	})
+
+	It("should not run more computations than allowed at the same time", func() {
+		firstStarted := make(chan struct{})
+		secondStarted := make(chan struct{})
+		asyncChecksums.Result(uid, func() (*DiskChecksums, error) {
+			close(firstStarted)
+			<-release
+			return nil, nil
+		}, onDone)
+		Eventually(firstStarted).Should(BeClosed())
+		asyncChecksums.Result("5678", func() (*DiskChecksums, error) {
+			close(secondStarted)
+			return nil, nil
+		}, onDone)
+
+		Consistently(secondStarted, "100ms").ShouldNot(BeClosed())
+
+		close(release)
+		Eventually(secondStarted).Should(BeClosed())
+		Eventually(done).Should(Receive())
+		Eventually(done).Should(Receive())
+	})
+})
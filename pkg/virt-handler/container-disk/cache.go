@@ -0,0 +1,325 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/types"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+)
+
+const (
+	cacheBlobsDir = "blobs"
+	cacheRefsDir  = "refs"
+)
+
+// errReflinkUnsupported is returned for containerDisks which can't be cloned into the cache.
+// Copying them instead would double the disk usage, so they are used without the cache.
+var errReflinkUnsupported = errors.New("reflinks are not supported")
+
+// diskCache is a node-local, content-addressed store of containerDisk payloads.
+// Every payload is stored once under blobs/<sha256> as a reflink of the original file,
+// containerDisks on filesystems without reflink support are not cached. Each VMI using a
+// payload holds a hardlink to the blob under refs/<vmi uid>/<volume>, which is what gets
+// mounted into the launcher. A blob is not used anymore once its link count drops to one
+// and is then pruned.
+type diskCache struct {
+	dir string
+	// lock only guards the maps below, payloads are cloned and hashed under the lock of their source
+	// and blobs are linked and pruned under the lock of the blob
+	lock sync.Mutex
+	// digests of the already cached sources, the layers of an image are shared
+	// by all containers using it so the same file is only hashed once
+	digests map[sourceIdentity]string
+	// locks of the sources and blobs in use, blobs in use are not pruned
+	locks map[string]*refLock
+	clone func(target, source *os.File) error
+}
+
+type sourceIdentity struct {
+	dev   uint64
+	ino   uint64
+	size  int64
+	mtime int64
+}
+
+type refLock struct {
+	sync.Mutex
+	refs int
+}
+
+func newDiskCache(dir string) *diskCache {
+	return &diskCache{
+		dir:     dir,
+		digests: map[sourceIdentity]string{},
+		locks:   map[string]*refLock{},
+		clone:   reflink,
+	}
+}
+
+func (c *diskCache) blobPath(digest string) string {
+	return filepath.Join(c.dir, cacheBlobsDir, digest)
+}
+
+func (c *diskCache) refsPath(vmiUID types.UID) string {
+	return filepath.Join(c.dir, cacheRefsDir, string(vmiUID))
+}
+
+func sourceLockKey(identity sourceIdentity) string {
+	return fmt.Sprintf("source/%d/%d/%d/%d", identity.dev, identity.ino, identity.size, identity.mtime)
+}
+
+func blobLockKey(digest string) string {
+	return "blob/" + digest
+}
+
+// lockKey locks key and returns the function to unlock it again.
+func (c *diskCache) lockKey(key string) func() {
+	c.lock.Lock()
+	l, exists := c.locks[key]
+	if !exists {
+		l = &refLock{}
+		c.locks[key] = l
+	}
+	l.refs++
+	c.lock.Unlock()
+
+	l.Lock()
+	return c.unlockKeyFunc(key, l)
+}
+
+// tryLockKey locks key unless it is locked already. It returns the function to unlock it again.
+func (c *diskCache) tryLockKey(key string) (func(), bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if _, exists := c.locks[key]; exists {
+		return nil, false
+	}
+	l := &refLock{refs: 1}
+	c.locks[key] = l
+	// nobody else knows the new lock yet
+	l.Lock()
+	return c.unlockKeyFunc(key, l), true
+}
+
+func (c *diskCache) unlockKeyFunc(key string, l *refLock) func() {
+	return func() {
+		l.Unlock()
+		c.lock.Lock()
+		l.refs--
+		if l.refs == 0 {
+			delete(c.locks, key)
+		}
+		c.lock.Unlock()
+	}
+}
+
+// add stores the payload of source in the cache if it is not present yet and references it for the volume of the VMI.
+// It returns the path of the reference, to be mounted instead of source, or errReflinkUnsupported.
+func (c *diskCache) add(vmiUID types.UID, volumeName string, source *safepath.Path) (string, error) {
+	refsDir := c.refsPath(vmiUID)
+	refPath := filepath.Join(refsDir, volumeName)
+	if _, err := os.Stat(refPath); err == nil {
+		return refPath, nil
+	}
+
+	var digest string
+	var unlockBlob func()
+	err := source.ExecuteNoFollow(func(sourcePath string) error {
+		var err error
+		digest, unlockBlob, err = c.store(sourcePath)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+	defer unlockBlob()
+
+	if err := os.MkdirAll(refsDir, 0750); err != nil {
+		return "", err
+	}
+	if err := os.Link(c.blobPath(digest), refPath); err != nil && !errors.Is(err, os.ErrExist) {
+		return "", fmt.Errorf("failed to reference cached containerDisk %s: %v", digest, err)
+	}
+	return refPath, nil
+}
+
+// store clones the file at sourcePath into the cache unless a blob with the same content exists and returns its digest.
+// The blob stays locked against pruning until the returned function is called.
+func (c *diskCache) store(sourcePath string) (string, func(), error) {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return "", nil, err
+	}
+	defer source.Close()
+
+	info, err := source.Stat()
+	if err != nil {
+		return "", nil, err
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return "", nil, fmt.Errorf("failed to stat %s", sourcePath)
+	}
+	identity := sourceIdentity{dev: stat.Dev, ino: stat.Ino, size: info.Size(), mtime: info.ModTime().UnixNano()}
+
+	unlockSource := c.lockKey(sourceLockKey(identity))
+	defer unlockSource()
+
+	c.lock.Lock()
+	digest, known := c.digests[identity]
+	c.lock.Unlock()
+	if known {
+		unlockBlob := c.lockKey(blobLockKey(digest))
+		if _, err := os.Stat(c.blobPath(digest)); err == nil {
+			return digest, unlockBlob, nil
+		}
+		unlockBlob()
+	}
+
+	blobsDir := filepath.Join(c.dir, cacheBlobsDir)
+	if err := os.MkdirAll(blobsDir, 0750); err != nil {
+		return "", nil, err
+	}
+	tmp, err := os.CreateTemp(blobsDir, ".tmp")
+	if err != nil {
+		return "", nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	// clone first, the payload is only hashed if it can be cached
+	if err := c.clone(tmp, source); err != nil {
+		log.Log.Reason(err).Infof("Not caching containerDisk %s", sourcePath)
+		return "", nil, errReflinkUnsupported
+	}
+	hash := sha256.New()
+	if _, err := io.Copy(hash, io.NewSectionReader(tmp, 0, info.Size())); err != nil {
+		return "", nil, err
+	}
+	digest = hex.EncodeToString(hash.Sum(nil))
+
+	unlockBlob := c.lockKey(blobLockKey(digest))
+	blob := c.blobPath(digest)
+	if _, err := os.Stat(blob); err != nil {
+		// qemu has to be able to read the blob just like the original file
+		if err := tmp.Chmod(info.Mode().Perm()); err != nil {
+			unlockBlob()
+			return "", nil, err
+		}
+		if err := tmp.Chown(int(stat.Uid), int(stat.Gid)); err != nil {
+			unlockBlob()
+			return "", nil, err
+		}
+		if err := os.Rename(tmp.Name(), blob); err != nil {
+			unlockBlob()
+			return "", nil, err
+		}
+		log.Log.Infof("Cached containerDisk %s as %s", sourcePath, digest)
+	}
+
+	c.lock.Lock()
+	c.digests[identity] = digest
+	c.lock.Unlock()
+	return digest, unlockBlob, nil
+}
+
+// reflink clones source into target, it fails if the filesystem doesn't support reflinks.
+func reflink(target, source *os.File) error {
+	return unix.IoctlFileClone(int(target.Fd()), int(source.Fd()))
+}
+
+// release drops the references of the VMI and prunes the blobs which are not referenced anymore.
+func (c *diskCache) release(vmiUID types.UID) error {
+	if err := os.RemoveAll(c.refsPath(vmiUID)); err != nil {
+		return err
+	}
+	return c.prune()
+}
+
+// prune removes the blobs which are not referenced anymore. The global lock is only held to pick the blobs
+// which are not in use, each of them is removed under its own lock.
+func (c *diskCache) prune() error {
+	entries, err := os.ReadDir(filepath.Join(c.dir, cacheBlobsDir))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		// skip blobs which are being cloned
+		if strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if err := c.pruneBlob(entry.Name()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneBlob removes the blob with the given digest if no VMI references it.
+func (c *diskCache) pruneBlob(digest string) error {
+	// skip blobs which are in use, they are pruned on the next release
+	unlock, locked := c.tryLockKey(blobLockKey(digest))
+	if !locked {
+		return nil
+	}
+	defer unlock()
+
+	info, err := os.Lstat(c.blobPath(digest))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok || stat.Nlink > 1 {
+		return nil
+	}
+	if err := os.Remove(c.blobPath(digest)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	c.lock.Lock()
+	for identity, d := range c.digests {
+		if d == digest {
+			delete(c.digests, identity)
+		}
+	}
+	c.lock.Unlock()
+	log.Log.Infof("Pruned unused cached containerDisk %s", digest)
+	return nil
+}
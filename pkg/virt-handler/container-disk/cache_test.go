@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package container_disk
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	"kubevirt.io/kubevirt/pkg/safepath"
+)
+
+var _ = Describe("containerDisk cache", func() {
+	var (
+		tmpDir string
+		cache  *diskCache
+	)
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+		cache = newDiskCache(filepath.Join(tmpDir, "cache"))
+		// the test filesystem may not support reflinks
+		cache.clone = func(target, source *os.File) error {
+			_, err := io.Copy(target, source)
+			return err
+		}
+	})
+
+	newSource := func(name, content string) *safepath.Path {
+		Expect(os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0440)).To(Succeed())
+		source, err := safepath.JoinAndResolveWithRelativeRoot("/", tmpDir, name)
+		Expect(err).ToNot(HaveOccurred())
+		return source
+	}
+
+	blobs := func() []os.DirEntry {
+		entries, err := os.ReadDir(filepath.Join(tmpDir, "cache", cacheBlobsDir))
+		if os.IsNotExist(err) {
+			return nil
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return entries
+	}
+
+	It("should share one blob between identical containerDisks", func() {
+		first, err := cache.add("vmi1", "disk", newSource("first.img", "golden"))
+		Expect(err).ToNot(HaveOccurred())
+		second, err := cache.add("vmi2", "disk", newSource("second.img", "golden"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(blobs()).To(HaveLen(1))
+		Expect(os.SameFile(stat(first), stat(second))).To(BeTrue())
+		content, err := os.ReadFile(first)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("golden"))
+		Expect(stat(first).Mode().Perm()).To(Equal(os.FileMode(0440)))
+	})
+
+	It("should store containerDisks with different content separately", func() {
+		_, err := cache.add("vmi1", "disk", newSource("first.img", "golden"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cache.add("vmi2", "disk", newSource("second.img", "silver"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(blobs()).To(HaveLen(2))
+	})
+
+	It("should prune a blob once the last VMI using it is released", func() {
+		_, err := cache.add("vmi1", "disk", newSource("first.img", "golden"))
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cache.add("vmi2", "disk", newSource("second.img", "golden"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(cache.release("vmi1")).To(Succeed())
+		Expect(blobs()).To(HaveLen(1))
+
+		Expect(cache.release("vmi2")).To(Succeed())
+		Expect(blobs()).To(BeEmpty())
+		Expect(cache.digests).To(BeEmpty())
+	})
+
+	It("should not cache containerDisks which can't be cloned", func() {
+		cache.clone = func(_, _ *os.File) error {
+			return syscall.EOPNOTSUPP
+		}
+
+		_, err := cache.add("vmi1", "disk", newSource("first.img", "golden"))
+		Expect(err).To(MatchError(errReflinkUnsupported))
+		Expect(blobs()).To(BeEmpty())
+		Expect(cache.digests).To(BeEmpty())
+	})
+
+	It("should not hash a containerDisk again", func() {
+		source := newSource("first.img", "golden")
+		_, err := cache.add("vmi1", "disk", source)
+		Expect(err).ToNot(HaveOccurred())
+
+		cache.clone = func(_, _ *os.File) error {
+			Fail("the containerDisk should not be cloned again")
+			return nil
+		}
+		_, err = cache.add("vmi2", "disk", source)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blobs()).To(HaveLen(1))
+	})
+
+	It("should add containerDisks concurrently", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			source := newSource(fmt.Sprintf("disk%d.img", i), "golden")
+			wg.Add(1)
+			go func(vmiUID types.UID) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				_, err := cache.add(vmiUID, "disk", source)
+				Expect(err).ToNot(HaveOccurred())
+			}(types.UID(fmt.Sprintf("vmi%d", i)))
+		}
+		wg.Wait()
+
+		Expect(blobs()).To(HaveLen(1))
+		Expect(cache.locks).To(BeEmpty())
+	})
+
+	It("should not prune a blob which is in use", func() {
+		_, err := cache.add("vmi1", "disk", newSource("first.img", "golden"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blobs()).To(HaveLen(1))
+		digest := blobs()[0].Name()
+
+		unlock := cache.lockKey(blobLockKey(digest))
+		Expect(cache.release("vmi1")).To(Succeed())
+		Expect(blobs()).To(HaveLen(1))
+
+		unlock()
+		Expect(cache.prune()).To(Succeed())
+		Expect(blobs()).To(BeEmpty())
+		Expect(cache.locks).To(BeEmpty())
+	})
+
+	It("should not fail to release a VMI without cached containerDisks", func() {
+		Expect(cache.release("vmi1")).To(Succeed())
+	})
+})
+
+func stat(path string) os.FileInfo {
+	info, err := os.Stat(path)
+	ExpectWithOffset(1, err).ToNot(HaveOccurred())
+	return info
+}
@@ -52,8 +52,10 @@ type mounter struct {
 	suppressWarningTimeout     time.Duration
 	socketPathGetter           containerdisk.SocketPathGetter
 	kernelBootSocketPathGetter containerdisk.KernelBootSocketPathGetter
+	firmwareSocketPathGetter   containerdisk.FirmwareSocketPathGetter
 	clusterConfig              *virtconfig.ClusterConfig
 	nodeIsolationResult        isolation.IsolationResult
+	cache                      *diskCache
 }
 
 type Mounter interface {
@@ -81,6 +83,7 @@ type kernelArtifacts struct {
 type DiskChecksums struct {
 	KernelBootChecksum     KernelBootChecksum
 	ContainerDiskChecksums map[string]uint32
+	FirmwareChecksum       *uint32
 }
 
 type KernelBootChecksum struct {
@@ -88,7 +91,7 @@ type KernelBootChecksum struct {
 	Kernel *uint32
 }
 
-func NewMounter(isoDetector isolation.PodIsolationDetector, mountStateDir string, clusterConfig *virtconfig.ClusterConfig) Mounter {
+func NewMounter(isoDetector isolation.PodIsolationDetector, mountStateDir string, cacheDir string, clusterConfig *virtconfig.ClusterConfig) Mounter {
 	return &mounter{
 		mountRecords:               make(map[types.UID]*vmiMountTargetRecord),
 		podIsolationDetector:       isoDetector,
@@ -96,8 +99,10 @@ func NewMounter(isoDetector isolation.PodIsolationDetector, mountStateDir string
 		suppressWarningTimeout:     1 * time.Minute,
 		socketPathGetter:           containerdisk.NewSocketPathGetter(""),
 		kernelBootSocketPathGetter: containerdisk.NewKernelBootSocketPathGetter(""),
+		firmwareSocketPathGetter:   containerdisk.NewFirmwareSocketPathGetter(""),
 		clusterConfig:              clusterConfig,
 		nodeIsolationResult:        isolation.NodeIsolationResult(),
+		cache:                      newDiskCache(cacheDir),
 	}
 }
 
@@ -315,6 +320,13 @@ func (m *mounter) MountAndVerify(vmi *v1.VirtualMachineInstance) (map[string]*co
 				if err != nil {
 					return nil, fmt.Errorf("failed to find a sourceFile in containerDisk %v: %v", volume.Name, err)
 				}
+				// hashing the payload would read lazily pulled images completely
+				if m.clusterConfig.ContainerDiskCacheEnabled() && !m.clusterConfig.ContainerDiskLazyPullingEnabled() {
+					sourceFile, err = m.getCachedContainerDiskPath(vmi, volume.Name, sourceFile)
+					if err != nil {
+						return nil, fmt.Errorf("failed to cache containerDisk %v: %v", volume.Name, err)
+					}
+				}
 
 				log.DefaultLogger().Object(vmi).Infof("Bind mounting container disk at %s to %s", sourceFile, targetFile)
 				out, err := virt_chroot.MountChroot(sourceFile, targetFile, true).CombinedOutput()
@@ -337,6 +349,10 @@ func (m *mounter) MountAndVerify(vmi *v1.VirtualMachineInstance) (map[string]*co
 	if err != nil {
 		return nil, fmt.Errorf("error mounting kernel artifacts: %v", err)
 	}
+	err = m.mountFirmware(vmi, true)
+	if err != nil {
+		return nil, fmt.Errorf("error mounting firmware image: %v", err)
+	}
 
 	return disksInfo, nil
 }
@@ -352,6 +368,11 @@ func (m *mounter) Unmount(vmi *v1.VirtualMachineInstance) error {
 		return fmt.Errorf("error unmounting kernel artifacts: %v", err)
 	}
 
+	err = m.unmountFirmware(vmi)
+	if err != nil {
+		return fmt.Errorf("error unmounting firmware image: %v", err)
+	}
+
 	record, err := m.getMountTargetRecord(vmi)
 	if err != nil {
 		return err
@@ -389,7 +410,25 @@ func (m *mounter) Unmount(vmi *v1.VirtualMachineInstance) error {
 		return err
 	}
 
-	return nil
+	return m.cache.release(vmi.UID)
+}
+
+// getCachedContainerDiskPath returns the path of the cached copy of the containerDisk, shared with all
+// VMIs on the node using a containerDisk with the same content. The containerDisk itself is returned
+// if it can't be cached.
+func (m *mounter) getCachedContainerDiskPath(vmi *v1.VirtualMachineInstance, volumeName string, sourceFile *safepath.Path) (*safepath.Path, error) {
+	refPath, err := m.cache.add(vmi.UID, volumeName, sourceFile)
+	if errors.Is(err, errReflinkUnsupported) {
+		return sourceFile, nil
+	} else if err != nil {
+		return nil, err
+	}
+	// the cache lives on the node at the same path as in virt-handler
+	nodeRoot, err := m.nodeIsolationResult.MountRoot()
+	if err != nil {
+		return nil, err
+	}
+	return nodeRoot.AppendAndResolveWithRelativeRoot(refPath)
 }
 
 func (m *mounter) ContainerDisksReady(vmi *v1.VirtualMachineInstance, notInitializedSince time.Time) (bool, error) {
@@ -417,6 +456,17 @@ func (m *mounter) ContainerDisksReady(vmi *v1.VirtualMachineInstance, notInitial
 		}
 	}
 
+	if util.HasFirmwareImage(vmi) {
+		_, err := m.firmwareSocketPathGetter(vmi)
+		if err != nil {
+			log.DefaultLogger().Object(vmi).Reason(err).Info("firmware container not yet ready")
+			if time.Now().After(notInitializedSince.Add(m.suppressWarningTimeout)) {
+				return false, fmt.Errorf("firmware container still not ready after one minute")
+			}
+			return false, nil
+		}
+	}
+
 	log.DefaultLogger().Object(vmi).V(4).Info("all containerdisks are ready")
 	return true, nil
 }
@@ -622,6 +672,118 @@ func (m *mounter) unmountKernelArtifacts(vmi *v1.VirtualMachineInstance) error {
 	return fmt.Errorf("kernel artifacts record wasn't found")
 }
 
+// mountFirmware bind mounts the firmware directory of the pinned firmware image into the
+// container-disks directory of virt-launcher. This function is assumed to run after MountAndVerify.
+func (m *mounter) mountFirmware(vmi *v1.VirtualMachineInstance, verify bool) error {
+	if !util.HasFirmwareImage(vmi) {
+		return nil
+	}
+
+	log.Log.Object(vmi).Infof("mounting firmware image %s", vmi.Status.FirmwareImageStatus.Name)
+
+	targetDir, err := containerdisk.GetDiskTargetDirFromHostView(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to get disk target dir: %v", err)
+	}
+	if err := safepath.MkdirAtNoFollow(targetDir, containerdisk.FirmwareName, 0755); err != nil {
+		if !os.IsExist(err) {
+			return err
+		}
+	}
+
+	targetDir, err = safepath.JoinNoFollow(targetDir, containerdisk.FirmwareName)
+	if err != nil {
+		return err
+	}
+
+	socketFilePath, err := m.firmwareSocketPathGetter(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to find socket path for firmware image: %v", err)
+	}
+
+	record := vmiMountTargetRecord{
+		MountTargetEntries: []vmiMountTargetEntry{{
+			TargetFile: unsafepath.UnsafeAbsolute(targetDir.Raw()),
+			SocketFile: socketFilePath,
+		}},
+	}
+
+	err = m.addMountTargetRecord(vmi, &record)
+	if err != nil {
+		return err
+	}
+
+	if isMounted, err := isolation.IsMounted(targetDir); err != nil {
+		return fmt.Errorf("failed to determine if %s is already mounted: %v", targetDir, err)
+	} else if !isMounted {
+		sourceDir, err := m.getFirmwarePath(vmi)
+		if err != nil {
+			return err
+		}
+
+		log.Log.Object(vmi).Infof("Bind mounting firmware image at %s to %s", sourceDir, targetDir)
+		out, err := virt_chroot.MountChroot(sourceDir, targetDir, true).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("failed to bindmount %v: %v : %v", containerdisk.FirmwareName, string(out), err)
+		}
+	}
+
+	if verify {
+		if mounted, err := isolation.IsMounted(targetDir); err != nil {
+			return fmt.Errorf("failed to check if firmware image is mounted. error: %v", err)
+		} else if !mounted {
+			return fmt.Errorf("firmware image verification failed")
+		}
+	}
+
+	return nil
+}
+
+func (m *mounter) unmountFirmware(vmi *v1.VirtualMachineInstance) error {
+	if !util.HasFirmwareImage(vmi) {
+		return nil
+	}
+
+	log.DefaultLogger().Object(vmi).Infof("unmounting firmware image")
+
+	record, err := m.getMountTargetRecord(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to get mount target record: %v", err)
+	} else if record == nil {
+		log.DefaultLogger().Object(vmi).Warning("Cannot find firmware entries to unmount")
+		return nil
+	}
+
+	for idx, entry := range record.MountTargetEntries {
+		if filepath.Base(entry.TargetFile) != containerdisk.FirmwareName {
+			continue
+		}
+		targetDir, err := safepath.NewFileNoFollow(entry.TargetFile)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return fmt.Errorf(failedCheckMountPointFmt, entry.TargetFile, err)
+		}
+		_ = targetDir.Close()
+
+		if mounted, err := isolation.IsMounted(targetDir.Path()); err != nil {
+			return fmt.Errorf(failedCheckMountPointFmt, targetDir, err)
+		} else if mounted {
+			log.DefaultLogger().Object(vmi).Infof("unmounting firmware image at path %s", targetDir)
+			out, err := virt_chroot.UmountChroot(targetDir.Path()).CombinedOutput()
+			if err != nil {
+				return fmt.Errorf(failedUnmountFmt, targetDir, string(out), err)
+			}
+		}
+
+		record.MountTargetEntries = append(record.MountTargetEntries[:idx], record.MountTargetEntries[idx+1:]...)
+		return nil
+	}
+
+	return nil
+}
+
 func (m *mounter) getContainerDiskPath(vmi *v1.VirtualMachineInstance, volume *v1.Volume, volumeIndex int) (*safepath.Path, error) {
 	sock, err := m.socketPathGetter(vmi, volumeIndex)
 	if err != nil {
@@ -678,6 +840,62 @@ func (m *mounter) getKernelArtifactPaths(vmi *v1.VirtualMachineInstance) (*kerne
 	return kernelArtifacts, nil
 }
 
+func (m *mounter) getFirmwarePath(vmi *v1.VirtualMachineInstance) (*safepath.Path, error) {
+	sock, err := m.firmwareSocketPathGetter(vmi)
+	if err != nil {
+		return nil, ErrDiskContainerGone
+	}
+
+	res, err := m.podIsolationDetector.DetectForSocket(vmi, sock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect socket for firmware container: %v", err)
+	}
+
+	mountPoint, err := isolation.ParentPathForRootMount(m.nodeIsolationResult, res)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect root mount point of firmware container on the node: %v", err)
+	}
+
+	return containerdisk.GetImage(mountPoint, vmi.Status.FirmwareImageStatus.Path)
+}
+
+// getDirectoryDigest computes a checksum over the names and the content of all regular files of a directory.
+// Symlinks and subdirectories are ignored.
+func getDirectoryDigest(dir *safepath.Path) (uint32, error) {
+	digest := crc32.NewIEEE()
+
+	err := dir.ExecuteNoFollow(func(path string) error {
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return err
+		}
+
+		// 32 MiB chunks
+		chunk := make([]byte, 1024*1024*32)
+
+		for _, entry := range entries {
+			if !entry.Type().IsRegular() {
+				continue
+			}
+			if _, err := io.WriteString(digest, entry.Name()); err != nil {
+				return err
+			}
+			f, err := os.Open(filepath.Join(path, entry.Name()))
+			if err != nil {
+				return err
+			}
+			_, err = io.CopyBuffer(digest, f, chunk)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	return digest.Sum32(), err
+}
+
 func getDigest(imageFile *safepath.Path) (uint32, error) {
 	digest := crc32.NewIEEE()
 
@@ -749,6 +967,21 @@ func (m *mounter) ComputeChecksums(vmi *v1.VirtualMachineInstance) (*DiskChecksu
 		}
 	}
 
+	// firmware
+	if util.HasFirmwareImage(vmi) {
+		firmwarePath, err := m.getFirmwarePath(vmi)
+		if err != nil {
+			return nil, err
+		}
+
+		checksum, err := getDirectoryDigest(firmwarePath)
+		if err != nil {
+			return nil, err
+		}
+
+		diskChecksums.FirmwareChecksum = &checksum
+	}
+
 	return diskChecksums, nil
 }
 
@@ -813,5 +1046,14 @@ func VerifyChecksums(mounter Mounter, vmi *v1.VirtualMachineInstance) error {
 		}
 	}
 
+	// verify firmware
+	if util.HasFirmwareImage(vmi) && diskChecksums.FirmwareChecksum != nil {
+		expectedChecksum := vmi.Status.FirmwareImageStatus.Checksum
+		computedChecksum := *diskChecksums.FirmwareChecksum
+		if err := compareChecksums(expectedChecksum, computedChecksum); err != nil {
+			return fmt.Errorf("checksum error for firmware image %s: %w", vmi.Status.FirmwareImageStatus.Name, err)
+		}
+	}
+
 	return nil
 }
@@ -64,6 +64,7 @@ var _ = Describe("ContainerDisk", func() {
 			mountStateDir:          tmpDir,
 			suppressWarningTimeout: 1 * time.Minute,
 			socketPathGetter:       containerdisk.NewSocketPathGetter(""),
+			cache:                  newDiskCache(filepath.Join(tmpDir, "cache")),
 		}
 	})
 
@@ -424,5 +425,41 @@ var _ = Describe("ContainerDisk", func() {
 				}),
 			)
 		})
+
+		Context("with a pinned firmware image", func() {
+			codeContent := []byte{0xA, 0xB, 0xC, 0xD}
+			varsContent := []byte{0x1, 0x2, 0x3, 0x4}
+			firmwareChecksum := crc32.ChecksumIEEE(append(append([]byte("OVMF_CODE.fd"), codeContent...), append([]byte("OVMF_VARS.fd"), varsContent...)...))
+
+			BeforeEach(func() {
+				m.firmwareSocketPathGetter = func(vmi *v1.VirtualMachineInstance) (string, error) {
+					return "somewhere-firmware", nil
+				}
+
+				firmwareDir := filepath.Join(rootMountPoint, "firmware")
+				Expect(os.Mkdir(firmwareDir, 0755)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(firmwareDir, "OVMF_CODE.fd"), codeContent, 0644)).To(Succeed())
+				Expect(os.WriteFile(filepath.Join(firmwareDir, "OVMF_VARS.fd"), varsContent, 0644)).To(Succeed())
+				// symlinks must not be followed
+				Expect(os.Symlink("/etc/hostname", filepath.Join(firmwareDir, "bios.bin"))).To(Succeed())
+			})
+
+			DescribeTable("verification should", func(storedChecksum uint32, verifyMatcher gomega_types.GomegaMatcher) {
+				firmwareVMI := api.NewMinimalVMI("fake-vmi")
+				firmwareVMI.Status.FirmwareImageStatus = &v1.FirmwareImageStatus{
+					Name:     "ovmf",
+					Image:    "registry:5000/ovmf:stable",
+					Path:     "/firmware",
+					Checksum: storedChecksum,
+				}
+
+				err = VerifyChecksums(m, firmwareVMI)
+				Expect(err).To(verifyMatcher)
+			},
+				Entry("succeed when source and target firmware match", firmwareChecksum, Not(HaveOccurred())),
+				Entry("fail when checksum is missing", uint32(0), And(HaveOccurred(), MatchError(ErrChecksumMissing))),
+				Entry("fail when source and target firmware do not match", crc32.ChecksumIEEE(codeContent), And(HaveOccurred(), MatchError(ErrChecksumMismatch))),
+			)
+		})
 	})
 })
@@ -198,26 +198,37 @@ func FindInterfaceIndexPath(res isolation.IsolationResult, podIfaceName string,
 	return nil, fmt.Errorf(strings.Join(errs, ", "))
 }
 
+// prepareVFIO hands the VFIO device nodes of the launcher pod over to the qemu user. The device plugins of SR-IOV
+// VFs, PCI host devices and mediated devices create them owned by root, which the unprivileged virt-launcher can't
+// change, so this has to happen in virt-handler before libvirt opens them.
 func (*VirtualMachineController) prepareVFIO(vmi *v1.VirtualMachineInstance, res isolation.IsolationResult) error {
 	vfioBasePath, err := isolation.SafeJoin(res, "dev", "vfio")
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil
 		}
+		return err
 	}
 	vfioPath, err := safepath.JoinNoFollow(vfioBasePath, "vfio")
 	if err != nil {
 		if errors.Is(err, os.ErrNotExist) {
 			return nil
 		}
+		return err
 	}
 	err = safepath.ChmodAtNoFollow(vfioPath, 0666)
 	if err != nil {
 		return err
 	}
 
+	return claimVFIODevices(vfioBasePath)
+}
+
+// claimVFIODevices changes the ownership of the VFIO group device nodes in dir and of the per device nodes
+// of the iommufd interface in its devices subdirectory.
+func claimVFIODevices(dir *safepath.Path) error {
 	var files []os.DirEntry
-	err = vfioBasePath.ExecuteNoFollow(func(safePath string) (err error) {
+	err := dir.ExecuteNoFollow(func(safePath string) (err error) {
 		files, err = os.ReadDir(safePath)
 		return err
 	})
@@ -225,16 +236,25 @@ func (*VirtualMachineController) prepareVFIO(vmi *v1.VirtualMachineInstance, res
 		return err
 	}
 
-	for _, group := range files {
-		if group.Name() == "vfio" {
+	for _, file := range files {
+		if file.Name() == "vfio" {
 			continue
 		}
-		groupPath, err := safepath.JoinNoFollow(vfioBasePath, group.Name())
+		filePath, err := safepath.JoinNoFollow(dir, file.Name())
 		if err != nil {
 			return err
 		}
-		if err := diskutils.DefaultOwnershipManager.SetFileOwnership(groupPath); err != nil {
-			return err
+		if file.IsDir() {
+			if file.Name() != "devices" {
+				continue
+			}
+			if err := claimVFIODevices(filePath); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := diskutils.DefaultOwnershipManager.SetFileOwnership(filePath); err != nil {
+			return fmt.Errorf("failed to set the owner of VFIO device %s: %v", file.Name(), err)
 		}
 	}
 	return nil
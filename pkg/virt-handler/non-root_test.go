@@ -22,6 +22,8 @@ package virthandler
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -29,6 +31,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/unsafepath"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
@@ -136,3 +139,65 @@ func podNetwork() v1.Network {
 		},
 	}
 }
+
+var _ = Describe("prepareVFIO", func() {
+	var mockIsolationResult *isolation.MockIsolationResult
+	var testsRootDir string
+	var claimedDevices []string
+
+	BeforeEach(func() {
+		var err error
+		testsRootDir, err = os.MkdirTemp("", "vfio-tests-")
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() { Expect(os.RemoveAll(testsRootDir)).To(Succeed()) })
+
+		ctrl := gomock.NewController(GinkgoT())
+		mockIsolationResult = isolation.NewMockIsolationResult(ctrl)
+		mockIsolationResult.EXPECT().Pid().Return(1).AnyTimes()
+		testsRootDirPath, err := safepath.JoinAndResolveWithRelativeRoot(testsRootDir)
+		Expect(err).ToNot(HaveOccurred())
+		mockIsolationResult.EXPECT().MountRoot().Return(testsRootDirPath, nil).AnyTimes()
+
+		claimedDevices = nil
+		ownershipManager := diskutils.NewMockOwnershipManagerInterface(ctrl)
+		ownershipManager.EXPECT().SetFileOwnership(gomock.Any()).DoAndReturn(func(path *safepath.Path) error {
+			claimedDevices = append(claimedDevices, strings.TrimPrefix(unsafepath.UnsafeAbsolute(path.Raw()), testsRootDir))
+			return nil
+		}).AnyTimes()
+		origOwnershipManager := diskutils.DefaultOwnershipManager
+		diskutils.DefaultOwnershipManager = ownershipManager
+		DeferCleanup(func() { diskutils.DefaultOwnershipManager = origOwnershipManager })
+	})
+
+	createDevice := func(path string) {
+		Expect(os.MkdirAll(filepath.Join(testsRootDir, filepath.Dir(path)), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(testsRootDir, path), nil, 0600)).To(Succeed())
+	}
+
+	It("should hand the VFIO container and group devices over to the qemu user", func() {
+		createDevice("/dev/vfio/vfio")
+		createDevice("/dev/vfio/12")
+		createDevice("/dev/vfio/47")
+
+		Expect((&VirtualMachineController{}).prepareVFIO(&v1.VirtualMachineInstance{}, mockIsolationResult)).To(Succeed())
+
+		info, err := os.Stat(filepath.Join(testsRootDir, "/dev/vfio/vfio"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0666)))
+		Expect(claimedDevices).To(ConsistOf("/dev/vfio/12", "/dev/vfio/47"))
+	})
+
+	It("should hand the iommufd devices over to the qemu user", func() {
+		createDevice("/dev/vfio/vfio")
+		createDevice("/dev/vfio/12")
+		createDevice("/dev/vfio/devices/vfio0")
+
+		Expect((&VirtualMachineController{}).prepareVFIO(&v1.VirtualMachineInstance{}, mockIsolationResult)).To(Succeed())
+		Expect(claimedDevices).To(ConsistOf("/dev/vfio/12", "/dev/vfio/devices/vfio0"))
+	})
+
+	It("should do nothing without VFIO devices", func() {
+		Expect((&VirtualMachineController{}).prepareVFIO(&v1.VirtualMachineInstance{}, mockIsolationResult)).To(Succeed())
+		Expect(claimedDevices).To(BeEmpty())
+	})
+})
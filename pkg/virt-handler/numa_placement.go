@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	nodelabellerapi "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
+)
+
+// getDeviceNumaNode looks up the host NUMA node of a PCI device, it is replaced in tests
+var getDeviceNumaNode = hardware.GetDeviceNumaNodeAffinity
+
+type gpuNUMAMisalignment struct {
+	reason  string
+	message string
+}
+
+// checkGPUNUMAPlacement verifies that every passthrough GPU of the domain is on a host NUMA node the vCPUs are
+// pinned to and, if the guest NUMA topology mirrors the host one, on a host NUMA node backing a guest NUMA node.
+// virt-launcher attaches the GPUs to the guest NUMA node backed by their host NUMA node, but the CPUs are handed
+// out by the kubelet, so a GPU on a host NUMA node the vCPUs are not pinned to can't be fixed and is only reported.
+func checkGPUNUMAPlacement(domain *api.Domain, capabilities *nodelabellerapi.Capabilities) *gpuNUMAMisalignment {
+	if domain == nil || domain.Spec.CPUTune == nil || capabilities == nil {
+		return nil
+	}
+
+	nodeOfCPU := map[int]uint32{}
+	for _, cell := range capabilities.Host.Topology.Cells.Cell {
+		for _, cpu := range cell.Cpus.CPU {
+			nodeOfCPU[int(cpu.ID)] = cell.ID
+		}
+	}
+
+	vcpuNodes := map[uint32]struct{}{}
+	for _, pin := range domain.Spec.CPUTune.VCPUPin {
+		cpus, err := hardware.ParseCPUSetLine(pin.CPUSet, 50000)
+		if err != nil {
+			log.Log.Reason(err).Warningf("failed to parse the cpuset of vCPU %d", pin.VCPU)
+			return nil
+		}
+		for _, cpu := range cpus {
+			if node, exists := nodeOfCPU[cpu]; exists {
+				vcpuNodes[node] = struct{}{}
+			}
+		}
+	}
+	if len(vcpuNodes) == 0 {
+		return nil
+	}
+
+	var guestCellOfNode map[uint32]uint32
+	if domain.Spec.NUMATune != nil && len(domain.Spec.NUMATune.MemNodes) > 0 {
+		guestCellOfNode = map[uint32]uint32{}
+		for _, memNode := range domain.Spec.NUMATune.MemNodes {
+			nodes, err := hardware.ParseCPUSetLine(memNode.NodeSet, 50000)
+			if err != nil {
+				log.Log.Reason(err).Warningf("failed to parse the nodeset of guest NUMA node %d", memNode.CellID)
+				return nil
+			}
+			for _, node := range nodes {
+				guestCellOfNode[uint32(node)] = memNode.CellID
+			}
+		}
+	}
+
+	var misalignment *gpuNUMAMisalignment
+	for _, hostDevice := range domain.Spec.Devices.HostDevices {
+		if hostDevice.Alias == nil || !strings.HasPrefix(hostDevice.Alias.GetName(), gpu.AliasPrefix) || hostDevice.Source.Address == nil {
+			continue
+		}
+		gpuName := strings.TrimPrefix(hostDevice.Alias.GetName(), gpu.AliasPrefix)
+		pciAddress, err := device.FormatPciAddress(hostDevice.Source.Address)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("failed to format the PCI address of GPU %s", gpuName)
+			continue
+		}
+		node, err := getDeviceNumaNode(pciAddress)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("failed to look up the NUMA node of GPU %s at %s", gpuName, pciAddress)
+			continue
+		}
+		if node == nil {
+			// the GPU has no NUMA affinity, so it can't be misaligned
+			continue
+		}
+
+		if _, aligned := vcpuNodes[*node]; !aligned {
+			return &gpuNUMAMisalignment{
+				reason: v1.VirtualMachineInstanceReasonGPUNUMAMisaligned,
+				message: fmt.Sprintf("GPU %s is on host NUMA node %d, the dedicated CPUs are on host NUMA nodes %s",
+					gpuName, *node, formatNUMANodes(vcpuNodes)),
+			}
+		}
+		if _, aligned := guestCellOfNode[*node]; guestCellOfNode != nil && !aligned && misalignment == nil {
+			misalignment = &gpuNUMAMisalignment{
+				reason:  v1.VirtualMachineInstanceReasonGPUGuestNUMAMisaligned,
+				message: fmt.Sprintf("GPU %s is on host NUMA node %d, which backs no guest NUMA node", gpuName, *node),
+			}
+		}
+	}
+	return misalignment
+}
+
+func formatNUMANodes(nodes map[uint32]struct{}) string {
+	var ids []int
+	for node := range nodes {
+		ids = append(ids, int(node))
+	}
+	sort.Ints(ids)
+	formatted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		formatted = append(formatted, strconv.Itoa(id))
+	}
+	return strings.Join(formatted, ",")
+}
+
+// updateGPUNUMAAlignmentCondition reports when the passthrough GPUs of a VMI with dedicated CPUs are not NUMA
+// aligned with its vCPUs, since that silently costs a good share of the GPU throughput.
+func (d *VirtualMachineController) updateGPUNUMAAlignmentCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	var misalignment *gpuNUMAMisalignment
+	if vmi.IsCPUDedicated() && len(vmi.Spec.Domain.Devices.GPUs) > 0 {
+		misalignment = checkGPUNUMAPlacement(domain, d.capabilities)
+	}
+
+	if misalignment == nil {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGPUNUMAAligned)
+		return
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGPUNUMAAligned)
+	if condition != nil && condition.Reason == misalignment.reason && condition.Message == misalignment.message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGPUNUMAAligned)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGPUNUMAAligned,
+		Status:             k8sv1.ConditionFalse,
+		Reason:             misalignment.reason,
+		Message:            misalignment.message,
+		LastTransitionTime: metav1.Now(),
+	})
+}
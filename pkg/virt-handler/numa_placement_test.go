@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virthandler
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	nodelabellerapi "kubevirt.io/kubevirt/pkg/virt-handler/node-labeller/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
+)
+
+var _ = Describe("GPU NUMA placement", func() {
+	const (
+		gpuAddress           = "0000:81:00.0"
+		noAffinityGPUAddress = "0000:83:00.0"
+	)
+
+	var (
+		capabilities *nodelabellerapi.Capabilities
+		gpuNode      uint32
+	)
+
+	newDomain := func(vcpuCPUSets ...string) *api.Domain {
+		domain := &api.Domain{}
+		domain.Spec.CPUTune = &api.CPUTune{}
+		for i, cpuSet := range vcpuCPUSets {
+			domain.Spec.CPUTune.VCPUPin = append(domain.Spec.CPUTune.VCPUPin, api.CPUTuneVCPUPin{VCPU: uint32(i), CPUSet: cpuSet})
+		}
+		domain.Spec.Devices.HostDevices = []api.HostDevice{{
+			Alias:  api.NewUserDefinedAlias(gpu.AliasPrefix + "gpu1"),
+			Type:   api.HostDevicePCI,
+			Source: api.HostDeviceSource{Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x00", Function: "0x0"}},
+		}}
+		return domain
+	}
+
+	BeforeEach(func() {
+		capabilities = &nodelabellerapi.Capabilities{}
+		capabilities.Host.Topology.Cells.Cell = []nodelabellerapi.Cell{
+			{ID: 0, Cpus: nodelabellerapi.CPUs{CPU: []nodelabellerapi.CPU{{ID: 0}, {ID: 1}}}},
+			{ID: 1, Cpus: nodelabellerapi.CPUs{CPU: []nodelabellerapi.CPU{{ID: 2}, {ID: 3}}}},
+		}
+		gpuNode = 1
+
+		origGetDeviceNumaNode := getDeviceNumaNode
+		getDeviceNumaNode = func(pciAddress string) (*uint32, error) {
+			switch pciAddress {
+			case gpuAddress:
+				return &gpuNode, nil
+			case noAffinityGPUAddress:
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unknown device %s", pciAddress)
+		}
+		DeferCleanup(func() {
+			getDeviceNumaNode = origGetDeviceNumaNode
+		})
+	})
+
+	It("should accept a GPU on the NUMA node of the vCPUs", func() {
+		Expect(checkGPUNUMAPlacement(newDomain("2", "3"), capabilities)).To(BeNil())
+	})
+
+	It("should accept a GPU on one of the NUMA nodes of the vCPUs", func() {
+		Expect(checkGPUNUMAPlacement(newDomain("1", "2"), capabilities)).To(BeNil())
+	})
+
+	It("should report a GPU on a NUMA node without vCPUs", func() {
+		misalignment := checkGPUNUMAPlacement(newDomain("0", "1"), capabilities)
+		Expect(misalignment).ToNot(BeNil())
+		Expect(misalignment.reason).To(Equal(v1.VirtualMachineInstanceReasonGPUNUMAMisaligned))
+		Expect(misalignment.message).To(Equal("GPU gpu1 is on host NUMA node 1, the dedicated CPUs are on host NUMA nodes 0"))
+	})
+
+	It("should report a GPU on a NUMA node backing no guest NUMA node", func() {
+		domain := newDomain("1", "2")
+		domain.Spec.NUMATune = &api.NUMATune{MemNodes: []api.MemNode{{CellID: 0, Mode: "strict", NodeSet: "0"}}}
+		misalignment := checkGPUNUMAPlacement(domain, capabilities)
+		Expect(misalignment).ToNot(BeNil())
+		Expect(misalignment.reason).To(Equal(v1.VirtualMachineInstanceReasonGPUGuestNUMAMisaligned))
+	})
+
+	It("should accept a GPU on a NUMA node backing a guest NUMA node", func() {
+		domain := newDomain("1", "2")
+		domain.Spec.NUMATune = &api.NUMATune{MemNodes: []api.MemNode{
+			{CellID: 0, Mode: "strict", NodeSet: "0"},
+			{CellID: 1, Mode: "strict", NodeSet: "1"},
+		}}
+		Expect(checkGPUNUMAPlacement(domain, capabilities)).To(BeNil())
+	})
+
+	It("should ignore host devices which are not GPUs", func() {
+		domain := newDomain("0", "1")
+		domain.Spec.Devices.HostDevices[0].Alias = api.NewUserDefinedAlias("hostdevice-nic")
+		Expect(checkGPUNUMAPlacement(domain, capabilities)).To(BeNil())
+	})
+
+	It("should ignore GPUs with an incomplete PCI address", func() {
+		domain := newDomain("0", "1")
+		domain.Spec.Devices.HostDevices[0].Source.Address = &api.Address{Type: api.AddressPCI, Bus: "0x81"}
+		Expect(checkGPUNUMAPlacement(domain, capabilities)).To(BeNil())
+	})
+
+	It("should ignore GPUs without NUMA affinity", func() {
+		domain := newDomain("0", "1")
+		domain.Spec.Devices.HostDevices[0].Source.Address.Bus = "0x83"
+		Expect(checkGPUNUMAPlacement(domain, capabilities)).To(BeNil())
+	})
+
+	It("should ignore GPUs with an unknown NUMA node", func() {
+		domain := newDomain("0", "1")
+		domain.Spec.Devices.HostDevices[0].Source.Address.Bus = "0x82"
+		Expect(checkGPUNUMAPlacement(domain, capabilities)).To(BeNil())
+	})
+})
@@ -95,6 +95,7 @@ import (
 	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	"kubevirt.io/kubevirt/pkg/executor"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-handler"
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	virtutil "kubevirt.io/kubevirt/pkg/util"
@@ -130,6 +131,14 @@ const (
 	unableCreateVirtLauncherConnectionFmt = "unable to create virt-launcher client connection: %v"
 )
 
+const (
+	// maxConcurrentChecksumComputations bounds the containerDisk checksums computed in the background,
+	// each one reads the whole images of a VMI.
+	maxConcurrentChecksumComputations = 2
+	// checksumWaitInterval is how often a migration target checks for the checksums of the source.
+	checksumWaitInterval = 5 * time.Second
+)
+
 const (
 	//VolumeReadyReason is the reason set when the volume is ready.
 	VolumeReadyReason = "VolumeReady"
@@ -237,7 +246,8 @@ func NewController(
 		watchdogTimeoutSeconds:      watchdogTimeoutSeconds,
 		migrationProxy:              migrationProxy,
 		podIsolationDetector:        podIsolationDetector,
-		containerDiskMounter:        container_disk.NewMounter(podIsolationDetector, filepath.Join(virtPrivateDir, "container-disk-mount-state"), clusterConfig),
+		containerDiskMounter:        container_disk.NewMounter(podIsolationDetector, filepath.Join(virtPrivateDir, "container-disk-mount-state"), filepath.Join(util.VirtLibDir, "container-disk-cache"), clusterConfig),
+		containerDiskChecksums:      container_disk.NewAsyncChecksums(maxConcurrentChecksumComputations),
 		hotplugVolumeMounter:        hotplug_volume.NewVolumeMounter(filepath.Join(virtPrivateDir, "hotplug-volume-mount-state"), kubeletPodsDir),
 		clusterConfig:               clusterConfig,
 		virtLauncherFSRunDirPattern: "/proc/%d/root/var/run",
@@ -326,6 +336,7 @@ type VirtualMachineController struct {
 	migrationProxy           migrationproxy.ProxyManager
 	podIsolationDetector     isolation.PodIsolationDetector
 	containerDiskMounter     container_disk.Mounter
+	containerDiskChecksums   *container_disk.AsyncChecksums
 	hotplugVolumeMounter     hotplug_volume.VolumeMounter
 	clusterConfig            *virtconfig.ClusterConfig
 	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
@@ -355,6 +366,14 @@ type vmiIrrecoverableError struct {
 
 func (e *vmiIrrecoverableError) Error() string { return e.msg }
 
+type hotplugVolumeMountError struct {
+	err error
+}
+
+func (e *hotplugVolumeMountError) Error() string { return e.err.Error() }
+
+func (e *hotplugVolumeMountError) Unwrap() error { return e.err }
+
 func formatIrrecoverableErrorMessage(domain *api.Domain) string {
 	msg := "unknown reason"
 	if domainPausedFailedPostCopy(domain) {
@@ -948,16 +967,59 @@ func needToComputeChecksums(vmi *v1.VirtualMachineInstance) bool {
 		}
 	}
 
+	if util.HasFirmwareImage(vmi) && vmi.Status.FirmwareImageStatus.Checksum == 0 {
+		return true
+	}
+
 	return false
 }
 
+// computeChecksums returns the checksums of the containerDisks and kernelboot artifacts of the VMI.
+// Computing them reads the images completely, with ContainerDiskLazyPulling this happens in the background
+// so that lazily pulled images are fetched while the VMI boots. Nil is returned until the computation is done.
+func (d *VirtualMachineController) computeChecksums(vmi *v1.VirtualMachineInstance) (*container_disk.DiskChecksums, error) {
+	if !d.clusterConfig.ContainerDiskLazyPullingEnabled() {
+		return d.containerDiskMounter.ComputeChecksums(vmi)
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	key := controller.VirtualMachineInstanceKey(vmi)
+	result, done := d.containerDiskChecksums.Result(vmi.UID,
+		func() (*container_disk.DiskChecksums, error) {
+			return d.containerDiskMounter.ComputeChecksums(vmiCopy)
+		},
+		func() {
+			d.Queue.Add(key)
+		},
+	)
+	if !done {
+		return nil, nil
+	}
+	d.containerDiskChecksums.Forget(vmi.UID)
+
+	if result.Err == nil {
+		var runningSince time.Time
+		for _, transition := range vmi.Status.PhaseTransitionTimestamps {
+			if transition.Phase == v1.Running {
+				runningSince = transition.PhaseTransitionTimestamp.Time
+			}
+		}
+		metrics.ObserveContainerDiskFetch(result.Started, result.Finished, runningSince)
+	}
+	return result.Checksums, result.Err
+}
+
 func (d *VirtualMachineController) updateChecksumInfo(vmi *v1.VirtualMachineInstance, syncError error) error {
 
 	if syncError != nil || vmi.DeletionTimestamp != nil || !needToComputeChecksums(vmi) {
 		return nil
 	}
 
-	diskChecksums, err := d.containerDiskMounter.ComputeChecksums(vmi)
+	diskChecksums, err := d.computeChecksums(vmi)
+	if diskChecksums == nil && err == nil {
+		// still computed in the background
+		return nil
+	}
 	if goerror.Is(err, container_disk.ErrDiskContainerGone) {
 		log.Log.Errorf("cannot compute checksums as containerdisk/kernelboot containers seem to have been terminated")
 		return nil
@@ -996,6 +1058,11 @@ func (d *VirtualMachineController) updateChecksumInfo(vmi *v1.VirtualMachineInst
 		}
 	}
 
+	// firmware
+	if util.HasFirmwareImage(vmi) && diskChecksums.FirmwareChecksum != nil {
+		vmi.Status.FirmwareImageStatus.Checksum = *diskChecksums.FirmwareChecksum
+	}
+
 	return nil
 }
 
@@ -1219,6 +1286,52 @@ func (d *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 	}
 }
 
+func (d *VirtualMachineController) updateShutdownConditions(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.GracePeriod == nil {
+		return
+	}
+	gracePeriod := domain.Spec.Metadata.KubeVirt.GracePeriod
+	if gracePeriod.ShutdownMethod == "" {
+		return
+	}
+
+	status := k8sv1.ConditionTrue
+	if gracePeriod.ShutdownMethod == v1.VirtualMachineInstanceReasonForceOffShutdown {
+		status = k8sv1.ConditionFalse
+	}
+	message := shutdownTrail(gracePeriod)
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGracefulShutdown)
+	if condition != nil {
+		if condition.Status == status && condition.Reason == gracePeriod.ShutdownMethod && condition.Message == message {
+			return
+		}
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceGracefulShutdown)
+	}
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceGracefulShutdown,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Reason:             gracePeriod.ShutdownMethod,
+		Message:            message,
+	})
+}
+
+// shutdownTrail describes the shutdown signals which were sent to the guest so far
+func shutdownTrail(gracePeriod *api.GracePeriodMetadata) string {
+	var trail []string
+	if gracePeriod.ACPIAttempts > 0 {
+		trail = append(trail, fmt.Sprintf("ACPI power button sent %d time(s)", gracePeriod.ACPIAttempts))
+	}
+	if gracePeriod.GuestAgentAttempted {
+		trail = append(trail, "guest agent shutdown requested")
+	}
+	if gracePeriod.ShutdownMethod == v1.VirtualMachineInstanceReasonForceOffShutdown {
+		trail = append(trail, "guest forcefully powered off")
+	}
+	return strings.Join(trail, ", ")
+}
+
 func dumpTargetFile(vmiName, volName string) string {
 	targetFileName := fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, time.Now().Format("20060102-150405"))
 	return targetFileName
@@ -1392,6 +1505,7 @@ func (d *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	d.updateVolumeStatusesFromDomain(vmi, domain)
 	d.updateFSFreezeStatus(vmi, domain)
 	d.updateMachineType(vmi, domain)
+	d.updateGuestNUMAInfo(vmi, domain)
 	if err = d.updateMemoryInfo(vmi, domain); err != nil {
 		return err
 	}
@@ -1407,6 +1521,8 @@ func (d *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	d.updatePausedConditions(vmi, domain, condManager)
+	d.updateShutdownConditions(vmi, domain, condManager)
+	d.updateGPUNUMAAlignmentCondition(vmi, domain, condManager)
 
 	return nil
 }
@@ -1451,6 +1567,8 @@ func (d *VirtualMachineController) updateVMIStatus(origVMI *v1.VirtualMachineIns
 		return err
 	}
 
+	updateHotplugVolumesCondition(vmi, condManager, syncError)
+
 	// Handle sync error
 	handleSyncError(vmi, condManager, syncError)
 
@@ -1493,6 +1611,74 @@ func handleSyncError(vmi *v1.VirtualMachineInstance, condManager *controller.Vir
 	condManager.CheckFailure(vmi, syncError, "Synchronizing with the Domain failed.")
 }
 
+// hotplugSteps orders the steps of the hotplug pipeline
+var hotplugSteps = map[string]int{
+	v1.VirtualMachineInstanceReasonHotplugAttachmentPodNotReady: 0,
+	v1.VirtualMachineInstanceReasonHotplugVolumeNotMounted:      1,
+	v1.VirtualMachineInstanceReasonHotplugVolumeNotAttached:     2,
+}
+
+func hotplugVolumeStep(volumeStatus *v1.VolumeStatus) string {
+	switch {
+	case volumeStatus.Phase == v1.HotplugVolumeMounted:
+		return v1.VirtualMachineInstanceReasonHotplugVolumeNotAttached
+	case volumeStatus.HotplugVolume.AttachPodUID != "":
+		return v1.VirtualMachineInstanceReasonHotplugVolumeNotMounted
+	default:
+		return v1.VirtualMachineInstanceReasonHotplugAttachmentPodNotReady
+	}
+}
+
+// updateHotplugVolumesCondition reports the step of the hotplug pipeline the hotplugged volumes are waiting for
+// or failing at, so that a stuck hotplug can be told apart from a slow one.
+func updateHotplugVolumesCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager, syncError error) {
+	specVolumes := make(map[string]struct{}, len(vmi.Spec.Volumes))
+	for _, volume := range vmi.Spec.Volumes {
+		specVolumes[volume.Name] = struct{}{}
+	}
+
+	var reason, message string
+	var mountError *hotplugVolumeMountError
+	if goerror.As(syncError, &mountError) {
+		reason = v1.VirtualMachineInstanceReasonHotplugVolumeMountFailed
+		message = mountError.Error()
+	} else {
+		for _, volumeStatus := range vmi.Status.VolumeStatus {
+			if volumeStatus.HotplugVolume == nil || volumeStatus.Phase == v1.VolumeReady {
+				continue
+			}
+			if _, inSpec := specVolumes[volumeStatus.Name]; !inSpec {
+				// being unplugged
+				continue
+			}
+			// report the volume which is the furthest behind
+			step := hotplugVolumeStep(&volumeStatus)
+			if reason == "" || hotplugSteps[step] < hotplugSteps[reason] {
+				reason = step
+				message = fmt.Sprintf("volume %s: %s", volumeStatus.Name, volumeStatus.Message)
+			}
+		}
+	}
+
+	if reason == "" {
+		condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceHotplugVolumesReady)
+		return
+	}
+
+	condition := condManager.GetCondition(vmi, v1.VirtualMachineInstanceHotplugVolumesReady)
+	if condition != nil && condition.Reason == reason && condition.Message == message {
+		return
+	}
+	condManager.RemoveCondition(vmi, v1.VirtualMachineInstanceHotplugVolumesReady)
+	vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceHotplugVolumesReady,
+		Status:             k8sv1.ConditionFalse,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
 func (d *VirtualMachineController) recordPhaseChangeEvent(vmi *v1.VirtualMachineInstance) {
 	switch vmi.Status.Phase {
 	case v1.Running:
@@ -2169,6 +2355,8 @@ func (d *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 
 	d.downwardMetricsManager.StopServer(vmi)
 
+	d.containerDiskChecksums.Forget(vmi.UID)
+
 	// Unmount container disks and clean up remaining files
 	if err := d.containerDiskMounter.Unmount(vmi); err != nil {
 		return err
@@ -2409,17 +2597,19 @@ func (d *VirtualMachineController) shutdownVMI(vmi *v1.VirtualMachineInstance, c
 
 	log.Log.Object(vmi).Infof("Signaled graceful shutdown for %s", vmi.GetObjectMeta().GetName())
 
+	// In case we have a long grace period, we want to resend the graceful shutdown periodically
+	// That's important since a booting OS can miss ACPI signals
+	interval := virtutil.ShutdownSignalIntervalSeconds(vmi)
+
 	// Make sure that we don't hot-loop in case we send the first domain notification
 	if timeLeft == -1 {
-		timeLeft = 5
+		timeLeft = interval
 		if vmi.Spec.TerminationGracePeriodSeconds != nil && *vmi.Spec.TerminationGracePeriodSeconds < timeLeft {
 			timeLeft = *vmi.Spec.TerminationGracePeriodSeconds
 		}
 	}
-	// In case we have a long grace period, we want to resend the graceful shutdown every 5 seconds
-	// That's important since a booting OS can miss ACPI signals
-	if timeLeft > 5 {
-		timeLeft = 5
+	if timeLeft > interval {
+		timeLeft = interval
 	}
 
 	// pending graceful shutdown.
@@ -2805,6 +2995,14 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 		return nil
 	}
 
+	// With ContainerDiskLazyPulling the source computes the checksums in the background after the VMI
+	// started, so they may not be reported yet. Wait for them instead of failing the sync.
+	if d.clusterConfig.ContainerDiskLazyPullingEnabled() && needToComputeChecksums(vmi) {
+		log.Log.Object(vmi).Info("Waiting for the source to compute the containerdisk checksums")
+		d.Queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), checksumWaitInterval)
+		return nil
+	}
+
 	// Verify container disks checksum
 	err = container_disk.VerifyChecksums(d.containerDiskMounter, vmi)
 	switch {
@@ -3050,7 +3248,7 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 
 		// Try to mount hotplug volume if there is any during startup.
 		if err := d.hotplugVolumeMounter.Mount(vmi, cgroupManager); err != nil {
-			return err
+			return &hotplugVolumeMountError{err: err}
 		}
 
 		nonAbsentIfaces := netvmispec.FilterInterfacesSpec(vmi.Spec.Domain.Devices.Interfaces, func(iface v1.Interface) bool {
@@ -3135,7 +3333,7 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 		}
 
 		if err := d.hotplugVolumeMounter.Mount(vmi, cgroupManager); err != nil {
-			return err
+			return &hotplugVolumeMountError{err: err}
 		}
 
 		if err := d.getMemoryDump(vmi); err != nil {
@@ -3257,6 +3455,16 @@ func (d *VirtualMachineController) hotplugSriovInterfacesCommand(vmi *v1.Virtual
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
 	}
 
+	if virtutil.IsNonRootVMI(vmi) {
+		res, err := d.podIsolationDetector.Detect(vmi)
+		if err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+		if err := d.prepareVFIO(vmi, res); err != nil {
+			return fmt.Errorf("%s: %v", errMsgPrefix, err)
+		}
+	}
+
 	log.Log.V(3).Object(vmi).Info("sending hot-plug host-devices command")
 	if err := client.HotplugHostDevices(vmi); err != nil {
 		return fmt.Errorf("%s: %v", errMsgPrefix, err)
@@ -3684,6 +3892,60 @@ func removeMigratedVolumes(vmi *v1.VirtualMachineInstance) {
 	vmi.Status.MigratedVolumes = nil
 }
 
+// updateGuestNUMAInfo reports the NUMA topology presented to the guest, as it was finally chosen by virt-launcher
+func (d *VirtualMachineController) updateGuestNUMAInfo(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || vmi == nil {
+		return
+	}
+	if domain.Spec.CPU.NUMA == nil || len(domain.Spec.CPU.NUMA.Cells) == 0 {
+		vmi.Status.GuestNUMA = nil
+		return
+	}
+
+	hostNodes := map[uint32]uint32{}
+	if domain.Spec.NUMATune != nil {
+		for _, memNode := range domain.Spec.NUMATune.MemNodes {
+			if node, err := strconv.ParseUint(memNode.NodeSet, 10, 32); err == nil {
+				hostNodes[memNode.CellID] = uint32(node)
+			}
+		}
+	}
+
+	guestNUMA := &v1.GuestNUMAStatus{}
+	for _, cell := range domain.Spec.CPU.NUMA.Cells {
+		id, err := strconv.ParseUint(cell.ID, 10, 32)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to parse the id of guest NUMA node %s", cell.ID)
+			return
+		}
+		guestCell := v1.GuestNUMACell{ID: uint32(id)}
+		if cpus, err := hardware.ParseCPUSetLine(cell.CPUs, 50000); err == nil {
+			for _, cpu := range cpus {
+				guestCell.CPUs = append(guestCell.CPUs, uint32(cpu))
+			}
+		}
+		if cell.Memory > 0 {
+			unit := cell.Unit
+			if unit == "" {
+				unit = "KiB"
+			}
+			guestCell.Memory = parseLibvirtQuantity(int64(cell.Memory), unit)
+		}
+		if hostNode, exists := hostNodes[guestCell.ID]; exists {
+			guestCell.HostNode = &hostNode
+		}
+		if cell.Distances != nil {
+			siblings := append([]api.NUMACellSibling{}, cell.Distances.Siblings...)
+			sort.Slice(siblings, func(i, j int) bool { return siblings[i].ID < siblings[j].ID })
+			for _, sibling := range siblings {
+				guestCell.Distances = append(guestCell.Distances, uint32(sibling.Value))
+			}
+		}
+		guestNUMA.Cells = append(guestNUMA.Cells, guestCell)
+	}
+	vmi.Status.GuestNUMA = guestNUMA
+}
+
 func parseLibvirtQuantity(value int64, unit string) *resource.Quantity {
 	switch unit {
 	case "b", "bytes":
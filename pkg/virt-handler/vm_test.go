@@ -1254,6 +1254,52 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
 			})
 
+			DescribeTable("on a migration target with checksums not yet reported by the source", func(lazyPulling bool) {
+				if lazyPulling {
+					controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{virtconfig.ContainerDiskLazyPullingGate},
+						},
+					})
+				}
+				vmi := NewScheduledVMIWithContainerDisk(vmiTestUUID, podTestUUID, host)
+				vmi.Status.Phase = v1.Running
+				vmi.Status.NodeName = "othernode"
+				vmi.Labels = map[string]string{v1.MigrationTargetNodeNameLabel: host}
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+					TargetNode:   host,
+					SourceNode:   "othernode",
+					MigrationUID: "123",
+				}
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+					Name:                vmi.Spec.Volumes[0].Name,
+					ContainerDiskVolume: &v1.ContainerDiskInfo{},
+				}}
+
+				mockWatchdog.CreateFile(vmi)
+				vmiFeeder.Add(vmi)
+
+				client.EXPECT().Ping()
+				mockContainerDiskMounter.EXPECT().ContainerDisksReady(vmi, gomock.Any()).Return(true, nil)
+
+				if lazyPulling {
+					controller.Execute()
+					Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+					return
+				}
+
+				mockContainerDiskMounter.EXPECT().ComputeChecksums(gomock.Any()).Return(&container_disk.DiskChecksums{
+					ContainerDiskChecksums: map[string]uint32{vmi.Spec.Volumes[0].Name: uint32(1234)},
+				}, nil)
+
+				controller.Execute()
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(0))
+				Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+			},
+				Entry("should fail the sync without ContainerDiskLazyPulling", false),
+				Entry("should wait for them with ContainerDiskLazyPulling", true),
+			)
+
 			It("should compute checksums for the specified containerDisks and kernelboot containers", func() {
 				vmi := NewScheduledVMIWithContainerDisk(vmiTestUUID, podTestUUID, host)
 				vmi.Status.Phase = v1.Running
@@ -1312,6 +1358,42 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 				controller.Execute()
 			})
+
+			It("should compute the checksum of the pinned firmware image", func() {
+				vmi := NewScheduledVMIWithContainerDisk(vmiTestUUID, podTestUUID, host)
+				vmi.Status.Phase = v1.Running
+				vmi.Status.FirmwareImageStatus = &v1.FirmwareImageStatus{
+					Name:  "ovmf",
+					Image: "registry:5000/ovmf:stable",
+					Path:  "/usr/share/OVMF",
+				}
+
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				domainFeeder.Add(domain)
+
+				mockWatchdog.CreateFile(vmi)
+				vmiFeeder.Add(vmi)
+
+				fakeDiskChecksums := &container_disk.DiskChecksums{
+					ContainerDiskChecksums: map[string]uint32{},
+					FirmwareChecksum:       pointer.Uint32(42),
+				}
+
+				mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any(), gomock.Any()).Return(nil)
+				mockContainerDiskMounter.EXPECT().ComputeChecksums(gomock.Any()).Return(fakeDiskChecksums, nil)
+				client.EXPECT().SyncVirtualMachine(gomock.Any(), gomock.Any()).Return(nil)
+				mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any(), gomock.Any()).Return(nil)
+
+				vmiInterface.EXPECT().Update(context.Background(), gomock.Any(), metav1.UpdateOptions{}).DoAndReturn(
+					func(ctx context.Context, vmi *v1.VirtualMachineInstance, options metav1.UpdateOptions) (*v1.VirtualMachineInstance, error) {
+						Expect(vmi.Status.FirmwareImageStatus).ToNot(BeNil())
+						Expect(vmi.Status.FirmwareImageStatus.Checksum).To(Equal(uint32(42)))
+						return vmi, nil
+					})
+
+				controller.Execute()
+			})
 		})
 
 		Context("reacting to a VMI with hotplug", func() {
@@ -3143,6 +3225,70 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 			controller.Execute()
 		})
+
+		It("should report the guest NUMA topology in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.CPU.NUMA = &api.NUMA{Cells: []api.NUMACell{
+				{ID: "0", CPUs: "0-1", Memory: 1024, Unit: "MiB", Distances: &api.NUMACellDistances{Siblings: []api.NUMACellSibling{{ID: 1, Value: 21}, {ID: 0, Value: 10}}}},
+				{ID: "1", CPUs: "2,3", Memory: 1048576, Distances: &api.NUMACellDistances{Siblings: []api.NUMACellSibling{{ID: 0, Value: 21}, {ID: 1, Value: 10}}}},
+			}}
+			domain.Spec.NUMATune = &api.NUMATune{MemNodes: []api.MemNode{
+				{CellID: 0, Mode: "strict", NodeSet: "2"},
+				{CellID: 1, Mode: "strict", NodeSet: "3"},
+			}}
+
+			controller.updateGuestNUMAInfo(vmi, domain)
+
+			expectedMemory := resource.NewQuantity(1024*1024*1024, resource.BinarySI)
+			Expect(vmi.Status.GuestNUMA).To(Equal(&v1.GuestNUMAStatus{Cells: []v1.GuestNUMACell{
+				{ID: 0, CPUs: []uint32{0, 1}, Memory: expectedMemory, HostNode: virtpointer.P(uint32(2)), Distances: []uint32{10, 21}},
+				{ID: 1, CPUs: []uint32{2, 3}, Memory: expectedMemory, HostNode: virtpointer.P(uint32(3)), Distances: []uint32{21, 10}},
+			}}))
+
+			domain.Spec.CPU.NUMA = nil
+			controller.updateGuestNUMAInfo(vmi, domain)
+			Expect(vmi.Status.GuestNUMA).To(BeNil())
+		})
+
+		DescribeTable("should reflect the shutdown signals in the GracefulShutdown condition", func(gracePeriod *api.GracePeriodMetadata, expectedStatus k8sv1.ConditionStatus, expectedMessage string) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.GracePeriod = gracePeriod
+
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateShutdownConditions(vmi, domain, condManager)
+
+			cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceGracefulShutdown)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(expectedStatus))
+			Expect(cond.Reason).To(Equal(gracePeriod.ShutdownMethod))
+			Expect(cond.Message).To(Equal(expectedMessage))
+		},
+			Entry("after an ACPI signal", &api.GracePeriodMetadata{
+				ShutdownMethod: v1.VirtualMachineInstanceReasonACPIShutdown,
+				ACPIAttempts:   2,
+			}, k8sv1.ConditionTrue, "ACPI power button sent 2 time(s)"),
+			Entry("after falling back to the guest agent", &api.GracePeriodMetadata{
+				ShutdownMethod:      v1.VirtualMachineInstanceReasonGuestAgentShutdown,
+				ACPIAttempts:        3,
+				GuestAgentAttempted: true,
+			}, k8sv1.ConditionTrue, "ACPI power button sent 3 time(s), guest agent shutdown requested"),
+			Entry("after a force off", &api.GracePeriodMetadata{
+				ShutdownMethod: v1.VirtualMachineInstanceReasonForceOffShutdown,
+				ACPIAttempts:   1,
+			}, k8sv1.ConditionFalse, "ACPI power button sent 1 time(s), guest forcefully powered off"),
+		)
+
+		It("should not add the GracefulShutdown condition if no shutdown signal was sent", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.GracePeriod = &api.GracePeriodMetadata{DeletionGracePeriodSeconds: 30}
+
+			condManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+			controller.updateShutdownConditions(vmi, domain, condManager)
+			Expect(condManager.HasCondition(vmi, v1.VirtualMachineInstanceGracefulShutdown)).To(BeFalse())
+		})
 	})
 
 	Context("VirtualMachineInstance controller gets informed about disk information", func() {
@@ -3610,6 +3756,81 @@ var _ = Describe("CurrentMemory in Libvirt Domain", func() {
 	)
 })
 
+var _ = Describe("HotplugVolumesReady condition", func() {
+	hotplugVolumeStatus := func(name string, phase v1.VolumePhase, attachPodUID types.UID) v1.VolumeStatus {
+		return v1.VolumeStatus{
+			Name:          name,
+			Phase:         phase,
+			Message:       string(phase),
+			HotplugVolume: &v1.HotplugVolumeStatus{AttachPodUID: attachPodUID},
+		}
+	}
+
+	newVMIWithHotplugVolumes := func(volumeStatuses ...v1.VolumeStatus) *v1.VirtualMachineInstance {
+		vmi := api2.NewMinimalVMI("testvmi")
+		for _, volumeStatus := range volumeStatuses {
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{Name: volumeStatus.Name})
+		}
+		vmi.Status.VolumeStatus = volumeStatuses
+		return vmi
+	}
+
+	DescribeTable("should report the step the furthest behind volume waits for", func(vmi *v1.VirtualMachineInstance, expectedReason, expectedMessage string) {
+		updateHotplugVolumesCondition(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager(), nil)
+
+		Expect(vmi.Status.Conditions).To(HaveLen(1))
+		Expect(vmi.Status.Conditions[0].Type).To(Equal(v1.VirtualMachineInstanceHotplugVolumesReady))
+		Expect(vmi.Status.Conditions[0].Status).To(Equal(k8sv1.ConditionFalse))
+		Expect(vmi.Status.Conditions[0].Reason).To(Equal(expectedReason))
+		Expect(vmi.Status.Conditions[0].Message).To(Equal(expectedMessage))
+	},
+		Entry("without attachment pod",
+			newVMIWithHotplugVolumes(hotplugVolumeStatus("vol1", v1.VolumePending, "")),
+			v1.VirtualMachineInstanceReasonHotplugAttachmentPodNotReady, "volume vol1: Pending"),
+		Entry("when not mounted",
+			newVMIWithHotplugVolumes(hotplugVolumeStatus("vol1", v1.HotplugVolumeAttachedToNode, "pod")),
+			v1.VirtualMachineInstanceReasonHotplugVolumeNotMounted, "volume vol1: AttachedToNode"),
+		Entry("when not attached",
+			newVMIWithHotplugVolumes(hotplugVolumeStatus("vol1", v1.HotplugVolumeMounted, "pod")),
+			v1.VirtualMachineInstanceReasonHotplugVolumeNotAttached, "volume vol1: MountedToPod"),
+		Entry("with several volumes",
+			newVMIWithHotplugVolumes(
+				hotplugVolumeStatus("vol1", v1.HotplugVolumeMounted, "pod"),
+				hotplugVolumeStatus("vol2", v1.HotplugVolumeAttachedToNode, "pod"),
+				hotplugVolumeStatus("vol3", v1.VolumeReady, "pod"),
+			),
+			v1.VirtualMachineInstanceReasonHotplugVolumeNotMounted, "volume vol2: AttachedToNode"),
+	)
+
+	It("should report a failed mount", func() {
+		vmi := newVMIWithHotplugVolumes(hotplugVolumeStatus("vol1", v1.HotplugVolumeAttachedToNode, "pod"))
+		syncErr := fmt.Errorf("sync failed: %w", &hotplugVolumeMountError{err: fmt.Errorf("no such device")})
+
+		updateHotplugVolumesCondition(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager(), syncErr)
+
+		Expect(vmi.Status.Conditions).To(HaveLen(1))
+		Expect(vmi.Status.Conditions[0].Reason).To(Equal(v1.VirtualMachineInstanceReasonHotplugVolumeMountFailed))
+		Expect(vmi.Status.Conditions[0].Message).To(Equal("no such device"))
+	})
+
+	It("should remove the condition once all volumes are ready or unplugged", func() {
+		vmi := newVMIWithHotplugVolumes(
+			hotplugVolumeStatus("vol1", v1.VolumeReady, "pod"),
+			hotplugVolumeStatus("vol2", v1.HotplugVolumeDetaching, "pod"),
+		)
+		vmi.Spec.Volumes = vmi.Spec.Volumes[:1]
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceHotplugVolumesReady,
+			Status: k8sv1.ConditionFalse,
+			Reason: v1.VirtualMachineInstanceReasonHotplugVolumeNotAttached,
+		}}
+
+		updateHotplugVolumesCondition(vmi, virtcontroller.NewVirtualMachineInstanceConditionManager(), nil)
+
+		Expect(vmi.Status.Conditions).To(BeEmpty())
+	})
+})
+
 type MockWatchdog struct {
 	baseDir string
 }
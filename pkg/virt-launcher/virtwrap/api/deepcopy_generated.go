@@ -609,6 +609,11 @@ func (in *Controller) DeepCopyInto(out *Controller) {
 		*out = new(ControllerDriver)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(ControllerTarget)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Alias != nil {
 		in, out := &in.Alias, &out.Alias
 		*out = new(Alias)
@@ -658,6 +663,32 @@ func (in *ControllerDriver) DeepCopy() *ControllerDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControllerTarget) DeepCopyInto(out *ControllerTarget) {
+	*out = *in
+	if in.BusNr != nil {
+		in, out := &in.BusNr, &out.BusNr
+		*out = new(uint)
+		**out = **in
+	}
+	if in.Node != nil {
+		in, out := &in.Node, &out.Node
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerTarget.
+func (in *ControllerTarget) DeepCopy() *ControllerTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ControllerTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Defaulter) DeepCopyInto(out *Defaulter) {
 	*out = *in
@@ -931,6 +962,27 @@ func (in *DiskDriver) DeepCopy() *DiskDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(DiskSecret)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -965,6 +1017,11 @@ func (in *DiskSource) DeepCopyInto(out *DiskSource) {
 		*out = make([]Slice, len(*in))
 		copy(*out, *in)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(DiskEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1742,6 +1799,10 @@ func (in *GracePeriodMetadata) DeepCopyInto(out *GracePeriodMetadata) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LastShutdownSignal != nil {
+		in, out := &in.LastShutdownSignal, &out.LastShutdownSignal
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2628,7 +2689,14 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 	if in.Cells != nil {
 		in, out := &in.Cells, &out.Cells
 		*out = make([]NUMACell, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Interconnects != nil {
+		in, out := &in.Interconnects, &out.Interconnects
+		*out = new(NUMAInterconnects)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -2646,6 +2714,11 @@ func (in *NUMA) DeepCopy() *NUMA {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMACell) DeepCopyInto(out *NUMACell) {
 	*out = *in
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = new(NUMACellDistances)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2659,6 +2732,101 @@ func (in *NUMACell) DeepCopy() *NUMACell {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMACellDistances) DeepCopyInto(out *NUMACellDistances) {
+	*out = *in
+	if in.Siblings != nil {
+		in, out := &in.Siblings, &out.Siblings
+		*out = make([]NUMACellSibling, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMACellDistances.
+func (in *NUMACellDistances) DeepCopy() *NUMACellDistances {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMACellDistances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMACellSibling) DeepCopyInto(out *NUMACellSibling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMACellSibling.
+func (in *NUMACellSibling) DeepCopy() *NUMACellSibling {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMACellSibling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAInterconnectBandwidth) DeepCopyInto(out *NUMAInterconnectBandwidth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAInterconnectBandwidth.
+func (in *NUMAInterconnectBandwidth) DeepCopy() *NUMAInterconnectBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAInterconnectBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAInterconnectLatency) DeepCopyInto(out *NUMAInterconnectLatency) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAInterconnectLatency.
+func (in *NUMAInterconnectLatency) DeepCopy() *NUMAInterconnectLatency {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAInterconnectLatency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMAInterconnects) DeepCopyInto(out *NUMAInterconnects) {
+	*out = *in
+	if in.Latencies != nil {
+		in, out := &in.Latencies, &out.Latencies
+		*out = make([]NUMAInterconnectLatency, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bandwidths != nil {
+		in, out := &in.Bandwidths, &out.Bandwidths
+		*out = make([]NUMAInterconnectBandwidth, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMAInterconnects.
+func (in *NUMAInterconnects) DeepCopy() *NUMAInterconnects {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMAInterconnects)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMATune) DeepCopyInto(out *NUMATune) {
 	*out = *in
@@ -3031,7 +3199,11 @@ func (in *SMBios) DeepCopy() *SMBios {
 func (in *SecretSpec) DeepCopyInto(out *SecretSpec) {
 	*out = *in
 	out.XMLName = in.XMLName
-	out.Usage = in.Usage
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(SecretUsage)
+		**out = **in
+	}
 	return
 }
 
@@ -282,15 +282,46 @@ type CPU struct {
 }
 
 type NUMA struct {
-	Cells []NUMACell `xml:"cell"`
+	Cells         []NUMACell         `xml:"cell"`
+	Interconnects *NUMAInterconnects `xml:"interconnects,omitempty"`
 }
 
 type NUMACell struct {
-	ID           string `xml:"id,attr"`
-	CPUs         string `xml:"cpus,attr"`
-	Memory       uint64 `xml:"memory,attr,omitempty"`
-	Unit         string `xml:"unit,attr,omitempty"`
-	MemoryAccess string `xml:"memAccess,attr,omitempty"`
+	ID           string             `xml:"id,attr"`
+	CPUs         string             `xml:"cpus,attr"`
+	Memory       uint64             `xml:"memory,attr,omitempty"`
+	Unit         string             `xml:"unit,attr,omitempty"`
+	MemoryAccess string             `xml:"memAccess,attr,omitempty"`
+	Distances    *NUMACellDistances `xml:"distances,omitempty"`
+}
+
+type NUMACellDistances struct {
+	Siblings []NUMACellSibling `xml:"sibling"`
+}
+
+type NUMACellSibling struct {
+	ID    uint32 `xml:"id,attr"`
+	Value uint64 `xml:"value,attr"`
+}
+
+type NUMAInterconnects struct {
+	Latencies  []NUMAInterconnectLatency   `xml:"latency"`
+	Bandwidths []NUMAInterconnectBandwidth `xml:"bandwidth"`
+}
+
+type NUMAInterconnectLatency struct {
+	Initiator uint32 `xml:"initiator,attr"`
+	Target    uint32 `xml:"target,attr"`
+	Type      string `xml:"type,attr"`
+	Value     uint64 `xml:"value,attr"`
+}
+
+type NUMAInterconnectBandwidth struct {
+	Initiator uint32 `xml:"initiator,attr"`
+	Target    uint32 `xml:"target,attr"`
+	Type      string `xml:"type,attr"`
+	Value     uint64 `xml:"value,attr"`
+	Unit      string `xml:"unit,attr,omitempty"`
 }
 
 type CPUFeature struct {
@@ -420,6 +451,10 @@ type GracePeriodMetadata struct {
 	DeletionGracePeriodSeconds int64        `xml:"deletionGracePeriodSeconds"`
 	DeletionTimestamp          *metav1.Time `xml:"deletionTimestamp,omitempty"`
 	MarkedForGracefulShutdown  *bool        `xml:"markedForGracefulShutdown,omitempty"`
+	ShutdownMethod             string       `xml:"shutdownMethod,omitempty"`
+	ACPIAttempts               uint32       `xml:"acpiAttempts,omitempty"`
+	GuestAgentAttempted        bool         `xml:"guestAgentAttempted,omitempty"`
+	LastShutdownSignal         *metav1.Time `xml:"lastShutdownSignal,omitempty"`
 }
 
 type Commandline struct {
@@ -638,12 +673,21 @@ type Controller struct {
 	Index   string            `xml:"index,attr"`
 	Model   string            `xml:"model,attr,omitempty"`
 	Driver  *ControllerDriver `xml:"driver,omitempty"`
+	Target  *ControllerTarget `xml:"target,omitempty"`
 	Alias   *Alias            `xml:"alias,omitempty"`
 	Address *Address          `xml:"address,omitempty"`
 }
 
 // END Controller -----------------------------
 
+// BEGIN ControllerTarget
+type ControllerTarget struct {
+	BusNr *uint   `xml:"busNr,attr,omitempty"`
+	Node  *uint32 `xml:"node,omitempty"`
+}
+
+// END ControllerTarget
+
 // BEGIN ControllerDriver
 type ControllerDriver struct {
 	IOThread *uint  `xml:"iothread,attr,omitempty"`
@@ -699,6 +743,12 @@ type DiskSource struct {
 	Host          *DiskSourceHost `xml:"host,omitempty"`
 	Reservations  *Reservations   `xml:"reservations,omitempty"`
 	Slices        []Slice         `xml:"slices,omitempty"`
+	Encryption    *DiskEncryption `xml:"encryption,omitempty"`
+}
+
+type DiskEncryption struct {
+	Format string      `xml:"format,attr"`
+	Secret *DiskSecret `xml:"secret,omitempty"`
 }
 
 type DiskTarget struct {
@@ -1219,11 +1269,12 @@ type SecretUsage struct {
 }
 
 type SecretSpec struct {
-	XMLName     xml.Name    `xml:"secret"`
-	Ephemeral   string      `xml:"ephemeral,attr"`
-	Private     string      `xml:"private,attr"`
-	Description string      `xml:"description,omitempty"`
-	Usage       SecretUsage `xml:"usage,omitempty"`
+	XMLName     xml.Name     `xml:"secret"`
+	Ephemeral   string       `xml:"ephemeral,attr"`
+	Private     string       `xml:"private,attr"`
+	UUID        string       `xml:"uuid,omitempty"`
+	Description string       `xml:"description,omitempty"`
+	Usage       *SecretUsage `xml:"usage,omitempty"`
 }
 
 func NewMinimalDomainSpec(vmiName string) *DomainSpec {
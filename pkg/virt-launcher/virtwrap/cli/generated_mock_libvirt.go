@@ -210,6 +210,16 @@ func (_mr *_MockConnectionRecorder) GetSEVInfo() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetSEVInfo")
 }
 
+func (_m *MockConnection) DefineEphemeralSecret(uuid string, value []byte) error {
+	ret := _m.ctrl.Call(_m, "DefineEphemeralSecret", uuid, value)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockConnectionRecorder) DefineEphemeralSecret(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DefineEphemeralSecret", arg0, arg1)
+}
+
 // Mock of Stream interface
 type MockStream struct {
 	ctrl     *gomock.Controller
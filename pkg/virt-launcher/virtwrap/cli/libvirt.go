@@ -65,6 +65,9 @@ type Connection interface {
 	GetDomainStats(statsTypes libvirt.DomainStatsTypes, l *stats.DomainJobInfo, flags libvirt.ConnectGetAllDomainStatsFlags) ([]*stats.DomainStats, error)
 	GetQemuVersion() (string, error)
 	GetSEVInfo() (*api.SEVNodeParameters, error)
+	// helper method, not found in libvirt
+	// We add this helper to define a private, ephemeral secret and to set its value in one go
+	DefineEphemeralSecret(uuid string, value []byte) error
 }
 
 type Stream interface {
@@ -360,6 +363,33 @@ func (l *LibvirtConnection) GetSEVInfo() (*api.SEVNodeParameters, error) {
 	return sevNodeParameters, nil
 }
 
+func (l *LibvirtConnection) DefineEphemeralSecret(uuid string, value []byte) (err error) {
+	if err = l.reconnectIfNecessary(); err != nil {
+		return
+	}
+
+	secretSpec := api.SecretSpec{
+		Ephemeral: "yes",
+		Private:   "yes",
+		UUID:      uuid,
+	}
+	secretXML, err := xml.Marshal(secretSpec)
+	if err != nil {
+		return err
+	}
+
+	secret, err := l.Connect.SecretDefineXML(string(secretXML), 0)
+	if err != nil {
+		l.checkConnectionLost(err)
+		return err
+	}
+	defer secret.Free()
+
+	err = secret.SetValue(value, 0)
+	l.checkConnectionLost(err)
+	return
+}
+
 func (l *LibvirtConnection) GetDeviceAliasMap(domain *libvirt.Domain) (map[string]string, error) {
 	devAliasMap := make(map[string]string)
 
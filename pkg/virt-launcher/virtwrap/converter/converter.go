@@ -62,6 +62,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/emptydisk"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/ignition"
@@ -114,6 +115,7 @@ type ConverterContext struct {
 	GenericHostDevices              []api.HostDevice
 	GPUHostDevices                  []api.HostDevice
 	EFIConfiguration                *EFIConfiguration
+	BIOSLoader                      string
 	MemBalloonStatsPeriod           uint
 	UseVirtioTransitional           bool
 	EphemeraldiskCreator            ephemeraldisk.EphemeralDiskCreatorInterface
@@ -125,6 +127,7 @@ type ConverterContext struct {
 	BochsForEFIGuests               bool
 	SerialConsoleLog                bool
 	DomainAttachmentByInterfaceName map[string]string
+	EphemeralStorageSecretUUID      string
 }
 
 func contains(volumes []string, name string) bool {
@@ -613,7 +616,7 @@ func Convert_v1_Volume_To_api_Disk(source *v1.Volume, disk *api.Disk, c *Convert
 		return Convert_v1_EphemeralVolumeSource_To_api_Disk(source.Name, disk, c)
 	}
 	if source.EmptyDisk != nil {
-		return Convert_v1_EmptyDiskSource_To_api_Disk(source.Name, source.EmptyDisk, disk)
+		return Convert_v1_EmptyDiskSource_To_api_Disk(source.Name, source.EmptyDisk, disk, c)
 	}
 	if source.ConfigMap != nil {
 		return Convert_v1_Config_To_api_Disk(source.Name, disk, config.ConfigMap)
@@ -672,6 +675,36 @@ func Convert_v1_Config_To_api_Disk(volumeName string, disk *api.Disk, configType
 	return nil
 }
 
+// kernelBootVolumeArtifactPath returns the path of a kernel boot artifact inside the volume it is provided by
+func kernelBootVolumeArtifactPath(vmi *v1.VirtualMachineInstance, volumeName, artifact string, c *ConverterContext) (string, error) {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name != volumeName {
+			continue
+		}
+		switch {
+		case volume.ConfigMap != nil:
+			return filepath.Join(config.GetConfigMapSourcePath(volumeName), artifact), nil
+		case c.IsBlockPVC[volumeName], c.IsBlockDV[volumeName]:
+			return "", fmt.Errorf("kernel boot volume %s is a block volume, a filesystem volume is required", volumeName)
+		case volume.PersistentVolumeClaim != nil, volume.DataVolume != nil:
+			return filepath.Join(hostdisk.GetMountedHostDiskDir(volumeName), artifact), nil
+		default:
+			return "", fmt.Errorf("kernel boot volume %s references an unsupported source", volumeName)
+		}
+	}
+	return "", fmt.Errorf("kernel boot volume %s not found", volumeName)
+}
+
+// readKernelArgsFromConfigMap reads the kernel arguments from the mounted ConfigMap volume
+func readKernelArgsFromConfigMap(argsFrom *v1.KernelArgsSource) (string, error) {
+	argsPath := filepath.Join(config.GetConfigMapSourcePath(argsFrom.VolumeName), argsFrom.Key)
+	kernelArgs, err := os.ReadFile(argsPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read kernel arguments from %s: %v", argsPath, err)
+	}
+	return strings.TrimSpace(string(kernelArgs)), nil
+}
+
 func GetFilesystemVolumePath(volumeName string) string {
 	return filepath.Join(string(filepath.Separator), "var", "run", "kubevirt-private", "vmi-disks", volumeName, "disk.img")
 }
@@ -823,7 +856,7 @@ func Convert_v1_DownwardMetricSource_To_api_Disk(disk *api.Disk, c *ConverterCon
 	return nil
 }
 
-func Convert_v1_EmptyDiskSource_To_api_Disk(volumeName string, _ *v1.EmptyDiskSource, disk *api.Disk) error {
+func Convert_v1_EmptyDiskSource_To_api_Disk(volumeName string, _ *v1.EmptyDiskSource, disk *api.Disk, c *ConverterContext) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
 	}
@@ -832,6 +865,7 @@ func Convert_v1_EmptyDiskSource_To_api_Disk(volumeName string, _ *v1.EmptyDiskSo
 	disk.Driver.Type = "qcow2"
 	disk.Driver.Discard = "unmap"
 	disk.Source.File = emptydisk.NewEmptyDiskCreator().FilePathForVolumeName(volumeName)
+	disk.Source.Encryption = newEphemeralStorageEncryption(c)
 	disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
 
 	return nil
@@ -846,6 +880,7 @@ func Convert_v1_ContainerDiskSource_To_api_Disk(volumeName string, _ *v1.Contain
 	disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
 	disk.Driver.Discard = "unmap"
 	disk.Source.File = c.EphemeraldiskCreator.GetFilePath(volumeName)
+	disk.Source.Encryption = newEphemeralStorageEncryption(c)
 	disk.BackingStore = &api.BackingStore{
 		Format: &api.BackingStoreFormat{},
 		Source: &api.DiskSource{},
@@ -869,6 +904,7 @@ func Convert_v1_EphemeralVolumeSource_To_api_Disk(volumeName string, disk *api.D
 	disk.Driver.ErrorPolicy = v1.DiskErrorPolicyStop
 	disk.Driver.Discard = "unmap"
 	disk.Source.File = c.EphemeraldiskCreator.GetFilePath(volumeName)
+	disk.Source.Encryption = newEphemeralStorageEncryption(c)
 	disk.BackingStore = &api.BackingStore{
 		Format: &api.BackingStoreFormat{},
 		Source: &api.DiskSource{},
@@ -891,6 +927,20 @@ func Convert_v1_EphemeralVolumeSource_To_api_Disk(volumeName string, disk *api.D
 	return nil
 }
 
+// newEphemeralStorageEncryption returns the encryption of the node-local images, nil if the ephemeral storage is not encrypted
+func newEphemeralStorageEncryption(c *ConverterContext) *api.DiskEncryption {
+	if c.EphemeralStorageSecretUUID == "" {
+		return nil
+	}
+	return &api.DiskEncryption{
+		Format: ephemeraldiskutils.EncryptionFormat,
+		Secret: &api.DiskSecret{
+			Type: "passphrase",
+			UUID: c.EphemeralStorageSecretUUID,
+		},
+	}
+}
+
 func Convert_v1_Watchdog_To_api_Watchdog(source *v1.Watchdog, watchdog *api.Watchdog, _ *ConverterContext) error {
 	watchdog.Alias = api.NewUserDefinedAlias(source.Name)
 	if source.I6300ESB != nil {
@@ -1226,6 +1276,14 @@ func Convert_v1_Firmware_To_related_apis(vmi *v1.VirtualMachineInstance, domain
 		}
 	}
 
+	if !util.IsEFIVMI(vmi) && c.BIOSLoader != "" {
+		domain.Spec.OS.BootLoader = &api.Loader{
+			Path:     c.BIOSLoader,
+			ReadOnly: "yes",
+			Type:     "rom",
+		}
+	}
+
 	if firmware.Bootloader != nil && firmware.Bootloader.BIOS != nil {
 		if firmware.Bootloader.BIOS.UseSerial != nil && *firmware.Bootloader.BIOS.UseSerial {
 			domain.Spec.OS.BIOS = &api.BIOS{
@@ -1259,10 +1317,41 @@ func Convert_v1_Firmware_To_related_apis(vmi *v1.VirtualMachineInstance, domain
 
 	}
 
+	if util.HasKernelBootVolume(vmi) {
+		kb := firmware.KernelBoot
+
+		log.Log.Object(vmi).Infof("kernel boot from volume %s defined for VMI. Converting to domain XML", kb.Volume.Name)
+		if kb.Volume.KernelPath != "" {
+			kernelPath, err := kernelBootVolumeArtifactPath(vmi, kb.Volume.Name, kb.Volume.KernelPath, c)
+			if err != nil {
+				return err
+			}
+			log.Log.Object(vmi).Infof("setting kernel path for kernel boot: " + kernelPath)
+			domain.Spec.OS.Kernel = kernelPath
+		}
+
+		if kb.Volume.InitrdPath != "" {
+			initrdPath, err := kernelBootVolumeArtifactPath(vmi, kb.Volume.Name, kb.Volume.InitrdPath, c)
+			if err != nil {
+				return err
+			}
+			log.Log.Object(vmi).Infof("setting initrd path for kernel boot: " + initrdPath)
+			domain.Spec.OS.Initrd = initrdPath
+		}
+	}
+
 	// Define custom command-line arguments even if kernel-boot container is not defined
 	if firmware.KernelBoot != nil {
-		log.Log.Object(vmi).Infof("setting custom kernel arguments: " + firmware.KernelBoot.KernelArgs)
-		domain.Spec.OS.KernelArgs = firmware.KernelBoot.KernelArgs
+		kernelArgs := firmware.KernelBoot.KernelArgs
+		if argsFrom := firmware.KernelBoot.KernelArgsFrom; argsFrom != nil {
+			var err error
+			kernelArgs, err = readKernelArgsFromConfigMap(argsFrom)
+			if err != nil {
+				return err
+			}
+		}
+		log.Log.Object(vmi).Infof("setting custom kernel arguments: " + kernelArgs)
+		domain.Spec.OS.KernelArgs = kernelArgs
 	}
 
 	if firmware.ACPI != nil {
@@ -1776,6 +1865,11 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 
 	domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, c.GenericHostDevices...)
 	domain.Spec.Devices.HostDevices = append(domain.Spec.Devices.HostDevices, c.GPUHostDevices...)
+	if vmi.IsCPUDedicated() && isAMD64(c.Architecture) && strings.Contains(domain.Spec.OS.Type.Machine, "q35") {
+		if err := PlaceGPUsOnGuestNUMANodes(&domain.Spec); err != nil {
+			return err
+		}
+	}
 
 	if vmi.Spec.Domain.CPU == nil || vmi.Spec.Domain.CPU.Model == "" {
 		domain.Spec.CPU.Mode = v1.CPUModeHostModel
@@ -1875,12 +1969,18 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 	}
 
 	if vmi.Spec.Domain.Devices.TPM != nil {
+		tpmVersion := v1.TPMVersion20
+		if vmi.Spec.Domain.Devices.TPM.Version != "" {
+			tpmVersion = vmi.Spec.Domain.Devices.TPM.Version
+		}
+		// libvirt runs swtpm_setup for the requested version and keeps the state of each version
+		//   in a separate directory, so changing the version of a persistent TPM loses its state.
 		domain.Spec.Devices.TPMs = []api.TPM{
 			{
 				Model: "tpm-tis",
 				Backend: api.TPMBackend{
 					Type:    "emulator",
-					Version: "2.0",
+					Version: string(tpmVersion),
 				},
 			},
 		}
@@ -1889,7 +1989,10 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			// tpm-crb is not techincally required for persistence, but since there was a desire for both,
 			//   we decided to introduce them together. Ultimately, we should use tpm-crb for all cases,
 			//   as it is now the generally preferred model
-			domain.Spec.Devices.TPMs[0].Model = "tpm-crb"
+			// tpm-crb is only defined for TPM 2.0, a TPM 1.2 always uses tpm-tis
+			if tpmVersion == v1.TPMVersion20 {
+				domain.Spec.Devices.TPMs[0].Model = "tpm-crb"
+			}
 		}
 	}
 
@@ -2045,6 +2148,10 @@ func GracePeriodSeconds(vmi *v1.VirtualMachineInstance) int64 {
 	if vmi.Spec.TerminationGracePeriodSeconds != nil {
 		gracePeriodSeconds = *vmi.Spec.TerminationGracePeriodSeconds
 	}
+	if vmi.Spec.ShutdownPolicy != nil && vmi.Spec.ShutdownPolicy.ForceOffTimeoutSeconds != nil &&
+		*vmi.Spec.ShutdownPolicy.ForceOffTimeoutSeconds < gracePeriodSeconds {
+		gracePeriodSeconds = *vmi.Spec.ShutdownPolicy.ForceOffTimeoutSeconds
+	}
 	return gracePeriodSeconds
 }
 
@@ -46,8 +46,10 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 
+	"kubevirt.io/kubevirt/pkg/config"
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
+	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 
@@ -299,6 +301,25 @@ var _ = Describe("Converter", func() {
 			xml := diskToDiskXML(v1Disk)
 			Expect(xml).To(Equal(expectedXML))
 		})
+
+		It("should encrypt emptyDisks with the ephemeral storage secret", func() {
+			disk := &api.Disk{Driver: &api.DiskDriver{}}
+			c := &ConverterContext{EphemeralStorageSecretUUID: "a6f2cd67-d0e1-4e0f-9b4c-0c8f4b3e1d20"}
+			Expect(Convert_v1_EmptyDiskSource_To_api_Disk("myvolume", &v1.EmptyDiskSource{}, disk, c)).To(Succeed())
+			Expect(disk.Source.Encryption).To(Equal(&api.DiskEncryption{
+				Format: "luks",
+				Secret: &api.DiskSecret{
+					Type: "passphrase",
+					UUID: "a6f2cd67-d0e1-4e0f-9b4c-0c8f4b3e1d20",
+				},
+			}))
+		})
+
+		It("should not encrypt emptyDisks without an ephemeral storage secret", func() {
+			disk := &api.Disk{Driver: &api.DiskDriver{}}
+			Expect(Convert_v1_EmptyDiskSource_To_api_Disk("myvolume", &v1.EmptyDiskSource{}, disk, &ConverterContext{})).To(Succeed())
+			Expect(disk.Source.Encryption).To(BeNil())
+		})
 	})
 
 	Context("with v1.VirtualMachineInstance", func() {
@@ -2478,6 +2499,22 @@ var _ = Describe("Converter", func() {
 				Expect(domainSpec.OS.BootLoader).To(BeNil())
 				Expect(domainSpec.OS.NVRam).To(BeNil())
 			})
+
+			It("should configure the BIOS rom of the firmware image if provided", func() {
+				c.BIOSLoader = "/var/run/kubevirt/container-disks/firmware/bios.bin"
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					Bootloader: &v1.Bootloader{
+						BIOS: &v1.BIOS{},
+					},
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+				Expect(domainSpec.OS.BootLoader).To(Equal(&api.Loader{
+					Path:     c.BIOSLoader,
+					ReadOnly: "yes",
+					Type:     "rom",
+				}))
+				Expect(domainSpec.OS.NVRam).To(BeNil())
+			})
 		})
 
 		DescribeTable("EFI bootloader", func(secureBoot *bool, efiCode, efiVars string) {
@@ -2611,6 +2648,87 @@ var _ = Describe("Converter", func() {
 				Entry("when no arguments provided", "", "", ""),
 			)
 		})
+
+		Context("when kernel boot from a volume is set", func() {
+			DescribeTable("should point the kernel and initrd into the volume", func(volumeSource v1.VolumeSource, expectedDir string) {
+				vmi.Spec.Volumes = []v1.Volume{{Name: "kernel-volume", VolumeSource: volumeSource}}
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					KernelBoot: &v1.KernelBoot{
+						Volume: &v1.KernelBootVolume{
+							Name:       "kernel-volume",
+							KernelPath: "/boot/vmlinuz",
+							InitrdPath: "/boot/initrd.img",
+						},
+					},
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+
+				Expect(domainSpec.OS.Kernel).To(Equal(filepath.Join(expectedDir, "boot", "vmlinuz")))
+				Expect(domainSpec.OS.Initrd).To(Equal(filepath.Join(expectedDir, "boot", "initrd.img")))
+			},
+				Entry("with a ConfigMap", v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{}}, config.GetConfigMapSourcePath("kernel-volume")),
+				Entry("with a PersistentVolumeClaim", v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}, hostdisk.GetMountedHostDiskDir("kernel-volume")),
+				Entry("with a DataVolume", v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "dv"}}, hostdisk.GetMountedHostDiskDir("kernel-volume")),
+			)
+
+			It("should fail if the volume has an unsupported source", func() {
+				vmi.Spec.Volumes = []v1.Volume{{Name: "kernel-volume", VolumeSource: v1.VolumeSource{EmptyDisk: &v1.EmptyDiskSource{}}}}
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					KernelBoot: &v1.KernelBoot{
+						Volume: &v1.KernelBootVolume{Name: "kernel-volume", KernelPath: "/vmlinuz"},
+					},
+				}
+				domain := &api.Domain{}
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).ToNot(Succeed())
+			})
+
+			It("should fail if the volume is a block volume", func() {
+				vmi.Spec.Volumes = []v1.Volume{{Name: "kernel-volume", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{}}}}
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					KernelBoot: &v1.KernelBoot{
+						Volume: &v1.KernelBootVolume{Name: "kernel-volume", KernelPath: "/vmlinuz"},
+					},
+				}
+				c.IsBlockPVC = map[string]bool{"kernel-volume": true}
+				domain := &api.Domain{}
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).To(MatchError(ContainSubstring("is a block volume")))
+			})
+		})
+
+		Context("when kernel arguments are read from a ConfigMap", func() {
+			var originalConfigMapSourceDir string
+
+			BeforeEach(func() {
+				originalConfigMapSourceDir = config.ConfigMapSourceDir
+				config.ConfigMapSourceDir = GinkgoT().TempDir()
+				Expect(os.MkdirAll(config.GetConfigMapSourcePath("cmdline"), 0755)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				config.ConfigMapSourceDir = originalConfigMapSourceDir
+			})
+
+			It("should set the content of the key as kernel arguments", func() {
+				Expect(os.WriteFile(filepath.Join(config.GetConfigMapSourcePath("cmdline"), "args"), []byte("console=ttyS0 debug\n"), 0644)).To(Succeed())
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					KernelBoot: &v1.KernelBoot{
+						KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "args"},
+					},
+				}
+				domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+				Expect(domainSpec.OS.KernelArgs).To(Equal("console=ttyS0 debug"))
+			})
+
+			It("should fail if the key does not exist", func() {
+				vmi.Spec.Domain.Firmware = &v1.Firmware{
+					KernelBoot: &v1.KernelBoot{
+						KernelArgsFrom: &v1.KernelArgsSource{VolumeName: "cmdline", Key: "missing"},
+					},
+				}
+				domain := &api.Domain{}
+				Expect(Convert_v1_VirtualMachineInstance_To_api_Domain(vmi, domain, c)).ToNot(Succeed())
+			})
+		})
 	})
 
 	Context("hotplug", func() {
@@ -2958,6 +3076,23 @@ var _ = Describe("Converter", func() {
 		})
 	})
 
+	DescribeTable("GracePeriodSeconds", func(terminationGracePeriodSeconds *int64, policy *v1.ShutdownPolicy, expected int64) {
+		vmi := &v1.VirtualMachineInstance{
+			Spec: v1.VirtualMachineInstanceSpec{
+				TerminationGracePeriodSeconds: terminationGracePeriodSeconds,
+				ShutdownPolicy:                policy,
+			},
+		}
+		Expect(GracePeriodSeconds(vmi)).To(Equal(expected))
+	},
+		Entry("should default to the default grace period", nil, nil, v1.DefaultGracePeriodSeconds),
+		Entry("should use the termination grace period", pointer.Int64(60), nil, int64(60)),
+		Entry("should use the force off timeout of the shutdown policy", pointer.Int64(60),
+			&v1.ShutdownPolicy{ForceOffTimeoutSeconds: pointer.Int64(20)}, int64(20)),
+		Entry("should never exceed the termination grace period", pointer.Int64(10),
+			&v1.ShutdownPolicy{ForceOffTimeoutSeconds: pointer.Int64(20)}, int64(10)),
+	)
+
 	Context("with FreePageReporting", func() {
 		var (
 			vmi *v1.VirtualMachineInstance
@@ -3022,6 +3157,136 @@ var _ = Describe("Converter", func() {
 			Entry("disabled when not set", false),
 		)
 	})
+
+	Context("with TPM", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = kvapi.NewMinimalVMI("testvmi")
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+		})
+
+		DescribeTable("should configure the emulated TPM", func(tpm *v1.TPMDevice, expectedTPM api.TPM) {
+			vmi.Spec.Domain.Devices.TPM = tpm
+			domain := vmiToDomain(vmi, &ConverterContext{AllowEmulation: true})
+			Expect(domain.Spec.Devices.TPMs).To(Equal([]api.TPM{expectedTPM}))
+		},
+			Entry("with version 2.0 by default", &v1.TPMDevice{}, api.TPM{
+				Model:   "tpm-tis",
+				Backend: api.TPMBackend{Type: "emulator", Version: "2.0"},
+			}),
+			Entry("with a persistent version 2.0", &v1.TPMDevice{Persistent: kubevirtpointer.P(true), Version: v1.TPMVersion20}, api.TPM{
+				Model:   "tpm-crb",
+				Backend: api.TPMBackend{Type: "emulator", Version: "2.0", PersistentState: "yes"},
+			}),
+			Entry("with version 1.2", &v1.TPMDevice{Version: v1.TPMVersion12}, api.TPM{
+				Model:   "tpm-tis",
+				Backend: api.TPMBackend{Type: "emulator", Version: "1.2"},
+			}),
+			Entry("with a persistent version 1.2 using tpm-tis", &v1.TPMDevice{Persistent: kubevirtpointer.P(true), Version: v1.TPMVersion12}, api.TPM{
+				Model:   "tpm-tis",
+				Backend: api.TPMBackend{Type: "emulator", Version: "1.2", PersistentState: "yes"},
+			}),
+		)
+	})
+})
+
+var _ = Describe("GPU NUMA placement", func() {
+	newGPU := func(name, bus string) api.HostDevice {
+		return api.HostDevice{
+			Type:  api.HostDevicePCI,
+			Alias: api.NewUserDefinedAlias("gpu-" + name),
+			Source: api.HostDeviceSource{
+				Address: &api.Address{Domain: "0x0000", Bus: bus, Slot: "0x00", Function: "0x0"},
+			},
+		}
+	}
+
+	newSpec := func(hostDevices ...api.HostDevice) *api.DomainSpec {
+		return &api.DomainSpec{
+			NUMATune: &api.NUMATune{
+				MemNodes: []api.MemNode{
+					{CellID: 0, Mode: "strict", NodeSet: "0"},
+					{CellID: 1, Mode: "strict", NodeSet: "1"},
+				},
+			},
+			Devices: api.Devices{
+				Controllers: []api.Controller{{Type: "pci", Index: "0", Model: "pcie-root"}},
+				HostDevices: hostDevices,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		hostNodes := map[string]uint32{
+			"0000:01:00.0": 0,
+			"0000:81:00.0": 1,
+			"0000:82:00.0": 1,
+			"0000:c1:00.0": 2,
+		}
+		getDeviceNumaNode = func(pciAddress string) (*uint32, error) {
+			// the kernel reports no NUMA affinity for this one
+			if pciAddress == "0000:83:00.0" {
+				return nil, nil
+			}
+			node, exists := hostNodes[pciAddress]
+			if !exists {
+				return nil, fmt.Errorf("no NUMA node for %s", pciAddress)
+			}
+			return &node, nil
+		}
+		DeferCleanup(func() { getDeviceNumaNode = hardware.GetDeviceNumaNodeAffinity })
+	})
+
+	It("should attach GPUs through an expander bus of the guest NUMA node backed by their host NUMA node", func() {
+		spec := newSpec(newGPU("gpu1", "0x81"), newGPU("gpu2", "0x01"), newGPU("gpu3", "0x82"))
+		Expect(PlaceGPUsOnGuestNUMANodes(spec)).To(Succeed())
+
+		Expect(spec.Devices.Controllers).To(Equal([]api.Controller{
+			{Type: "pci", Index: "0", Model: "pcie-root"},
+			{Type: "pci", Index: "1", Model: "pcie-expander-bus", Target: &api.ControllerTarget{BusNr: kubevirtpointer.P(uint(254)), Node: kubevirtpointer.P(uint32(0))}},
+			{Type: "pci", Index: "2", Model: "pcie-root-port", Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x01", Slot: "0x00", Function: "0x0"}},
+			{Type: "pci", Index: "3", Model: "pcie-expander-bus", Target: &api.ControllerTarget{BusNr: kubevirtpointer.P(uint(251)), Node: kubevirtpointer.P(uint32(1))}},
+			{Type: "pci", Index: "4", Model: "pcie-root-port", Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x03", Slot: "0x00", Function: "0x0"}},
+			{Type: "pci", Index: "5", Model: "pcie-root-port", Address: &api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x03", Slot: "0x01", Function: "0x0"}},
+		}))
+		Expect(spec.Devices.HostDevices[0].Address).To(Equal(&api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x04", Slot: "0x00", Function: "0x0"}))
+		Expect(spec.Devices.HostDevices[1].Address).To(Equal(&api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x02", Slot: "0x00", Function: "0x0"}))
+		Expect(spec.Devices.HostDevices[2].Address).To(Equal(&api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x05", Slot: "0x00", Function: "0x0"}))
+	})
+
+	It("should render the guest NUMA node of the expander bus", func() {
+		spec := newSpec(newGPU("gpu1", "0x81"))
+		Expect(PlaceGPUsOnGuestNUMANodes(spec)).To(Succeed())
+		data, err := xml.Marshal(spec.Devices.Controllers[1])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`<Controller type="pci" index="1" model="pcie-expander-bus"><target busNr="254"><node>1</node></target></Controller>`))
+	})
+
+	DescribeTable("should keep the default placement", func(hostDevice api.HostDevice) {
+		spec := newSpec(hostDevice)
+		Expect(PlaceGPUsOnGuestNUMANodes(spec)).To(Succeed())
+		Expect(spec.Devices.Controllers).To(HaveLen(1))
+		Expect(spec.Devices.HostDevices[0].Address).To(BeNil())
+	},
+		Entry("of a GPU on a host NUMA node which backs no guest NUMA node", newGPU("gpu1", "0xc1")),
+		Entry("of a GPU with an unknown host NUMA node", newGPU("gpu1", "0x02")),
+		Entry("of a GPU without NUMA affinity", newGPU("gpu1", "0x83")),
+		Entry("of a GPU with an incomplete PCI address", newGPU("gpu1", "")),
+		Entry("of a host device which is not a GPU", api.HostDevice{
+			Type:   api.HostDevicePCI,
+			Alias:  api.NewUserDefinedAlias("hostdevice-dev1"),
+			Source: api.HostDeviceSource{Address: &api.Address{Domain: "0x0000", Bus: "0x81", Slot: "0x00", Function: "0x0"}},
+		}),
+	)
+
+	It("should not place GPUs without a guest NUMA topology", func() {
+		spec := newSpec(newGPU("gpu1", "0x81"))
+		spec.NUMATune = nil
+		Expect(PlaceGPUsOnGuestNUMANodes(spec)).To(Succeed())
+		Expect(spec.Devices.Controllers).To(HaveLen(1))
+		Expect(spec.Devices.HostDevices[0].Address).To(BeNil())
+	})
 })
 
 var _ = Describe("disk device naming", func() {
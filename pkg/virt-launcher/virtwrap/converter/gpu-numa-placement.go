@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package converter
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/gpu"
+)
+
+const (
+	pcieExpanderBusModel = "pcie-expander-bus"
+	pcieRootPortModel    = "pcie-root-port"
+	// expander buses take their guest bus numbers from the top, below this one
+	maxPCIBusNr = 256
+)
+
+// getDeviceNumaNode looks up the host NUMA node of a PCI device, it is replaced in tests
+var getDeviceNumaNode = hardware.GetDeviceNumaNodeAffinity
+
+// PlaceGPUsOnGuestNUMANodes attaches the passthrough GPUs through a PCIe expander bus to the guest NUMA node
+// which is backed by the host NUMA node of the GPU, so that the guest sees the NUMA affinity of the GPU.
+// It requires the guest NUMA topology of guestMappingPassthrough, GPUs on host NUMA nodes backing no guest
+// NUMA node keep their default placement.
+func PlaceGPUsOnGuestNUMANodes(spec *api.DomainSpec) error {
+	if spec.NUMATune == nil || len(spec.NUMATune.MemNodes) == 0 {
+		return nil
+	}
+
+	guestCellOfNode := map[uint32]uint32{}
+	for _, memNode := range spec.NUMATune.MemNodes {
+		nodes, err := hardware.ParseCPUSetLine(memNode.NodeSet, 50000)
+		if err != nil {
+			return fmt.Errorf("failed to parse the nodeset of guest NUMA node %d: %v", memNode.CellID, err)
+		}
+		for _, node := range nodes {
+			guestCellOfNode[uint32(node)] = memNode.CellID
+		}
+	}
+
+	var cells []uint32
+	gpusOfCell := map[uint32][]int{}
+	for i, hostDevice := range spec.Devices.HostDevices {
+		if hostDevice.Type != api.HostDevicePCI || hostDevice.Alias == nil ||
+			!strings.HasPrefix(hostDevice.Alias.GetName(), gpu.AliasPrefix) ||
+			hostDevice.Source.Address == nil || hostDevice.Address != nil {
+			continue
+		}
+		pciAddress, err := device.FormatPciAddress(hostDevice.Source.Address)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("failed to format the PCI address of host device %s", hostDevice.Alias.GetName())
+			continue
+		}
+		node, err := getDeviceNumaNode(pciAddress)
+		if err != nil {
+			log.Log.Reason(err).V(4).Infof("failed to look up the NUMA node of %s", pciAddress)
+			continue
+		}
+		if node == nil {
+			// without NUMA affinity the default placement is as good as any
+			continue
+		}
+		cell, exists := guestCellOfNode[*node]
+		if !exists {
+			continue
+		}
+		if _, exists := gpusOfCell[cell]; !exists {
+			cells = append(cells, cell)
+		}
+		gpusOfCell[cell] = append(gpusOfCell[cell], i)
+	}
+	sort.Slice(cells, func(i, j int) bool { return cells[i] < cells[j] })
+
+	index := nextPCIControllerIndex(spec)
+	busNr := maxPCIBusNr
+	for _, cell := range cells {
+		gpus := gpusOfCell[cell]
+		// one bus for the expander bus itself and one for each root port
+		busNr -= 1 + len(gpus)
+		expanderBusIndex := index
+		index++
+		spec.Devices.Controllers = append(spec.Devices.Controllers, api.Controller{
+			Type:  "pci",
+			Index: strconv.Itoa(expanderBusIndex),
+			Model: pcieExpanderBusModel,
+			Target: &api.ControllerTarget{
+				BusNr: pointer.P(uint(busNr)),
+				Node:  pointer.P(cell),
+			},
+		})
+
+		for slot, i := range gpus {
+			rootPortIndex := index
+			index++
+			spec.Devices.Controllers = append(spec.Devices.Controllers, api.Controller{
+				Type:    "pci",
+				Index:   strconv.Itoa(rootPortIndex),
+				Model:   pcieRootPortModel,
+				Address: newPCIAddress(expanderBusIndex, slot),
+			})
+			spec.Devices.HostDevices[i].Address = newPCIAddress(rootPortIndex, 0)
+		}
+	}
+	return nil
+}
+
+func nextPCIControllerIndex(spec *api.DomainSpec) int {
+	next := 1
+	for _, controller := range spec.Devices.Controllers {
+		if controller.Type != "pci" {
+			continue
+		}
+		if index, err := strconv.Atoi(controller.Index); err == nil && index >= next {
+			next = index + 1
+		}
+	}
+	return next
+}
+
+func newPCIAddress(bus, slot int) *api.Address {
+	return &api.Address{
+		Type:     api.AddressPCI,
+		Domain:   "0x0000",
+		Bus:      fmt.Sprintf("%#02x", bus),
+		Slot:     fmt.Sprintf("%#02x", slot),
+		Function: "0x0",
+	}
+}
@@ -1,6 +1,7 @@
 package vcpu
 
 import (
+	"fmt"
 	"strconv"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -77,6 +78,14 @@ var _ = Describe("NumaPlacement", func() {
 		givenVMI = &v1.VirtualMachineInstance{}
 		memory := resource.MustParse("64Mi")
 		givenVMI.Spec.Domain.Memory = &v1.Memory{Guest: &memory}
+
+		origGetNumaNodeLocalAccess := getNumaNodeLocalAccess
+		getNumaNodeLocalAccess = func(numaNode int) (uint64, uint64, error) {
+			return 0, 0, fmt.Errorf("no access attributes for node %d", numaNode)
+		}
+		DeferCleanup(func() {
+			getNumaNodeLocalAccess = origGetNumaNodeLocalAccess
+		})
 	})
 
 	It("should not map the numa topology without hugepages requested", func() {
@@ -180,6 +189,43 @@ var _ = Describe("NumaPlacement", func() {
 			Expect(givenSpec.CPU).To(Equal(expectedSpec.CPU))
 			Expect(givenSpec.MemoryBacking).To(Equal(expectedMemoryBacking))
 		})
+		It("should pass the distances between the host numa nodes on", func() {
+			givenTopology.NumaCells[0].Distances = []*cmdv1.Sibling{{Id: 0, Value: 10}, {Id: 4, Value: 21}}
+			givenTopology.NumaCells[1].Distances = []*cmdv1.Sibling{{Id: 0, Value: 21}, {Id: 4, Value: 10}}
+			expectedSpec.CPU.NUMA.Cells[0].Distances = &api.NUMACellDistances{Siblings: []api.NUMACellSibling{{ID: 0, Value: 10}, {ID: 1, Value: 21}}}
+			expectedSpec.CPU.NUMA.Cells[1].Distances = &api.NUMACellDistances{Siblings: []api.NUMACellSibling{{ID: 0, Value: 21}, {ID: 1, Value: 10}}}
+
+			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+			Expect(givenSpec.CPU).To(Equal(expectedSpec.CPU))
+		})
+
+		It("should not pass incomplete distances on", func() {
+			givenTopology.NumaCells[0].Distances = []*cmdv1.Sibling{{Id: 0, Value: 10}, {Id: 4, Value: 21}}
+			givenTopology.NumaCells[1].Distances = []*cmdv1.Sibling{{Id: 4, Value: 10}}
+
+			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+			Expect(givenSpec.CPU).To(Equal(expectedSpec.CPU))
+		})
+
+		It("should pass the memory access attributes of the host numa nodes on", func() {
+			getNumaNodeLocalAccess = func(numaNode int) (uint64, uint64, error) {
+				return uint64(80 + numaNode), 1024, nil
+			}
+			expectedSpec.CPU.NUMA.Interconnects = &api.NUMAInterconnects{
+				Latencies: []api.NUMAInterconnectLatency{
+					{Initiator: 0, Target: 0, Type: "access", Value: 80},
+					{Initiator: 1, Target: 1, Type: "access", Value: 84},
+				},
+				Bandwidths: []api.NUMAInterconnectBandwidth{
+					{Initiator: 0, Target: 0, Type: "access", Value: 1000000, Unit: "KiB"},
+					{Initiator: 1, Target: 1, Type: "access", Value: 1000000, Unit: "KiB"},
+				},
+			}
+
+			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
+			Expect(givenSpec.CPU).To(Equal(expectedSpec.CPU))
+		})
+
 		It("should process no shared pages when tuned for real time", func() {
 			givenVMI.Spec.Domain.CPU = &v1.CPU{Realtime: &v1.Realtime{}}
 			Expect(numaMapping(givenVMI, givenSpec, givenTopology)).To(Succeed())
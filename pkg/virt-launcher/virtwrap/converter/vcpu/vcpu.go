@@ -19,6 +19,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
+// getNumaNodeLocalAccess looks up the memory access attributes of a host numa node, it is replaced in tests
+var getNumaNodeLocalAccess = hardware.GetNumaNodeLocalAccess
+
 type VCPUPool interface {
 	FitCores() (tune *api.CPUTune, err error)
 	FitThread() (thread uint32, err error)
@@ -626,8 +629,10 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 	}
 
 	virtualCellID := -1
+	var hostCells []*v1.Cell
 	for _, cell := range topology.NumaCells {
 		if vcpus, exists := numamap[cell.Id]; exists {
+			hostCells = append(hostCells, cell)
 			var cpus []string
 			for _, cpu := range vcpus {
 				cpus = append(cpus, strconv.Itoa(int(cpu)))
@@ -658,6 +663,8 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 			domain.CPU.NUMA.Cells[i].Memory += hugepagesSize
 		}
 	}
+	numaDistances(domain, hostCells)
+	numaInterconnects(domain, hostCells)
 	if vmi.IsRealtimeEnabled() {
 		// RT settings when hugepages are enabled
 		domain.MemoryBacking.NoSharePages = &api.NoSharePages{}
@@ -665,6 +672,63 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 	return nil
 }
 
+// numaDistances passes the distances between the host numa nodes backing the guest numa nodes on to the guest,
+// where they end up in the ACPI SLIT. Nothing is passed on if the host does not report all of them.
+func numaDistances(domain *api.DomainSpec, hostCells []*v1.Cell) {
+	distances := make([]*api.NUMACellDistances, len(hostCells))
+	for i, from := range hostCells {
+		distances[i] = &api.NUMACellDistances{}
+		for j, to := range hostCells {
+			distance, exists := hostDistance(from, to.Id)
+			if !exists {
+				return
+			}
+			distances[i].Siblings = append(distances[i].Siblings, api.NUMACellSibling{ID: uint32(j), Value: distance})
+		}
+	}
+	for i := range domain.CPU.NUMA.Cells {
+		domain.CPU.NUMA.Cells[i].Distances = distances[i]
+	}
+}
+
+func hostDistance(from *v1.Cell, to uint32) (uint64, bool) {
+	for _, sibling := range from.Distances {
+		if sibling.Id == to {
+			return sibling.Value, true
+		}
+	}
+	return 0, false
+}
+
+// numaInterconnects passes the local memory access latency and bandwidth the host firmware reports for the host
+// numa nodes backing the guest numa nodes on to the guest, where they end up in the ACPI HMAT. Nothing is passed
+// on if the host does not report them for all nodes.
+func numaInterconnects(domain *api.DomainSpec, hostCells []*v1.Cell) {
+	interconnects := &api.NUMAInterconnects{}
+	for i, cell := range hostCells {
+		latency, bandwidth, err := getNumaNodeLocalAccess(int(cell.Id))
+		if err != nil {
+			log.Log.V(4).Reason(err).Infof("no memory access attributes are known for host numa node %d", cell.Id)
+			return
+		}
+		interconnects.Latencies = append(interconnects.Latencies, api.NUMAInterconnectLatency{
+			Initiator: uint32(i),
+			Target:    uint32(i),
+			Type:      "access",
+			Value:     latency,
+		})
+		// the kernel reports MB/s, libvirt expects KiB/s
+		interconnects.Bandwidths = append(interconnects.Bandwidths, api.NUMAInterconnectBandwidth{
+			Initiator: uint32(i),
+			Target:    uint32(i),
+			Type:      "access",
+			Value:     bandwidth * 1000 * 1000 / 1024,
+			Unit:      "KiB",
+		})
+	}
+	domain.CPU.NUMA.Interconnects = interconnects
+}
+
 func hugePagesInfo(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec) (size uint64, unit string, enabled bool, err error) {
 	if domain.MemoryBacking != nil && domain.MemoryBacking.HugePages != nil {
 		if vmi.Spec.Domain.Memory.Hugepages != nil {
@@ -24,6 +24,7 @@ import (
 	"os"
 	"strings"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util"
@@ -107,3 +108,29 @@ func (p *BestEffortAddressPool) Pop(resource string) (string, error) {
 	address, _ := p.pool.Pop(resource)
 	return address, nil
 }
+
+type DeviceStatusAddressPool struct {
+	addressesByDevice map[string]string
+}
+
+// NewDeviceStatusAddressPool creates a pool of the PCI addresses of the devices allocated through
+// resource claims, as recorded by virt-controller in the VMI status. The addresses are looked up
+// by the name of the device in the VMI spec.
+func NewDeviceStatusAddressPool(deviceStatuses []v1.DeviceStatusInfo) *DeviceStatusAddressPool {
+	pool := &DeviceStatusAddressPool{
+		addressesByDevice: make(map[string]string),
+	}
+	for _, deviceStatus := range deviceStatuses {
+		pool.addressesByDevice[deviceStatus.Name] = deviceStatus.PCIAddress
+	}
+	return pool
+}
+
+func (p *DeviceStatusAddressPool) Pop(name string) (string, error) {
+	address, exists := p.addressesByDevice[name]
+	if !exists {
+		return "", fmt.Errorf("no allocated device found for %s", name)
+	}
+	delete(p.addressesByDevice, name)
+	return address, nil
+}
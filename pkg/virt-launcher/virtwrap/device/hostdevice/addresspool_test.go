@@ -26,6 +26,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
+
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
 )
 
@@ -78,6 +80,25 @@ var _ = Describe("Address Pool", func() {
 	})
 })
 
+var _ = Describe("Device Status Address Pool", func() {
+	It("fails to pop an address given no status for the device", func() {
+		pool := hostdevice.NewDeviceStatusAddressPool([]v1.DeviceStatusInfo{{Name: "gpu0", PCIAddress: pciAddresses0}})
+		_, err := pool.Pop("gpu1")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("succeeds to pop the address of each device once", func() {
+		pool := hostdevice.NewDeviceStatusAddressPool([]v1.DeviceStatusInfo{
+			{Name: "gpu0", PCIAddress: pciAddresses0},
+			{Name: "gpu1", PCIAddress: pciAddresses1},
+		})
+		Expect(pool.Pop("gpu1")).To(Equal(pciAddresses1))
+		Expect(pool.Pop("gpu0")).To(Equal(pciAddresses0))
+		_, err := pool.Pop("gpu0")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 func newResourceEnv(prefix, resourceName string, addresses ...string) envData {
 	resourceName = strings.ToUpper(resourceName)
 	return envData{
@@ -46,6 +46,10 @@ func NewUSBAddressPool(hostDevices []v1.HostDevice) *hostdevice.AddressPool {
 func extractResources(hostDevices []v1.HostDevice) []string {
 	var resourceSet = make(map[string]struct{})
 	for _, hostDevice := range hostDevices {
+		// the addresses of the devices allocated through resource claims are not provided per resource
+		if hostDevice.Claim != nil {
+			continue
+		}
 		resourceSet[hostDevice.DeviceName] = struct{}{}
 	}
 
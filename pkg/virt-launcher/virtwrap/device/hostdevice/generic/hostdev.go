@@ -34,17 +34,18 @@ const (
 	DefaultDisplayOff                 = false
 )
 
-func CreateHostDevices(vmiHostDevices []v1.HostDevice) ([]api.HostDevice, error) {
+func CreateHostDevices(vmiHostDevices []v1.HostDevice, hostDeviceStatuses []v1.DeviceStatusInfo) ([]api.HostDevice, error) {
 	return CreateHostDevicesFromPools(vmiHostDevices,
-		NewPCIAddressPool(vmiHostDevices), NewMDEVAddressPool(vmiHostDevices), NewUSBAddressPool(vmiHostDevices))
+		NewPCIAddressPool(vmiHostDevices), NewMDEVAddressPool(vmiHostDevices), NewUSBAddressPool(vmiHostDevices),
+		hostdevice.NewDeviceStatusAddressPool(hostDeviceStatuses))
 }
 
-func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool, mdevAddressPool, usbAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
+func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool, mdevAddressPool, usbAddressPool, claimAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
 	pciPool := hostdevice.NewBestEffortAddressPool(pciAddressPool)
 	mdevPool := hostdevice.NewBestEffortAddressPool(mdevAddressPool)
 	usbPool := hostdevice.NewBestEffortAddressPool(usbAddressPool)
 
-	hostDevicesMetaData := createHostDevicesMetadata(vmiHostDevices)
+	hostDevicesMetaData, claimedHostDevicesMetaData := createHostDevicesMetadata(vmiHostDevices)
 	pciHostDevices, err := hostdevice.CreatePCIHostDevices(hostDevicesMetaData, pciPool)
 	if err != nil {
 		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
@@ -63,6 +64,14 @@ func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool,
 
 	hostDevices = append(hostDevices, usbHostDevices...)
 
+	// Host devices allocated through resource claims are PCI devices
+	claimedHostDevices, err := hostdevice.CreatePCIHostDevices(claimedHostDevicesMetaData, claimAddressPool)
+	if err != nil {
+		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
+	}
+
+	hostDevices = append(hostDevices, claimedHostDevices...)
+
 	if err := validateCreationOfAllDevices(vmiHostDevices, hostDevices); err != nil {
 		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
 	}
@@ -70,16 +79,26 @@ func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool,
 	return hostDevices, nil
 }
 
-func createHostDevicesMetadata(vmiHostDevices []v1.HostDevice) []hostdevice.HostDeviceMetaData {
-	var hostDevicesMetaData []hostdevice.HostDeviceMetaData
+// createHostDevicesMetadata returns the metadata of the host devices exposed by device plugins and of the
+// host devices allocated through resource claims. The addresses of the latter are looked up by the name of the device.
+func createHostDevicesMetadata(vmiHostDevices []v1.HostDevice) ([]hostdevice.HostDeviceMetaData, []hostdevice.HostDeviceMetaData) {
+	var hostDevicesMetaData, claimedHostDevicesMetaData []hostdevice.HostDeviceMetaData
 	for _, dev := range vmiHostDevices {
+		if dev.Claim != nil {
+			claimedHostDevicesMetaData = append(claimedHostDevicesMetaData, hostdevice.HostDeviceMetaData{
+				AliasPrefix:  AliasPrefix,
+				Name:         dev.Name,
+				ResourceName: dev.Name,
+			})
+			continue
+		}
 		hostDevicesMetaData = append(hostDevicesMetaData, hostdevice.HostDeviceMetaData{
 			AliasPrefix:  AliasPrefix,
 			Name:         dev.Name,
 			ResourceName: dev.DeviceName,
 		})
 	}
-	return hostDevicesMetaData
+	return hostDevicesMetaData, claimedHostDevicesMetaData
 }
 
 // validateCreationOfAllDevices validates that all specified generic host-devices have a matching host-device.
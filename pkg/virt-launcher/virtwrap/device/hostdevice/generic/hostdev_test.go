@@ -39,15 +39,28 @@ var _ = Describe("Generic HostDevice", func() {
 	})
 
 	It("creates no device given no generic host-devices/s", func() {
-		Expect(generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices)).To(BeEmpty())
+		Expect(generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices, nil)).To(BeEmpty())
 	})
 
 	It("fails to create devices given no resource", func() {
 		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{DeviceName: hostdevResource0, Name: hostdevName0}}
-		_, err := generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices)
+		_, err := generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices, nil)
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("creates a PCI device for a host device allocated through a resource claim", func() {
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: hostdevName0, Claim: &v1.ClaimRequest{ClaimName: "hostdev-claim"}}}
+		hostDeviceStatuses := []v1.DeviceStatusInfo{{Name: hostdevName0, ResourceClaimName: "hostdev-claim", DeviceName: "nic-0", PCIAddress: hostdevPCIAddress0}}
+
+		hostPCIAddress := api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x01", Function: "0x0"}
+		Expect(generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices, hostDeviceStatuses)).To(Equal([]api.HostDevice{{
+			Alias:   api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName0),
+			Source:  api.HostDeviceSource{Address: &hostPCIAddress},
+			Type:    api.HostDevicePCI,
+			Managed: "no",
+		}}))
+	})
+
 	It("fails to create device given two devices but only one address", func() {
 		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
 			{DeviceName: hostdevResource0, Name: hostdevName0},
@@ -59,7 +72,7 @@ var _ = Describe("Generic HostDevice", func() {
 		mdevPool.AddResource(hostdevResource1, hostdevPCIAddress1)
 		usbPool := newAddressPoolStub()
 
-		_, err := generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, usbPool)
+		_, err := generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, usbPool, newAddressPoolStub())
 		Expect(err).To(HaveOccurred())
 	})
 
@@ -91,7 +104,7 @@ var _ = Describe("Generic HostDevice", func() {
 			Model:  "vfio-pci",
 		}
 
-		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, usbPool)).
+		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, usbPool, newAddressPoolStub())).
 			To(Equal([]api.HostDevice{expectHostDevice0, expectHostDevice1}))
 	})
 })
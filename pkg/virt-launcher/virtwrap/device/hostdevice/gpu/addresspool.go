@@ -40,6 +40,10 @@ func NewMDEVAddressPool(gpuDevices []v1.GPU) *hostdevice.AddressPool {
 func extractResources(gpuDevices []v1.GPU) []string {
 	var resourceSet = make(map[string]struct{})
 	for _, gpuDevice := range gpuDevices {
+		// the addresses of the devices allocated through resource claims are not provided per resource
+		if gpuDevice.Claim != nil {
+			continue
+		}
 		resourceSet[gpuDevice.DeviceName] = struct{}{}
 	}
 
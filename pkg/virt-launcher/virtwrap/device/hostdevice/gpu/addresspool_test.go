@@ -112,6 +112,7 @@ var _ = Describe("GPU Address Pool", func() {
 		Entry("PCI", gpu.NewPCIAddressPool, v1.PCIResourcePrefix, gpuPCIAddress0, gpuPCIAddress1),
 		Entry("MDEV", gpu.NewMDEVAddressPool, v1.MDevResourcePrefix, gpuMDEVAddress0, gpuMDEVAddress1),
 	)
+
 })
 
 func newResourceEnv(prefix, resourceName string, addresses ...string) envData {
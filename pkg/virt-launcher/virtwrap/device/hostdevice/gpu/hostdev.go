@@ -34,15 +34,16 @@ const (
 	DefaultDisplayOn             = true
 )
 
-func CreateHostDevices(vmiGPUs []v1.GPU) ([]api.HostDevice, error) {
-	return CreateHostDevicesFromPools(vmiGPUs, NewPCIAddressPool(vmiGPUs), NewMDEVAddressPool(vmiGPUs))
+func CreateHostDevices(vmiGPUs []v1.GPU, gpuStatuses []v1.DeviceStatusInfo) ([]api.HostDevice, error) {
+	return CreateHostDevicesFromPools(vmiGPUs,
+		NewPCIAddressPool(vmiGPUs), NewMDEVAddressPool(vmiGPUs), hostdevice.NewDeviceStatusAddressPool(gpuStatuses))
 }
 
-func CreateHostDevicesFromPools(vmiGPUs []v1.GPU, pciAddressPool, mdevAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
+func CreateHostDevicesFromPools(vmiGPUs []v1.GPU, pciAddressPool, mdevAddressPool, claimAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
 	pciPool := hostdevice.NewBestEffortAddressPool(pciAddressPool)
 	mdevPool := hostdevice.NewBestEffortAddressPool(mdevAddressPool)
 
-	hostDevicesMetaData := createHostDevicesMetadata(vmiGPUs)
+	hostDevicesMetaData, claimedHostDevicesMetaData := createHostDevicesMetadata(vmiGPUs)
 	pciHostDevices, err := hostdevice.CreatePCIHostDevices(hostDevicesMetaData, pciPool)
 	if err != nil {
 		return nil, fmt.Errorf(failedCreateGPUHostDeviceFmt, err)
@@ -54,6 +55,14 @@ func CreateHostDevicesFromPools(vmiGPUs []v1.GPU, pciAddressPool, mdevAddressPoo
 
 	hostDevices := append(pciHostDevices, mdevHostDevices...)
 
+	// GPUs allocated through resource claims are PCI devices
+	claimedHostDevices, err := hostdevice.CreatePCIHostDevices(claimedHostDevicesMetaData, claimAddressPool)
+	if err != nil {
+		return nil, fmt.Errorf(failedCreateGPUHostDeviceFmt, err)
+	}
+
+	hostDevices = append(hostDevices, claimedHostDevices...)
+
 	if err := validateCreationOfAllDevices(vmiGPUs, hostDevices); err != nil {
 		return nil, fmt.Errorf(failedCreateGPUHostDeviceFmt, err)
 	}
@@ -61,9 +70,19 @@ func CreateHostDevicesFromPools(vmiGPUs []v1.GPU, pciAddressPool, mdevAddressPoo
 	return hostDevices, nil
 }
 
-func createHostDevicesMetadata(vmiGPUs []v1.GPU) []hostdevice.HostDeviceMetaData {
-	var hostDevicesMetaData []hostdevice.HostDeviceMetaData
+// createHostDevicesMetadata returns the metadata of the GPUs exposed by device plugins and of the GPUs
+// allocated through resource claims. The addresses of the latter are looked up by the name of the GPU.
+func createHostDevicesMetadata(vmiGPUs []v1.GPU) ([]hostdevice.HostDeviceMetaData, []hostdevice.HostDeviceMetaData) {
+	var hostDevicesMetaData, claimedHostDevicesMetaData []hostdevice.HostDeviceMetaData
 	for _, dev := range vmiGPUs {
+		if dev.Claim != nil {
+			claimedHostDevicesMetaData = append(claimedHostDevicesMetaData, hostdevice.HostDeviceMetaData{
+				AliasPrefix:  AliasPrefix,
+				Name:         dev.Name,
+				ResourceName: dev.Name,
+			})
+			continue
+		}
 		hostDevicesMetaData = append(hostDevicesMetaData, hostdevice.HostDeviceMetaData{
 			AliasPrefix:       AliasPrefix,
 			Name:              dev.Name,
@@ -71,7 +90,7 @@ func createHostDevicesMetadata(vmiGPUs []v1.GPU) []hostdevice.HostDeviceMetaData
 			VirtualGPUOptions: dev.VirtualGPUOptions,
 		})
 	}
-	return hostDevicesMetaData
+	return hostDevicesMetaData, claimedHostDevicesMetaData
 }
 
 // validateCreationOfAllDevices validates that all specified GPU/s have a matching host-device.
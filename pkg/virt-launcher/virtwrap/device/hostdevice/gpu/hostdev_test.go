@@ -39,12 +39,31 @@ var _ = Describe("GPU HostDevice", func() {
 	})
 
 	It("creates no device given no GPU/s", func() {
-		Expect(gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs)).To(BeEmpty())
+		Expect(gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs, nil)).To(BeEmpty())
 	})
 
 	It("fails to create devices given no resource", func() {
 		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{DeviceName: gpuResource0, Name: gpuName0}}
-		_, err := gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs)
+		_, err := gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("creates a PCI device for a GPU allocated through a resource claim", func() {
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: gpuName0, Claim: &v1.ClaimRequest{ClaimName: "gpu-claim"}}}
+		gpuStatuses := []v1.DeviceStatusInfo{{Name: gpuName0, ResourceClaimName: "gpu-claim", DeviceName: "gpu-0", PCIAddress: gpuPCIAddress0}}
+
+		hostPCIAddress := api.Address{Type: api.AddressPCI, Domain: "0x0000", Bus: "0x81", Slot: "0x01", Function: "0x0"}
+		Expect(gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs, gpuStatuses)).To(Equal([]api.HostDevice{{
+			Alias:   api.NewUserDefinedAlias(gpu.AliasPrefix + gpuName0),
+			Source:  api.HostDeviceSource{Address: &hostPCIAddress},
+			Type:    api.HostDevicePCI,
+			Managed: "no",
+		}}))
+	})
+
+	It("fails to create devices given no status for a GPU allocated through a resource claim", func() {
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: gpuName0, Claim: &v1.ClaimRequest{ClaimName: "gpu-claim"}}}
+		_, err := gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs, nil)
 		Expect(err).To(HaveOccurred())
 	})
 
@@ -58,7 +77,7 @@ var _ = Describe("GPU HostDevice", func() {
 		mdevPool := newAddressPoolStub()
 		mdevPool.AddResource(gpuResource1, gpuPCIAddress1)
 
-		_, err := gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool)
+		_, err := gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool, newAddressPoolStub())
 		Expect(err).To(HaveOccurred())
 	})
 
@@ -91,7 +110,7 @@ var _ = Describe("GPU HostDevice", func() {
 			RamFB:   "on",
 		}
 
-		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool)).
+		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool, newAddressPoolStub())).
 			To(Equal([]api.HostDevice{expectHostDevice0, expectHostDevice1}))
 	})
 	It("creates MDEV with display option turned off", func() {
@@ -121,7 +140,7 @@ var _ = Describe("GPU HostDevice", func() {
 			Model:  "vfio-pci",
 		}
 
-		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool)).
+		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool, newAddressPoolStub())).
 			To(Equal([]api.HostDevice{expectHostDevice1}))
 	})
 	It("creates MDEV with display ramFB option turned off", func() {
@@ -154,7 +173,7 @@ var _ = Describe("GPU HostDevice", func() {
 			Display: "on",
 		}
 
-		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool)).
+		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool, newAddressPoolStub())).
 			To(Equal([]api.HostDevice{expectHostDevice1}))
 	})
 	It("creates MDEV with enabled display and ramfb by default", func() {
@@ -183,7 +202,7 @@ var _ = Describe("GPU HostDevice", func() {
 			RamFB:   "on",
 		}
 
-		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool)).
+		Expect(gpu.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.GPUs, pciPool, mdevPool, newAddressPoolStub())).
 			To(Equal([]api.HostDevice{expectHostDevice1}))
 	})
 })
@@ -20,6 +20,9 @@
 package device
 
 import (
+	"fmt"
+	"strings"
+
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -38,3 +41,17 @@ func NewPciAddressField(address string) (*api.Address, error) {
 		Function: "0x" + dbsfFields[3],
 	}, nil
 }
+
+// FormatPciAddress returns the PCI address (domain:bus:slot.function) of a domain PCI Address spec
+func FormatPciAddress(address *api.Address) (string, error) {
+	pciAddress := fmt.Sprintf("%s:%s:%s.%s",
+		strings.TrimPrefix(address.Domain, "0x"),
+		strings.TrimPrefix(address.Bus, "0x"),
+		strings.TrimPrefix(address.Slot, "0x"),
+		strings.TrimPrefix(address.Function, "0x"),
+	)
+	if _, err := hwutil.ParsePciAddress(pciAddress); err != nil {
+		return "", err
+	}
+	return pciAddress, nil
+}
@@ -45,4 +45,24 @@ var _ = Describe("PCI Address", func() {
 		Expect(err).To(HaveOccurred())
 		Expect(address).To(BeNil())
 	})
+
+	It("is formatted from a domain PCI Address spec", func() {
+		Expect(device.FormatPciAddress(&api.Address{
+			Type:     api.AddressPCI,
+			Domain:   "0x0000",
+			Bus:      "0x81",
+			Slot:     "0x11",
+			Function: "0x1",
+		})).To(Equal("0000:81:11.1"))
+	})
+
+	DescribeTable("fails to format an incomplete domain PCI Address spec", func(address *api.Address) {
+		pciAddress, err := device.FormatPciAddress(address)
+		Expect(err).To(HaveOccurred())
+		Expect(pciAddress).To(BeEmpty())
+	},
+		Entry("without fields", &api.Address{}),
+		Entry("with a short domain", &api.Address{Domain: "0x0", Bus: "0x81", Slot: "0x11", Function: "0x1"}),
+		Entry("without function", &api.Address{Domain: "0x0000", Bus: "0x81", Slot: "0x11"}),
+	)
 })
@@ -33,6 +33,8 @@ const (
 	EFIVarsSecureBoot = "OVMF_VARS.secboot.fd"
 	EFICodeSEV        = "OVMF_CODE.cc.fd"
 	EFIVarsSEV        = EFIVars
+
+	BIOS = "bios.bin"
 )
 
 type EFIEnvironment struct {
@@ -112,6 +114,12 @@ func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
 	}
 }
 
+// DetectBIOS returns the path of the SeaBIOS binary in the given directory,
+// or an empty string if there is none.
+func DetectBIOS(path string) string {
+	return getEFIBinaryIfExists(path, BIOS)
+}
+
 func getEFIBinaryIfExists(path, binary string) string {
 	fullPath := filepath.Join(path, binary)
 	if _, err := os.Stat(fullPath); err == nil {
@@ -105,4 +105,14 @@ var _ = Describe("EFI environment detection", func() {
 		Expect(efiEnv.EFIVars(!secureBootEnabled, sevEnabled)).To(Equal(varsSEV))
 		Expect(efiEnv.EFIVars(!secureBootEnabled, !sevEnabled)).To(Equal(varsSEV)) // same as EFIVars
 	})
+
+	It("should detect the BIOS binary", func() {
+		biosPath := createEFIRoms(BIOS)
+		defer os.RemoveAll(biosPath)
+		Expect(DetectBIOS(biosPath)).To(Equal(filepath.Join(biosPath, BIOS)))
+
+		emptyPath := createEFIRoms()
+		defer os.RemoveAll(emptyPath)
+		Expect(DetectBIOS(emptyPath)).To(BeEmpty())
+	})
 })
@@ -56,17 +56,52 @@ func ConvertKubeVirtNUMACellToDomainDomainCell(cell []api.NUMACell) ([]libvirtxm
 			return nil, err
 		}
 		id := uint(v)
-		ret = append(ret, libvirtxml.DomainCell{
+		domainCell := libvirtxml.DomainCell{
 			ID:        &id,
 			CPUs:      c.CPUs,
 			Memory:    uint(c.Memory),
 			Unit:      c.Unit,
 			MemAccess: c.MemoryAccess,
-		})
+		}
+		if c.Distances != nil {
+			domainCell.Distances = &libvirtxml.DomainCellDistances{}
+			for _, sibling := range c.Distances.Siblings {
+				domainCell.Distances.Siblings = append(domainCell.Distances.Siblings, libvirtxml.DomainCellSibling{
+					ID:    uint(sibling.ID),
+					Value: uint(sibling.Value),
+				})
+			}
+		}
+		ret = append(ret, domainCell)
 	}
 	return ret, nil
 }
 
+func ConvertKubeVirtNUMAInterconnectsToDomainNUMAInterconnects(interconnects *api.NUMAInterconnects) *libvirtxml.DomainNUMAInterconnects {
+	if interconnects == nil {
+		return nil
+	}
+	ret := &libvirtxml.DomainNUMAInterconnects{}
+	for _, latency := range interconnects.Latencies {
+		ret.Latencies = append(ret.Latencies, libvirtxml.DomainNUMAInterconnectLatency{
+			Initiator: uint(latency.Initiator),
+			Target:    uint(latency.Target),
+			Type:      latency.Type,
+			Value:     uint(latency.Value),
+		})
+	}
+	for _, bandwidth := range interconnects.Bandwidths {
+		ret.Bandwidths = append(ret.Bandwidths, libvirtxml.DomainNUMAInterconnectBandwidth{
+			Initiator: uint(bandwidth.Initiator),
+			Target:    uint(bandwidth.Target),
+			Type:      bandwidth.Type,
+			Value:     uint(bandwidth.Value),
+			Unit:      bandwidth.Unit,
+		})
+	}
+	return ret
+}
+
 func ConvertKubeVirtNUMAToDomainNUMA(numa *api.NUMA) (*libvirtxml.DomainNuma, error) {
 	if numa == nil {
 		return nil, nil
@@ -76,7 +111,8 @@ func ConvertKubeVirtNUMAToDomainNUMA(numa *api.NUMA) (*libvirtxml.DomainNuma, er
 		return nil, err
 	}
 	return &libvirtxml.DomainNuma{
-		Cell: cell,
+		Cell:          cell,
+		Interconnects: ConvertKubeVirtNUMAInterconnectsToDomainNUMAInterconnects(numa.Interconnects),
 	}, nil
 }
 
@@ -214,6 +214,21 @@ var _ = Describe("Convert KubeVirt domain types to Libvirtxml", func() {
 			Entry("empty", nil, nil),
 			Entry("with some values", &api.NUMA{Cells: []api.NUMACell{cell}},
 				&libvirtxml.DomainNuma{Cell: []libvirtxml.DomainCell{dcell}}),
+			Entry("with distances and interconnects",
+				&api.NUMA{
+					Cells: []api.NUMACell{{ID: "0", Distances: &api.NUMACellDistances{Siblings: []api.NUMACellSibling{{ID: 0, Value: 10}}}}},
+					Interconnects: &api.NUMAInterconnects{
+						Latencies:  []api.NUMAInterconnectLatency{{Initiator: 0, Target: 0, Type: "access", Value: 80}},
+						Bandwidths: []api.NUMAInterconnectBandwidth{{Initiator: 0, Target: 0, Type: "access", Value: 204800, Unit: "KiB"}},
+					},
+				},
+				&libvirtxml.DomainNuma{
+					Cell: []libvirtxml.DomainCell{{ID: new(uint), Distances: &libvirtxml.DomainCellDistances{Siblings: []libvirtxml.DomainCellSibling{{ID: 0, Value: 10}}}}},
+					Interconnects: &libvirtxml.DomainNUMAInterconnects{
+						Latencies:  []libvirtxml.DomainNUMAInterconnectLatency{{Initiator: 0, Target: 0, Type: "access", Value: 80}},
+						Bandwidths: []libvirtxml.DomainNUMAInterconnectBandwidth{{Initiator: 0, Target: 0, Type: "access", Value: 204800, Unit: "KiB"}},
+					},
+				}),
 		)
 
 	})
@@ -73,6 +73,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/emptydisk"
 	ephemeraldisk "kubevirt.io/kubevirt/pkg/ephemeral-disk"
+	ephemeraldiskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/ignition"
@@ -168,6 +169,7 @@ type LibvirtDomainManager struct {
 	efiEnvironment           *efi.EFIEnvironment
 	ovmfPath                 string
 	ephemeralDiskCreator     ephemeraldisk.EphemeralDiskCreatorInterface
+	ephemeralStorageKey      *ephemeraldiskutils.EncryptionKey
 	directIOChecker          converter.DirectIOChecker
 	disksInfo                map[string]*cmdv1.DiskInfo
 	cancelSafetyUnfreezeChan chan struct{}
@@ -781,6 +783,16 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing the pod network failed: %v", err)
 	}
 
+	emptyDiskCreator := emptydisk.NewEmptyDiskCreator()
+	if ephemeraldiskutils.IsEncryptionEnabled(vmi) {
+		key, err := l.getEphemeralStorageKey(vmi)
+		if err != nil {
+			return domain, fmt.Errorf("preparing the ephemeral storage encryption key failed: %v", err)
+		}
+		l.ephemeralDiskCreator.SetEncryptionKey(key)
+		emptyDiskCreator.SetEncryptionKey(key)
+	}
+
 	// Create ephemeral disk for container disks
 	err = containerdisk.CreateEphemeralImages(vmi, l.ephemeralDiskCreator, disksInfo)
 	if err != nil {
@@ -792,7 +804,7 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("preparing ephemeral images failed: %v", err)
 	}
 	// create empty disks if they exist
-	if err := emptydisk.NewEmptyDiskCreator().CreateTemporaryDisks(vmi); err != nil {
+	if err := emptyDiskCreator.CreateTemporaryDisks(vmi); err != nil {
 		return domain, fmt.Errorf("creating empty disks failed: %v", err)
 	}
 	// create ConfigMap disks if they exists
@@ -926,6 +938,34 @@ func shouldExpandOffline(disk api.Disk) bool {
 	return true
 }
 
+// getEFIEnvironment returns the EFI binaries of the firmware image the VMI is pinned to,
+// or the ones shipped with virt-launcher.
+func (l *LibvirtDomainManager) getEFIEnvironment(vmi *v1.VirtualMachineInstance) *efi.EFIEnvironment {
+	if kutil.HasFirmwareImage(vmi) {
+		return efi.DetectEFIEnvironment(runtime.GOARCH, containerdisk.GetFirmwareDirFromLauncherView())
+	}
+	return l.efiEnvironment
+}
+
+// getEphemeralStorageKey returns the key encrypting the ephemeral images of the VMI. It is generated on first use
+// and stored in an ephemeral libvirt secret, so that it never leaves the memory of virt-launcher and libvirt.
+func (l *LibvirtDomainManager) getEphemeralStorageKey(vmi *v1.VirtualMachineInstance) (*ephemeraldiskutils.EncryptionKey, error) {
+	if l.ephemeralStorageKey != nil {
+		return l.ephemeralStorageKey, nil
+	}
+
+	key, err := ephemeraldiskutils.NewEncryptionKey(ephemeraldiskutils.EncryptionSecretUUID(vmi))
+	if err != nil {
+		return nil, err
+	}
+	if err := l.virConn.DefineEphemeralSecret(key.SecretUUID, key.Value()); err != nil {
+		return nil, fmt.Errorf("failed to define the libvirt secret of the key: %v", err)
+	}
+	l.ephemeralStorageKey = key
+
+	return key, nil
+}
+
 func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineInstance, allowEmulation bool, options *cmdv1.VirtualMachineOptions, isMigrationTarget bool) (*converter.ConverterContext, error) {
 
 	logger := log.Log.Object(vmi)
@@ -969,23 +1009,30 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		}
 	}
 
+	efiEnvironment := l.getEFIEnvironment(vmi)
+
 	var efiConf *converter.EFIConfiguration
 	if vmi.IsBootloaderEFI() {
 		secureBoot := vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot == nil || *vmi.Spec.Domain.Firmware.Bootloader.EFI.SecureBoot
 		sev := kutil.IsSEVVMI(vmi)
 
-		if !l.efiEnvironment.Bootable(secureBoot, sev) {
+		if !efiEnvironment.Bootable(secureBoot, sev) {
 			log.Log.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV=%v", secureBoot, sev)
 			return nil, fmt.Errorf("EFI OVMF roms missing for booting in EFI mode with SecureBoot=%v, SEV=%v", secureBoot, sev)
 		}
 
 		efiConf = &converter.EFIConfiguration{
-			EFICode:      l.efiEnvironment.EFICode(secureBoot, sev),
-			EFIVars:      l.efiEnvironment.EFIVars(secureBoot, sev),
+			EFICode:      efiEnvironment.EFICode(secureBoot, sev),
+			EFIVars:      efiEnvironment.EFIVars(secureBoot, sev),
 			SecureLoader: secureBoot,
 		}
 	}
 
+	var biosLoader string
+	if kutil.HasFirmwareImage(vmi) && !vmi.IsBootloaderEFI() {
+		biosLoader = efi.DetectBIOS(containerdisk.GetFirmwareDirFromLauncherView())
+	}
+
 	// Map the VirtualMachineInstance to the Domain
 	c := &converter.ConverterContext{
 		Architecture:          runtime.GOARCH,
@@ -995,6 +1042,7 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		IsBlockPVC:            isBlockPVCMap,
 		IsBlockDV:             isBlockDVMap,
 		EFIConfiguration:      efiConf,
+		BIOSLoader:            biosLoader,
 		UseVirtioTransitional: vmi.Spec.Domain.Devices.UseVirtioTransitional != nil && *vmi.Spec.Domain.Devices.UseVirtioTransitional,
 		PermanentVolumes:      permanentVolumes,
 		EphemeraldiskCreator:  l.ephemeralDiskCreator,
@@ -1003,6 +1051,10 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		SerialConsoleLog:      isSerialConsoleLogEnabled(false, vmi),
 	}
 
+	if ephemeraldiskutils.IsEncryptionEnabled(vmi) {
+		c.EphemeralStorageSecretUUID = ephemeraldiskutils.EncryptionSecretUUID(vmi)
+	}
+
 	if options != nil {
 		c.ExpandDisksEnabled = options.ExpandDisksEnabled
 		if options.VirtualMachineSMBios != nil {
@@ -1039,13 +1091,19 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 		c.HotplugVolumes = hotplugVolumes
 		c.SRIOVDevices = sriovDevices
 
-		genericHostDevices, err := generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices)
+		// virt-controller records the devices allocated through resource claims in the VMI status
+		deviceStatus := vmi.Status.DeviceStatus
+		if deviceStatus == nil {
+			deviceStatus = &v1.DeviceStatus{}
+		}
+
+		genericHostDevices, err := generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices, deviceStatus.HostDeviceStatuses)
 		if err != nil {
 			return nil, err
 		}
 		c.GenericHostDevices = genericHostDevices
 
-		gpuHostDevices, err := gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs)
+		gpuHostDevices, err := gpu.CreateHostDevices(vmi.Spec.Domain.Devices.GPUs, deviceStatus.GPUStatuses)
 		if err != nil {
 			return nil, err
 		}
@@ -1798,18 +1856,36 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 	}
 
 	if domState == libvirt.DOMAIN_RUNNING || domState == libvirt.DOMAIN_PAUSED {
-		err = dom.ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN)
+		now := metav1.Now()
+		gracePeriod, _ := l.metadataCache.GracePeriod.Load()
+		method, shouldSignal := nextShutdownMethod(vmi, gracePeriod, now.Time)
+		if !shouldSignal {
+			log.Log.Object(vmi).V(4).Infof("Shutdown policy does not allow to signal %s again yet", vmi.GetObjectMeta().GetName())
+			return nil
+		}
+
+		flags := libvirt.DOMAIN_SHUTDOWN_ACPI_POWER_BTN
+		if method == v1.VirtualMachineInstanceReasonGuestAgentShutdown {
+			flags = libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT
+		}
+		err = dom.ShutdownFlags(flags)
 		if err != nil {
-			log.Log.Object(vmi).Reason(err).Error("Signalling graceful shutdown failed.")
+			log.Log.Object(vmi).Reason(err).Errorf("Signalling graceful shutdown via %s failed.", method)
 			return err
 		}
-		log.Log.Object(vmi).Infof("Signaled graceful shutdown for %s", vmi.GetObjectMeta().GetName())
+		log.Log.Object(vmi).Infof("Signaled graceful shutdown via %s for %s", method, vmi.GetObjectMeta().GetName())
 
 		l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
 			if gracePeriodMetadata.DeletionTimestamp == nil {
-				now := metav1.Now()
 				gracePeriodMetadata.DeletionTimestamp = &now
 			}
+			if method == v1.VirtualMachineInstanceReasonACPIShutdown {
+				gracePeriodMetadata.ACPIAttempts++
+			} else {
+				gracePeriodMetadata.GuestAgentAttempted = true
+			}
+			gracePeriodMetadata.ShutdownMethod = method
+			gracePeriodMetadata.LastShutdownSignal = &now
 		})
 		log.Log.V(4).Infof("Graceful period set in metadata: %s", l.metadataCache.GracePeriod.String())
 	}
@@ -1817,6 +1893,32 @@ func (l *LibvirtDomainManager) SignalShutdownVMI(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+// nextShutdownMethod determines, based on the shutdown policy of the VMI and the
+// signals which were already sent, if and how the guest should be signalled next.
+func nextShutdownMethod(vmi *v1.VirtualMachineInstance, gracePeriod api.GracePeriodMetadata, now time.Time) (string, bool) {
+	policy := vmi.Spec.ShutdownPolicy
+	if policy == nil {
+		return v1.VirtualMachineInstanceReasonACPIShutdown, true
+	}
+
+	interval := time.Duration(kutil.ShutdownSignalIntervalSeconds(vmi)) * time.Second
+	if gracePeriod.LastShutdownSignal != nil && now.Sub(gracePeriod.LastShutdownSignal.Time) < interval {
+		return "", false
+	}
+
+	if policy.ACPIRetries == nil || gracePeriod.ACPIAttempts <= *policy.ACPIRetries {
+		return v1.VirtualMachineInstanceReasonACPIShutdown, true
+	}
+
+	if policy.GuestAgentFallback && !gracePeriod.GuestAgentAttempted &&
+		controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return v1.VirtualMachineInstanceReasonGuestAgentShutdown, true
+	}
+
+	// All graceful methods are exhausted, wait for the guest to be forcefully powered off
+	return "", false
+}
+
 func (l *LibvirtDomainManager) KillVMI(vmi *v1.VirtualMachineInstance) error {
 	domName := api.VMINamespaceKeyFunc(vmi)
 	dom, err := l.virConn.LookupDomainByName(domName)
@@ -1841,6 +1943,9 @@ func (l *LibvirtDomainManager) KillVMI(vmi *v1.VirtualMachineInstance) error {
 	}
 
 	if domState == libvirt.DOMAIN_RUNNING || domState == libvirt.DOMAIN_PAUSED || domState == libvirt.DOMAIN_SHUTDOWN {
+		l.metadataCache.GracePeriod.WithSafeBlock(func(gracePeriodMetadata *api.GracePeriodMetadata, _ bool) {
+			gracePeriodMetadata.ShutdownMethod = v1.VirtualMachineInstanceReasonForceOffShutdown
+		})
 		err = dom.DestroyFlags(libvirt.DOMAIN_DESTROY_GRACEFUL)
 		if err != nil {
 			if domainerrors.IsNotFound(err) {
@@ -2225,7 +2330,7 @@ func (l *LibvirtDomainManager) GetLaunchMeasurement(vmi *v1.VirtualMachineInstan
 		sevMeasurementInfo.Policy = domainLaunchSecurityParameters.SEVPolicy
 	}
 
-	loader := l.efiEnvironment.EFICode(false, true) // no secureBoot, with sev
+	loader := l.getEFIEnvironment(vmi).EFICode(false, true) // no secureBoot, with sev
 	f, err := os.Open(loader)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Error opening loader binary %s", loader)
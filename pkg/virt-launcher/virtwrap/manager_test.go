@@ -1483,7 +1483,83 @@ var _ = Describe("Manager", func() {
 
 			gracePeriod, _ := metadataCache.GracePeriod.Load()
 			Expect(gracePeriod.DeletionTimestamp).NotTo(BeNil())
+			Expect(gracePeriod.ShutdownMethod).To(Equal(v1.VirtualMachineInstanceReasonACPIShutdown))
+			Expect(gracePeriod.ACPIAttempts).To(Equal(uint32(1)))
 		})
+
+		It("Should fall back to the guest agent once the ACPI retries are exhausted", func() {
+			mockDomain.EXPECT().GetState().AnyTimes().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockConn.EXPECT().LookupDomainByName(testDomainName).AnyTimes().DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().ShutdownFlags(libvirt.DOMAIN_SHUTDOWN_GUEST_AGENT).Return(nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			metadataCache.GracePeriod.Store(api.GracePeriodMetadata{ACPIAttempts: 2})
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.ShutdownPolicy = &v1.ShutdownPolicy{ACPIRetries: virtpointer.P(uint32(1)), GuestAgentFallback: true}
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+			}
+			Expect(manager.SignalShutdownVMI(vmi)).To(Succeed())
+
+			gracePeriod, _ := metadataCache.GracePeriod.Load()
+			Expect(gracePeriod.ShutdownMethod).To(Equal(v1.VirtualMachineInstanceReasonGuestAgentShutdown))
+			Expect(gracePeriod.GuestAgentAttempted).To(BeTrue())
+		})
+
+		It("Should record a force off when killing the domain", func() {
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().DestroyFlags(libvirt.DOMAIN_DESTROY_GRACEFUL).Return(nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+			Expect(manager.KillVMI(newVMI(testNamespace, testVmName))).To(Succeed())
+
+			gracePeriod, _ := metadataCache.GracePeriod.Load()
+			Expect(gracePeriod.ShutdownMethod).To(Equal(v1.VirtualMachineInstanceReasonForceOffShutdown))
+		})
+
+		DescribeTable("nextShutdownMethod", func(policy *v1.ShutdownPolicy, agentConnected bool, gracePeriod api.GracePeriodMetadata, sinceLastSignal time.Duration, expectedMethod string, expectedSignal bool) {
+			now := time.Now()
+			if sinceLastSignal > 0 {
+				gracePeriod.LastShutdownSignal = &metav1.Time{Time: now.Add(-sinceLastSignal)}
+			}
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.ShutdownPolicy = policy
+			if agentConnected {
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+					{Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue},
+				}
+			}
+
+			method, signal := nextShutdownMethod(vmi, gracePeriod, now)
+			Expect(signal).To(Equal(expectedSignal))
+			Expect(method).To(Equal(expectedMethod))
+		},
+			Entry("should always use ACPI without a policy", nil, false,
+				api.GracePeriodMetadata{ACPIAttempts: 10}, time.Second,
+				v1.VirtualMachineInstanceReasonACPIShutdown, true),
+			Entry("should not resend before the interval passed",
+				&v1.ShutdownPolicy{ACPIRetryIntervalSeconds: virtpointer.P(uint32(10))}, false,
+				api.GracePeriodMetadata{ACPIAttempts: 1}, 5*time.Second,
+				"", false),
+			Entry("should resend ACPI after the interval passed",
+				&v1.ShutdownPolicy{ACPIRetryIntervalSeconds: virtpointer.P(uint32(10))}, false,
+				api.GracePeriodMetadata{ACPIAttempts: 1}, 11*time.Second,
+				v1.VirtualMachineInstanceReasonACPIShutdown, true),
+			Entry("should stop signalling once the retries are exhausted",
+				&v1.ShutdownPolicy{ACPIRetries: virtpointer.P(uint32(2))}, true,
+				api.GracePeriodMetadata{ACPIAttempts: 3}, time.Duration(0),
+				"", false),
+			Entry("should not fall back to the guest agent if it is not connected",
+				&v1.ShutdownPolicy{ACPIRetries: virtpointer.P(uint32(0)), GuestAgentFallback: true}, false,
+				api.GracePeriodMetadata{ACPIAttempts: 1}, time.Duration(0),
+				"", false),
+			Entry("should fall back to the guest agent only once",
+				&v1.ShutdownPolicy{ACPIRetries: virtpointer.P(uint32(0)), GuestAgentFallback: true}, true,
+				api.GracePeriodMetadata{ACPIAttempts: 1, GuestAgentAttempted: true}, time.Duration(0),
+				"", false),
+		)
 	})
 	Context("test migration monitor", func() {
 		It("migration should be canceled if it's not progressing", func() {
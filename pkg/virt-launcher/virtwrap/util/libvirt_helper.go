@@ -41,6 +41,7 @@ const (
 	libvirtRuntimePath  = "/var/run/libvirt"
 	libvirtHomePath     = "/var/run/kubevirt-private/libvirt"
 	qemuNonRootConfPath = libvirtHomePath + "/qemu.conf"
+	virtsecretdPath     = "/usr/sbin/virtsecretd"
 )
 
 var LifeCycleTranslationMap = map[libvirt.DomainState]api.LifeCycle{
@@ -389,6 +390,45 @@ func StartVirtlog(stopChan chan struct{}, domainName string, nonRoot bool) {
 	go startQEMUSeaBiosLogging(stopChan)
 }
 
+// StartVirtsecretd spawns virtsecretd, which holds the libvirt secrets of the domain, if it is installed.
+// Secrets are kept in memory only, they are lost if virtsecretd is restarted.
+func StartVirtsecretd(stopChan chan struct{}) {
+	if _, err := os.Stat(virtsecretdPath); err != nil {
+		log.Log.Infof("%s is not available, libvirt secrets are not supported", virtsecretdPath)
+		return
+	}
+
+	go func() {
+		for {
+			exitChan := make(chan struct{})
+			cmd := exec.Command(virtsecretdPath)
+
+			err := cmd.Start()
+			if err != nil {
+				log.Log.Reason(err).Error("failed to start virtsecretd")
+				panic(err)
+			}
+
+			go func() {
+				defer close(exitChan)
+				cmd.Wait()
+			}()
+
+			select {
+			case <-stopChan:
+				cmd.Process.Kill()
+				return
+			case <-exitChan:
+				log.Log.Errorf("virtsecretd exited, restarting")
+			}
+
+			// this sleep is to avoid consuming all resources in the
+			// event of a virtsecretd crash loop.
+			time.Sleep(time.Second)
+		}
+	}()
+}
+
 // returns the namespace and name that is encoded in the
 // domain name.
 func SplitVMINamespaceKey(domainName string) (namespace, name string) {
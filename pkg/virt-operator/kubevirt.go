@@ -43,6 +43,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/util/status"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
 	install "kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
@@ -962,6 +963,9 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 	// Set the default architecture
 	config.SetDefaultArchitecture(kv)
 
+	// Record the FIPS profile enforced by the components for compliance audits
+	kv.Status.FIPS = kvtls.FIPSStatus(kv)
+
 	if kv.Status.Phase == "" {
 		kv.Status.Phase = v1.KubeVirtPhaseDeploying
 	}
@@ -49,6 +49,9 @@ const (
 	kubevirtLabelKey = "kubevirt.io"
 
 	portName = "--port"
+
+	// the 5s pause before the shutdown, the maximum stream drain and the 5s server shutdown, plus slack
+	virtApiGracePeriodSeconds = 330
 )
 
 func NewPrometheusService(namespace string) *corev1.Service {
@@ -329,6 +332,8 @@ func NewApiServerDeployment(namespace, repository, imagePrefix, version, product
 		RunAsNonRoot:   pointer.Bool(true),
 		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
 	}
+	// leave room for draining the open streams for up to the maximum streamDrainSeconds
+	pod.TerminationGracePeriodSeconds = pointer.Int64(virtApiGracePeriodSeconds)
 
 	container := &deployment.Spec.Template.Spec.Containers[0]
 	container.Command = []string{
@@ -894,6 +894,52 @@ var CRDsValidation map[string]string = map[string]string{
               - type: string
               pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
               x-kubernetes-int-or-string: true
+            crashLoopBackOff:
+              description: CrashLoopBackOff configures how VirtualMachines whose guests
+                keep failing are restarted
+              properties:
+                maxDelaySeconds:
+                  description: |-
+                    MaxDelaySeconds is the upper bound of the exponential delay between two consecutive
+                    restarts of a failing VirtualMachine.
+                    Defaults to 300
+                  type: integer
+                minRunDurationSeconds:
+                  description: |-
+                    MinRunDurationSeconds is the time a VMI has to stay in the Running phase before
+                    it is considered to be started successfully. VMIs which terminate earlier are
+                    counted as start failures.
+                    Defaults to 0, which only counts VMIs never reaching the Running phase as start failures.
+                  format: int64
+                  type: integer
+                restartLimits:
+                  description: |-
+                    RestartLimits caps the number of consecutive start failures per RunStrategy.
+                    Once the limit is reached the VirtualMachine is not restarted anymore until
+                    a new start, stop or restart request is issued.
+                  items:
+                    description: |-
+                      RestartLimit defines the maximum number of consecutive start failures
+                      tolerated for VirtualMachines using the given RunStrategy.
+                    properties:
+                      maxConsecutiveFailures:
+                        description: |-
+                          MaxConsecutiveFailures is the number of consecutive start failures after which
+                          the VirtualMachine is no longer restarted.
+                        type: integer
+                      runStrategy:
+                        description: RunStrategy the limit applies to. Only Always
+                          and RerunOnFailure are supported.
+                        type: string
+                    required:
+                    - maxConsecutiveFailures
+                    - runStrategy
+                    type: object
+                  type: array
+                  x-kubernetes-list-map-keys:
+                  - runStrategy
+                  x-kubernetes-list-type: map
+              type: object
             defaultRuntimeClass:
               type: string
             developerConfiguration:
@@ -998,6 +1044,88 @@ var CRDsValidation map[string]string = map[string]string{
                 migrated instead of shut-off in case of a node drain. If the VirtualMachineInstance specific
                 field is set it overrides the cluster level one.
               type: string
+            exportOIDC:
+              description: |-
+                ExportOIDC lets the VirtualMachineExport servers accept OIDC ID tokens as bearer tokens,
+                in addition to the generated export token.
+              properties:
+                clientID:
+                  description: ClientID is the audience the ID tokens have to be issued
+                    for.
+                  type: string
+                groupBindings:
+                  description: GroupBindings limit the accepted ID tokens to the members
+                    of the groups bound to the namespace of the export.
+                  items:
+                    description: ExportOIDCGroupBinding binds an OIDC group to the
+                      namespaces whose VirtualMachineExports its members can download.
+                    properties:
+                      group:
+                        description: Group is the name of the group in the groups
+                          claim.
+                        type: string
+                      namespaces:
+                        description: Namespaces are the namespaces whose VirtualMachineExports
+                          the members of the group can download.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: set
+                    required:
+                    - group
+                    - namespaces
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                groupsClaim:
+                  description: GroupsClaim is the name of the claim holding the groups
+                    of the user. Defaults to groups
+                  type: string
+                issuerURL:
+                  description: IssuerURL is the https URL of the issuer, it has to
+                    serve the OIDC discovery document.
+                  type: string
+              required:
+              - clientID
+              - groupBindings
+              - issuerURL
+              type: object
+            firmwareImages:
+              description: |-
+                FirmwareImages is the set of firmware images VMIs can select with
+                spec.domain.firmware.imageName instead of using the firmware shipped with virt-launcher.
+              items:
+                description: |-
+                  FirmwareImage describes a container image providing OVMF and SeaBIOS binaries.
+                  The binaries are expected to use the file names of the virt-launcher image
+                  (e.g. OVMF_CODE.fd, OVMF_VARS.fd, OVMF_CODE.secboot.fd, bios.bin).
+                properties:
+                  image:
+                    description: Image is the container image containing the firmware
+                      binaries
+                    type: string
+                  imagePullPolicy:
+                    description: |-
+                      Image pull policy.
+                      One of Always, Never, IfNotPresent.
+                      Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                    type: string
+                  name:
+                    description: Name is used by VMIs to reference the firmware image
+                    type: string
+                  path:
+                    description: |-
+                      Path is the absolute directory inside the image containing the firmware binaries.
+                      Defaults to /usr/share/OVMF
+                    type: string
+                required:
+                - image
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             handlerConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1034,6 +1162,39 @@ var CRDsValidation map[string]string = map[string]string{
               description: PullPolicy describes a policy for if/when to pull a container
                 image
               type: string
+            instancetypeUpdateStrategy:
+              description: |-
+                InstancetypeUpdateStrategy defines if and how running VirtualMachines are moved onto
+                new revisions of the instancetypes and preferences they reference.
+                When unset, VirtualMachines stay on the revisions captured when they were first started.
+              properties:
+                maxUnavailable:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    MaxUnavailable is the maximum number of running VirtualMachines which are updated at the same time.
+                    Value can be an absolute number (ex: 5) or a percentage of the running VirtualMachines
+                    referencing an outdated revision (ex: 10%). Absolute number is calculated from percentage
+                    by rounding down, with a minimum of 1.
+
+
+                    Defaults to 1
+                  x-kubernetes-int-or-string: true
+                methods:
+                  description: |-
+                    Methods defines the methods that can be used to apply a new revision to running VirtualMachines.
+                    When both LiveMigrate and Restart are listed, only VirtualMachines whose changes can't be
+                    applied live are restarted. Once a method is listed, VirtualMachines that are not running are
+                    moved onto the new revision as well.
+
+
+                    An empty list keeps VirtualMachines on their captured revisions.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
             ksmConfiguration:
               description: KSMConfiguration holds the information regarding the enabling
                 the KSM in the nodes (if available).
@@ -1410,6 +1571,27 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            securityProfiles:
+              description: |-
+                SecurityProfiles lists the seccomp profiles and SELinux types VirtualMachineInstances
+                may select for their virt-launcher compute container.
+              properties:
+                allowedSELinuxTypes:
+                  description: AllowedSELinuxTypes are the SELinux types which can
+                    be selected.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+                allowedSeccompLocalhostProfiles:
+                  description: |-
+                    AllowedSeccompLocalhostProfiles are the localhost seccomp profiles which can be selected,
+                    as paths relative to the seccomp profile root of the kubelet. The profiles have to exist on the nodes.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             selinuxLauncherType:
               type: string
             smbios:
@@ -1425,6 +1607,56 @@ var CRDsValidation map[string]string = map[string]string{
                 version:
                   type: string
               type: object
+            streamDrainSeconds:
+              description: |-
+                StreamDrainSeconds is how long virt-api and virt-handler keep serving the open console, VNC,
+                USB redirection, VSOCK and port-forward streams while they shut down, e.g. during an update.
+                New streams are refused meanwhile, so that clients reconnect to another instance.
+                Defaults to 0, which closes the open streams right away. The maximum is 300.
+              format: int64
+              type: integer
+            subresourceRateLimits:
+              description: |-
+                SubresourceRateLimits limits how often a single user, and all users of a namespace together,
+                may call the console, vnc, expand-spec and memorydump subresources.
+              properties:
+                perNamespace:
+                  description: PerNamespace limits the requests of all users within
+                    a namespace together.
+                  properties:
+                    burst:
+                      description: |-
+                        Maximum burst for throttle.
+                        If it's zero, the component default will be used
+                      type: integer
+                    qps:
+                      description: |-
+                        QPS indicates the maximum QPS to the apiserver from this client.
+                        If it's zero, the component default will be used
+                      type: number
+                  required:
+                  - burst
+                  - qps
+                  type: object
+                perUser:
+                  description: PerUser limits the requests of every user across all
+                    namespaces.
+                  properties:
+                    burst:
+                      description: |-
+                        Maximum burst for throttle.
+                        If it's zero, the component default will be used
+                      type: integer
+                    qps:
+                      description: |-
+                        QPS indicates the maximum QPS to the apiserver from this client.
+                        If it's zero, the component default will be used
+                      type: number
+                  required:
+                  - burst
+                  - qps
+                  type: object
+              type: object
             supportContainerResources:
               description: SupportContainerResources specifies the resource requirements
                 for various types of supporting containers such as container disks/virtiofs/sidecars
@@ -1515,6 +1747,12 @@ var CRDsValidation map[string]string = map[string]string{
                     type: string
                   type: array
                   x-kubernetes-list-type: set
+                fipsMode:
+                  description: |-
+                    FIPSMode restricts the TLS stacks of the KubeVirt components to the FIPS 140 approved
+                    protocol versions, cipher suites and key exchange curves.
+                    MinTLSVersion and Ciphers have to be FIPS compliant when it is enabled.
+                  type: boolean
                 minTLSVersion:
                   description: |-
                     MinTLSVersion is a way to specify the minimum protocol version that is acceptable for TLS connections.
@@ -1533,6 +1771,72 @@ var CRDsValidation map[string]string = map[string]string{
                   - VersionTLS13
                   type: string
               type: object
+            toolsDisk:
+              description: |-
+                ToolsDisk attaches a read-only disk, provided by a containerDisk image, to every VMI
+                created in the selected namespaces, e.g. to ship monitoring agents or guest drivers.
+                Changes only affect VMIs created afterwards.
+              properties:
+                image:
+                  description: Image is the containerDisk image providing the disk
+                  type: string
+                imagePullPolicy:
+                  description: |-
+                    Image pull policy.
+                    One of Always, Never, IfNotPresent.
+                    Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+                  type: string
+                namespaceLabelSelector:
+                  description: |-
+                    NamespaceLabelSelector selects the namespaces whose VMIs get the disk attached.
+                    When unset, the disk is attached to the VMIs of all namespaces.
+                  properties:
+                    matchExpressions:
+                      description: matchExpressions is a list of label selector requirements.
+                        The requirements are ANDed.
+                      items:
+                        description: |-
+                          A label selector requirement is a selector that contains values, a key, and an operator that
+                          relates the key and values.
+                        properties:
+                          key:
+                            description: key is the label key that the selector applies
+                              to.
+                            type: string
+                          operator:
+                            description: |-
+                              operator represents a key's relationship to a set of values.
+                              Valid operators are In, NotIn, Exists and DoesNotExist.
+                            type: string
+                          values:
+                            description: |-
+                              values is an array of string values. If the operator is In or NotIn,
+                              the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                              the values array must be empty. This array is replaced during a strategic
+                              merge patch.
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                        required:
+                        - key
+                        - operator
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    matchLabels:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                        map is equivalent to an element of matchExpressions, whose key field is "key", the
+                        operator is "In", and the values array contains only "value". The requirements are ANDed.
+                      type: object
+                  type: object
+                  x-kubernetes-map-type: atomic
+              required:
+              - image
+              type: object
             virtualMachineInstancesPerNode:
               type: integer
             virtualMachineOptions:
@@ -3775,6 +4079,34 @@ var CRDsValidation map[string]string = map[string]string{
           type: array
         defaultArchitecture:
           type: string
+        fips:
+          description: FIPS reports the FIPS profile the KubeVirt components enforce
+            on their TLS connections
+          properties:
+            ciphers:
+              description: Ciphers are the TLS 1.2 cipher suites accepted by the KubeVirt
+                components
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            enabled:
+              description: Enabled reports whether the TLS stacks are restricted to
+                the FIPS 140 approved settings
+              type: boolean
+            minTLSVersion:
+              description: MinTLSVersion is the minimum TLS version accepted by the
+                KubeVirt components
+              type: string
+            validatedModule:
+              description: ValidatedModule reports whether the cryptography of the
+                KubeVirt components is provided by a FIPS 140 validated module, they
+                are only FIPS compliant if it is true as well as Enabled
+              type: boolean
+          required:
+          - enabled
+          - validatedModule
+          type: object
         generations:
           items:
             description: GenerationStatus keeps track of the generation for a given
@@ -6175,7 +6507,24 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a GPU device to the vmi.
                           items:
                             properties:
+                              claim:
+                                description: |-
+                                  Claim references the ResourceClaim of the VMI the GPU is allocated from
+                                  through Dynamic Resource Allocation.
+                                  Either DeviceName or Claim has to be set.
+                                properties:
+                                  claimName:
+                                    description: ClaimName is the name of the entry
+                                      in spec.resourceClaims the device is allocated
+                                      from.
+                                    type: string
+                                required:
+                                - claimName
+                                type: object
                               deviceName:
+                                description: |-
+                                  DeviceName is the resource name of the GPU exposed by a device plugin.
+                                  Either DeviceName or Claim has to be set.
                                 type: string
                               name:
                                 description: Name of the GPU device as exposed by
@@ -6209,7 +6558,6 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                 type: object
                             required:
-                            - deviceName
                             - name
                             type: object
                           type: array
@@ -6218,9 +6566,24 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a host device to the vmi.
                           items:
                             properties:
+                              claim:
+                                description: |-
+                                  Claim references the ResourceClaim of the VMI the host device is allocated from
+                                  through Dynamic Resource Allocation.
+                                  Either DeviceName or Claim has to be set.
+                                properties:
+                                  claimName:
+                                    description: ClaimName is the name of the entry
+                                      in spec.resourceClaims the device is allocated
+                                      from.
+                                    type: string
+                                required:
+                                - claimName
+                                type: object
                               deviceName:
-                                description: DeviceName is the resource name of the
-                                  host device exposed by a device plugin
+                                description: |-
+                                  DeviceName is the resource name of the host device exposed by a device plugin.
+                                  Either DeviceName or Claim has to be set.
                                 type: string
                               name:
                                 type: string
@@ -6230,7 +6593,6 @@ var CRDsValidation map[string]string = map[string]string{
                                   via config drive
                                 type: string
                             required:
-                            - deviceName
                             - name
                             type: object
                           type: array
@@ -6466,6 +6828,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 Persistent indicates the state of the TPM device should be kept accross reboots
                                 Defaults to false
                               type: boolean
+                            version:
+                              description: |-
+                                Version of the emulated TPM, one of 1.2 or 2.0.
+                                TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                                Defaults to 2.0
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: |-
@@ -6782,6 +7150,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: boolean
                               type: object
                           type: object
+                        imageName:
+                          description: |-
+                            ImageName selects a firmware image from spec.configuration.firmwareImages of the
+                            KubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.
+                            The image is pinned when the VMI is created and kept across migrations.
+                          type: string
                         kernelBoot:
                           description: Settings to set the kernel for booting.
                           properties:
@@ -6821,6 +7195,45 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Arguments to be passed to the kernel at
                                 boot time
                               type: string
+                            kernelArgsFrom:
+                              description: |-
+                                KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.
+                                The arguments are read on every boot, so they can be changed without modifying the VMI.
+                                Mutually exclusive with kernelArgs.
+                              properties:
+                                key:
+                                  description: Key within the ConfigMap which holds
+                                    the kernel arguments
+                                  type: string
+                                volumeName:
+                                  description: VolumeName is the name of the ConfigMap
+                                    volume of the VMI
+                                  type: string
+                              required:
+                              - key
+                              - volumeName
+                              type: object
+                            volume:
+                              description: |-
+                                Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.
+                                Mutually exclusive with container.
+                              properties:
+                                initrdPath:
+                                  description: The fully-qualified path to the ramdisk
+                                    image inside the volume
+                                  type: string
+                                kernelPath:
+                                  description: The fully-qualified path to the kernel
+                                    image inside the volume
+                                  type: string
+                                name:
+                                  description: |-
+                                    Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI
+                                    which contains the kernel artifacts. The volume must not be used by a disk.
+                                  type: string
+                              required:
+                              - name
+                              type: object
                           type: object
                         serial:
                           description: The system-serial-number in SMBIOS
@@ -6943,6 +7356,17 @@ var CRDsValidation map[string]string = map[string]string{
                   required:
                   - devices
                   type: object
+                ephemeralStorage:
+                  description: EphemeralStorage configures the node-local storage backing
+                    containerDisk, ephemeral and emptyDisk volumes.
+                  properties:
+                    encrypted:
+                      description: |-
+                        Encrypted enables the encryption of the qcow2 overlays of containerDisk and ephemeral volumes and of emptyDisk
+                        volumes. The key is generated per VirtualMachineInstance, is only kept in memory and is discarded once the
+                        VirtualMachineInstance stops.
+                      type: boolean
+                  type: object
                 evictionStrategy:
                   description: |-
                     EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -7150,44 +7574,285 @@ var CRDsValidation map[string]string = map[string]string{
                     Selector which must match a node's labels for the vmi to be scheduled on that node.
                     More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                   type: object
-                priorityClassName:
-                  description: |-
-                    If specified, indicates the pod's priority.
-                    If not specified, the pod priority will be default or zero if there is no
-                    default.
-                  type: string
-                readinessProbe:
-                  description: |-
-                    Periodic probe of VirtualMachineInstance service readiness.
-                    VirtualmachineInstances will be removed from service endpoints if the probe fails.
-                    Cannot be updated.
-                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                podOverlay:
+                  description: PodOverlay adds a safelisted set of fields to the virt-launcher
+                    pod of the VirtualMachineInstance.
                   properties:
-                    exec:
+                    labels:
+                      additionalProperties:
+                        type: string
                       description: |-
-                        One and only one of the following should be specified.
-                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
-                        If the guest agent is not available, this probe will fail.
-                      properties:
-                        command:
-                          description: |-
-                            Command is the command line to execute inside the container, the working directory for the
-                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
-                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
-                            a shell, you need to explicitly call out to that shell.
-                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
-                          items:
-                            type: string
-                          type: array
-                          x-kubernetes-list-type: atomic
+                        Labels are added to the virt-launcher pod only, without being set on the VirtualMachineInstance.
+                        Labels in the kubevirt.io domain are reserved and not allowed.
                       type: object
-                    failureThreshold:
+                    runtimeClassName:
                       description: |-
-                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
-                        Defaults to 3. Minimum value is 1.
-                      format: int32
-                      type: integer
-                    guestAgentPing:
+                        RuntimeClassName sets the runtime class of the virt-launcher pod.
+                        It has to match the default runtime class of the cluster if one is configured.
+                      type: string
+                    tolerations:
+                      description: Tolerations are added to the tolerations of the
+                        VirtualMachineInstance.
+                      items:
+                        description: |-
+                          The pod this Toleration is attached to tolerates any taint that matches
+                          the triple <key,value,effect> using the matching operator <operator>.
+                        properties:
+                          effect:
+                            description: |-
+                              Effect indicates the taint effect to match. Empty means match all taint effects.
+                              When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                            type: string
+                          key:
+                            description: |-
+                              Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                              If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                            type: string
+                          operator:
+                            description: |-
+                              Operator represents a key's relationship to the value.
+                              Valid operators are Exists and Equal. Defaults to Equal.
+                              Exists is equivalent to wildcard for value, so that a pod can
+                              tolerate all taints of a particular category.
+                            type: string
+                          tolerationSeconds:
+                            description: |-
+                              TolerationSeconds represents the period of time the toleration (which must be
+                              of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                              it is not set, which means tolerate the taint forever (do not evict). Zero and
+                              negative values will be treated as 0 (evict immediately) by the system.
+                            format: int64
+                            type: integer
+                          value:
+                            description: |-
+                              Value is the taint value the toleration matches to.
+                              If the operator is Exists, the value should be empty, otherwise just a regular string.
+                            type: string
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    topologySpreadConstraints:
+                      description: |-
+                        TopologySpreadConstraints are merged into the topology spread constraints of the VirtualMachineInstance.
+                        A constraint with the same topologyKey and whenUnsatisfiable as one of the VirtualMachineInstance replaces it.
+                      items:
+                        description: TopologySpreadConstraint specifies how to spread
+                          matching pods among the given topology.
+                        properties:
+                          labelSelector:
+                            description: |-
+                              LabelSelector is used to find matching pods.
+                              Pods that match this label selector are counted to determine the number of pods
+                              in their corresponding topology domain.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select the pods over which
+                              spreading will be calculated. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are ANDed with labelSelector
+                              to select the group of existing pods over which spreading will be calculated
+                              for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                              MatchLabelKeys cannot be set when LabelSelector isn't set.
+                              Keys that don't exist in the incoming pod labels will
+                              be ignored. A null or empty list means only match against labelSelector.
+
+
+                              This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          maxSkew:
+                            description: |-
+                              MaxSkew describes the degree to which pods may be unevenly distributed.
+                              When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                              between the number of matching pods in the target topology and the global minimum.
+                              The global minimum is the minimum number of matching pods in an eligible domain
+                              or zero if the number of eligible domains is less than MinDomains.
+                              For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                              labelSelector spread as 2/2/1:
+                              In this case, the global minimum is 1.
+                              | zone1 | zone2 | zone3 |
+                              |  P P  |  P P  |   P   |
+                              - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                              scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                              violate MaxSkew(1).
+                              - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                              When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                              to topologies that satisfy it.
+                              It's a required field. Default value is 1 and 0 is not allowed.
+                            format: int32
+                            type: integer
+                          minDomains:
+                            description: |-
+                              MinDomains indicates a minimum number of eligible domains.
+                              When the number of eligible domains with matching topology keys is less than minDomains,
+                              Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                              And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                              this value has no effect on scheduling.
+                              As a result, when the number of eligible domains is less than minDomains,
+                              scheduler won't schedule more than maxSkew Pods to those domains.
+                              If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                              Valid values are integers greater than 0.
+                              When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+
+                              For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                              labelSelector spread as 2/2/2:
+                              | zone1 | zone2 | zone3 |
+                              |  P P  |  P P  |  P P  |
+                              The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                              In this situation, new pod with the same labelSelector cannot be scheduled,
+                              because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                              it will violate MaxSkew.
+                            format: int32
+                            type: integer
+                          nodeAffinityPolicy:
+                            description: |-
+                              NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                              when calculating pod topology spread skew. Options are:
+                              - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                              - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+
+                              If this value is nil, the behavior is equivalent to the Honor policy.
+                              This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                            type: string
+                          nodeTaintsPolicy:
+                            description: |-
+                              NodeTaintsPolicy indicates how we will treat node taints when calculating
+                              pod topology spread skew. Options are:
+                              - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                              has a toleration, are included.
+                              - Ignore: node taints are ignored. All nodes are included.
+
+
+                              If this value is nil, the behavior is equivalent to the Ignore policy.
+                              This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                            type: string
+                          topologyKey:
+                            description: |-
+                              TopologyKey is the key of node labels. Nodes that have a label with this key
+                              and identical values are considered to be in the same topology.
+                              We consider each <key, value> as a "bucket", and try to put balanced number
+                              of pods into each bucket.
+                              We define a domain as a particular instance of a topology.
+                              Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                              nodeAffinityPolicy and nodeTaintsPolicy.
+                              e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                              And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                              It's a required field.
+                            type: string
+                          whenUnsatisfiable:
+                            description: |-
+                              WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                              the spread constraint.
+                              - DoNotSchedule (default) tells the scheduler not to schedule it.
+                              - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                                but giving higher precedence to topologies that would help reduce the
+                                skew.
+                              A constraint is considered "Unsatisfiable" for an incoming pod
+                              if and only if every possible node assignment for that pod would violate
+                              "MaxSkew" on some topology.
+                              For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                              labelSelector spread as 3/1/1:
+                              | zone1 | zone2 | zone3 |
+                              | P P P |   P   |   P   |
+                              If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                              to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                              MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                              won't make it *more* imbalanced.
+                              It's a required field.
+                            type: string
+                        required:
+                        - maxSkew
+                        - topologyKey
+                        - whenUnsatisfiable
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
+                priorityClassName:
+                  description: |-
+                    If specified, indicates the pod's priority.
+                    If not specified, the pod priority will be default or zero if there is no
+                    default.
+                  type: string
+                readinessProbe:
+                  description: |-
+                    Periodic probe of VirtualMachineInstance service readiness.
+                    VirtualmachineInstances will be removed from service endpoints if the probe fails.
+                    Cannot be updated.
+                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                  properties:
+                    exec:
+                      description: |-
+                        One and only one of the following should be specified.
+                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the container, the working directory for the
+                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                            a shell, you need to explicitly call out to that shell.
+                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                    failureThreshold:
+                      description: |-
+                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                        Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
                       type: object
@@ -7292,11 +7957,102 @@ var CRDsValidation map[string]string = map[string]string{
                       format: int32
                       type: integer
                   type: object
+                resourceClaims:
+                  description: |-
+                    ResourceClaims are the ResourceClaims which have to be allocated and reserved before the virt-launcher pod
+                    of the VMI is allowed to start. GPUs and host devices reference them to consume the allocated devices.
+                    Requires the DynamicResourceAllocation feature gate of Kubernetes.
+                  items:
+                    description: |-
+                      PodResourceClaim references exactly one ResourceClaim through a ClaimSource.
+                      It adds a name to it that uniquely identifies the ResourceClaim inside the Pod.
+                      Containers that need access to the ResourceClaim reference it with this name.
+                    properties:
+                      name:
+                        description: |-
+                          Name uniquely identifies this resource claim inside the pod.
+                          This must be a DNS_LABEL.
+                        type: string
+                      source:
+                        description: Source describes where to find the ResourceClaim.
+                        properties:
+                          resourceClaimName:
+                            description: |-
+                              ResourceClaimName is the name of a ResourceClaim object in the same
+                              namespace as this pod.
+                            type: string
+                          resourceClaimTemplateName:
+                            description: |-
+                              ResourceClaimTemplateName is the name of a ResourceClaimTemplate
+                              object in the same namespace as this pod.
+
+                              The template will be used to create a new ResourceClaim, which will
+                              be bound to this pod. When this pod is deleted, the ResourceClaim
+                              will also be deleted. The pod name and resource name, along with a
+                              generated component, will be used to form a unique name for the
+                              ResourceClaim, which will be recorded in pod.status.resourceClaimStatuses.
+
+                              This field is immutable and no changes will be made to the
+                              corresponding ResourceClaim by the control plane after creating the
+                              ResourceClaim.
+                            type: string
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 schedulerName:
                   description: |-
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                securityProfile:
+                  description: |-
+                    SecurityProfile selects a stricter seccomp profile and SELinux type for the virt-launcher compute container.
+                    Only the profiles and types allowed in the KubeVirt configuration can be selected.
+                  properties:
+                    seccompLocalhostProfile:
+                      description: |-
+                        SeccompLocalhostProfile is the seccomp profile of the compute container, a path relative to the seccomp
+                        profile root of the kubelet. It has to be listed in securityProfiles.allowedSeccompLocalhostProfiles.
+                      type: string
+                    selinuxType:
+                      description: |-
+                        SELinuxType is the SELinux type of the compute container.
+                        It has to be listed in securityProfiles.allowedSELinuxTypes.
+                      type: string
+                  type: object
+                shutdownPolicy:
+                  description: ShutdownPolicy controls how the guest is asked to shut
+                    down before it is forcefully powered off.
+                  properties:
+                    acpiRetries:
+                      description: |-
+                        ACPIRetries is the number of times the ACPI power button event is sent again
+                        after the first one was not honored by the guest.
+                        If not set, the event is sent again until the guest is forcefully powered off.
+                      format: int32
+                      type: integer
+                    acpiRetryIntervalSeconds:
+                      description: |-
+                        ACPIRetryIntervalSeconds is the time to wait between two ACPI power button events.
+                        Defaults to 5
+                      format: int32
+                      type: integer
+                    forceOffTimeoutSeconds:
+                      description: |-
+                        ForceOffTimeoutSeconds is the time after the first shutdown signal after which the guest
+                        is forcefully powered off. It must not exceed terminationGracePeriodSeconds.
+                        Defaults to terminationGracePeriodSeconds
+                      format: int64
+                      type: integer
+                    guestAgentFallback:
+                      description: |-
+                        GuestAgentFallback requests the guest agent to shut down the guest once all
+                        ACPI retries are exhausted. Requires acpiRetries to be set and a connected guest agent.
+                      type: boolean
+                  type: object
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -8659,7 +9415,23 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any GPU devices associated with the instancetype.
           items:
             properties:
+              claim:
+                description: |-
+                  Claim references the ResourceClaim of the VMI the GPU is allocated from
+                  through Dynamic Resource Allocation.
+                  Either DeviceName or Claim has to be set.
+                properties:
+                  claimName:
+                    description: ClaimName is the name of the entry in spec.resourceClaims
+                      the device is allocated from.
+                    type: string
+                required:
+                - claimName
+                type: object
               deviceName:
+                description: |-
+                  DeviceName is the resource name of the GPU exposed by a device plugin.
+                  Either DeviceName or Claim has to be set.
                 type: string
               name:
                 description: Name of the GPU device as exposed by a device plugin
@@ -8691,7 +9463,6 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                 type: object
             required:
-            - deviceName
             - name
             type: object
           type: array
@@ -8700,9 +9471,23 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any HostDevices associated with the instancetype.
           items:
             properties:
+              claim:
+                description: |-
+                  Claim references the ResourceClaim of the VMI the host device is allocated from
+                  through Dynamic Resource Allocation.
+                  Either DeviceName or Claim has to be set.
+                properties:
+                  claimName:
+                    description: ClaimName is the name of the entry in spec.resourceClaims
+                      the device is allocated from.
+                    type: string
+                required:
+                - claimName
+                type: object
               deviceName:
-                description: DeviceName is the resource name of the host device exposed
-                  by a device plugin
+                description: |-
+                  DeviceName is the resource name of the host device exposed by a device plugin.
+                  Either DeviceName or Claim has to be set.
                 type: string
               name:
                 type: string
@@ -8711,7 +9496,6 @@ var CRDsValidation map[string]string = map[string]string{
                   its tag will be provided to the guest via config drive
                 type: string
             required:
-            - deviceName
             - name
             type: object
           type: array
@@ -9116,6 +9900,12 @@ var CRDsValidation map[string]string = map[string]string{
                     Persistent indicates the state of the TPM device should be kept accross reboots
                     Defaults to false
                   type: boolean
+                version:
+                  description: |-
+                    Version of the emulated TPM, one of 1.2 or 2.0.
+                    TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                    Defaults to 2.0
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
@@ -11235,7 +12025,23 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a GPU device to the vmi.
                   items:
                     properties:
+                      claim:
+                        description: |-
+                          Claim references the ResourceClaim of the VMI the GPU is allocated from
+                          through Dynamic Resource Allocation.
+                          Either DeviceName or Claim has to be set.
+                        properties:
+                          claimName:
+                            description: ClaimName is the name of the entry in spec.resourceClaims
+                              the device is allocated from.
+                            type: string
+                        required:
+                        - claimName
+                        type: object
                       deviceName:
+                        description: |-
+                          DeviceName is the resource name of the GPU exposed by a device plugin.
+                          Either DeviceName or Claim has to be set.
                         type: string
                       name:
                         description: Name of the GPU device as exposed by a device
@@ -11268,7 +12074,6 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                         type: object
                     required:
-                    - deviceName
                     - name
                     type: object
                   type: array
@@ -11277,9 +12082,23 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a host device to the vmi.
                   items:
                     properties:
+                      claim:
+                        description: |-
+                          Claim references the ResourceClaim of the VMI the host device is allocated from
+                          through Dynamic Resource Allocation.
+                          Either DeviceName or Claim has to be set.
+                        properties:
+                          claimName:
+                            description: ClaimName is the name of the entry in spec.resourceClaims
+                              the device is allocated from.
+                            type: string
+                        required:
+                        - claimName
+                        type: object
                       deviceName:
-                        description: DeviceName is the resource name of the host device
-                          exposed by a device plugin
+                        description: |-
+                          DeviceName is the resource name of the host device exposed by a device plugin.
+                          Either DeviceName or Claim has to be set.
                         type: string
                       name:
                         type: string
@@ -11288,7 +12107,6 @@ var CRDsValidation map[string]string = map[string]string{
                           and its tag will be provided to the guest via config drive
                         type: string
                     required:
-                    - deviceName
                     - name
                     type: object
                   type: array
@@ -11522,6 +12340,12 @@ var CRDsValidation map[string]string = map[string]string{
                         Persistent indicates the state of the TPM device should be kept accross reboots
                         Defaults to false
                       type: boolean
+                    version:
+                      description: |-
+                        Version of the emulated TPM, one of 1.2 or 2.0.
+                        TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                        Defaults to 2.0
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: |-
@@ -11835,6 +12659,12 @@ var CRDsValidation map[string]string = map[string]string{
                           type: boolean
                       type: object
                   type: object
+                imageName:
+                  description: |-
+                    ImageName selects a firmware image from spec.configuration.firmwareImages of the
+                    KubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.
+                    The image is pinned when the VMI is created and kept across migrations.
+                  type: string
                 kernelBoot:
                   description: Settings to set the kernel for booting.
                   properties:
@@ -11872,6 +12702,45 @@ var CRDsValidation map[string]string = map[string]string{
                     kernelArgs:
                       description: Arguments to be passed to the kernel at boot time
                       type: string
+                    kernelArgsFrom:
+                      description: |-
+                        KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.
+                        The arguments are read on every boot, so they can be changed without modifying the VMI.
+                        Mutually exclusive with kernelArgs.
+                      properties:
+                        key:
+                          description: Key within the ConfigMap which holds the kernel
+                            arguments
+                          type: string
+                        volumeName:
+                          description: VolumeName is the name of the ConfigMap volume
+                            of the VMI
+                          type: string
+                      required:
+                      - key
+                      - volumeName
+                      type: object
+                    volume:
+                      description: |-
+                        Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.
+                        Mutually exclusive with container.
+                      properties:
+                        initrdPath:
+                          description: The fully-qualified path to the ramdisk image
+                            inside the volume
+                          type: string
+                        kernelPath:
+                          description: The fully-qualified path to the kernel image
+                            inside the volume
+                          type: string
+                        name:
+                          description: |-
+                            Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI
+                            which contains the kernel artifacts. The volume must not be used by a disk.
+                          type: string
+                      required:
+                      - name
+                      type: object
                   type: object
                 serial:
                   description: The system-serial-number in SMBIOS
@@ -11992,6 +12861,17 @@ var CRDsValidation map[string]string = map[string]string{
           required:
           - devices
           type: object
+        ephemeralStorage:
+          description: EphemeralStorage configures the node-local storage backing
+            containerDisk, ephemeral and emptyDisk volumes.
+          properties:
+            encrypted:
+              description: |-
+                Encrypted enables the encryption of the qcow2 overlays of containerDisk and ephemeral volumes and of emptyDisk
+                volumes. The key is generated per VirtualMachineInstance, is only kept in memory and is discarded once the
+                VirtualMachineInstance stops.
+              type: boolean
+          type: object
         evictionStrategy:
           description: |-
             EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -12198,22 +13078,262 @@ var CRDsValidation map[string]string = map[string]string{
             Selector which must match a node's labels for the vmi to be scheduled on that node.
             More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
           type: object
-        priorityClassName:
-          description: |-
-            If specified, indicates the pod's priority.
-            If not specified, the pod priority will be default or zero if there is no
-            default.
-          type: string
-        readinessProbe:
-          description: |-
-            Periodic probe of VirtualMachineInstance service readiness.
-            VirtualmachineInstances will be removed from service endpoints if the probe fails.
-            Cannot be updated.
-            More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+        podOverlay:
+          description: PodOverlay adds a safelisted set of fields to the virt-launcher
+            pod of the VirtualMachineInstance.
           properties:
-            exec:
+            labels:
+              additionalProperties:
+                type: string
               description: |-
-                One and only one of the following should be specified.
+                Labels are added to the virt-launcher pod only, without being set on the VirtualMachineInstance.
+                Labels in the kubevirt.io domain are reserved and not allowed.
+              type: object
+            runtimeClassName:
+              description: |-
+                RuntimeClassName sets the runtime class of the virt-launcher pod.
+                It has to match the default runtime class of the cluster if one is configured.
+              type: string
+            tolerations:
+              description: Tolerations are added to the tolerations of the VirtualMachineInstance.
+              items:
+                description: |-
+                  The pod this Toleration is attached to tolerates any taint that matches
+                  the triple <key,value,effect> using the matching operator <operator>.
+                properties:
+                  effect:
+                    description: |-
+                      Effect indicates the taint effect to match. Empty means match all taint effects.
+                      When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                    type: string
+                  key:
+                    description: |-
+                      Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                      If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                    type: string
+                  operator:
+                    description: |-
+                      Operator represents a key's relationship to the value.
+                      Valid operators are Exists and Equal. Defaults to Equal.
+                      Exists is equivalent to wildcard for value, so that a pod can
+                      tolerate all taints of a particular category.
+                    type: string
+                  tolerationSeconds:
+                    description: |-
+                      TolerationSeconds represents the period of time the toleration (which must be
+                      of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                      it is not set, which means tolerate the taint forever (do not evict). Zero and
+                      negative values will be treated as 0 (evict immediately) by the system.
+                    format: int64
+                    type: integer
+                  value:
+                    description: |-
+                      Value is the taint value the toleration matches to.
+                      If the operator is Exists, the value should be empty, otherwise just a regular string.
+                    type: string
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            topologySpreadConstraints:
+              description: |-
+                TopologySpreadConstraints are merged into the topology spread constraints of the VirtualMachineInstance.
+                A constraint with the same topologyKey and whenUnsatisfiable as one of the VirtualMachineInstance replaces it.
+              items:
+                description: TopologySpreadConstraint specifies how to spread matching
+                  pods among the given topology.
+                properties:
+                  labelSelector:
+                    description: |-
+                      LabelSelector is used to find matching pods.
+                      Pods that match this label selector are counted to determine the number of pods
+                      in their corresponding topology domain.
+                    properties:
+                      matchExpressions:
+                        description: matchExpressions is a list of label selector
+                          requirements. The requirements are ANDed.
+                        items:
+                          description: |-
+                            A label selector requirement is a selector that contains values, a key, and an operator that
+                            relates the key and values.
+                          properties:
+                            key:
+                              description: key is the label key that the selector
+                                applies to.
+                              type: string
+                            operator:
+                              description: |-
+                                operator represents a key's relationship to a set of values.
+                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                              type: string
+                            values:
+                              description: |-
+                                values is an array of string values. If the operator is In or NotIn,
+                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                the values array must be empty. This array is replaced during a strategic
+                                merge patch.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                          required:
+                          - key
+                          - operator
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      matchLabels:
+                        additionalProperties:
+                          type: string
+                        description: |-
+                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                        type: object
+                    type: object
+                    x-kubernetes-map-type: atomic
+                  matchLabelKeys:
+                    description: |-
+                      MatchLabelKeys is a set of pod label keys to select the pods over which
+                      spreading will be calculated. The keys are used to lookup values from the
+                      incoming pod labels, those key-value labels are ANDed with labelSelector
+                      to select the group of existing pods over which spreading will be calculated
+                      for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                      MatchLabelKeys cannot be set when LabelSelector isn't set.
+                      Keys that don't exist in the incoming pod labels will
+                      be ignored. A null or empty list means only match against labelSelector.
+
+
+                      This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  maxSkew:
+                    description: |-
+                      MaxSkew describes the degree to which pods may be unevenly distributed.
+                      When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                      between the number of matching pods in the target topology and the global minimum.
+                      The global minimum is the minimum number of matching pods in an eligible domain
+                      or zero if the number of eligible domains is less than MinDomains.
+                      For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                      labelSelector spread as 2/2/1:
+                      In this case, the global minimum is 1.
+                      | zone1 | zone2 | zone3 |
+                      |  P P  |  P P  |   P   |
+                      - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                      scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                      violate MaxSkew(1).
+                      - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                      When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                      to topologies that satisfy it.
+                      It's a required field. Default value is 1 and 0 is not allowed.
+                    format: int32
+                    type: integer
+                  minDomains:
+                    description: |-
+                      MinDomains indicates a minimum number of eligible domains.
+                      When the number of eligible domains with matching topology keys is less than minDomains,
+                      Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                      And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                      this value has no effect on scheduling.
+                      As a result, when the number of eligible domains is less than minDomains,
+                      scheduler won't schedule more than maxSkew Pods to those domains.
+                      If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                      Valid values are integers greater than 0.
+                      When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+
+                      For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                      labelSelector spread as 2/2/2:
+                      | zone1 | zone2 | zone3 |
+                      |  P P  |  P P  |  P P  |
+                      The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                      In this situation, new pod with the same labelSelector cannot be scheduled,
+                      because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                      it will violate MaxSkew.
+                    format: int32
+                    type: integer
+                  nodeAffinityPolicy:
+                    description: |-
+                      NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                      when calculating pod topology spread skew. Options are:
+                      - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                      - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+
+                      If this value is nil, the behavior is equivalent to the Honor policy.
+                      This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                    type: string
+                  nodeTaintsPolicy:
+                    description: |-
+                      NodeTaintsPolicy indicates how we will treat node taints when calculating
+                      pod topology spread skew. Options are:
+                      - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                      has a toleration, are included.
+                      - Ignore: node taints are ignored. All nodes are included.
+
+
+                      If this value is nil, the behavior is equivalent to the Ignore policy.
+                      This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                    type: string
+                  topologyKey:
+                    description: |-
+                      TopologyKey is the key of node labels. Nodes that have a label with this key
+                      and identical values are considered to be in the same topology.
+                      We consider each <key, value> as a "bucket", and try to put balanced number
+                      of pods into each bucket.
+                      We define a domain as a particular instance of a topology.
+                      Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                      nodeAffinityPolicy and nodeTaintsPolicy.
+                      e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                      And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                      It's a required field.
+                    type: string
+                  whenUnsatisfiable:
+                    description: |-
+                      WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                      the spread constraint.
+                      - DoNotSchedule (default) tells the scheduler not to schedule it.
+                      - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                        but giving higher precedence to topologies that would help reduce the
+                        skew.
+                      A constraint is considered "Unsatisfiable" for an incoming pod
+                      if and only if every possible node assignment for that pod would violate
+                      "MaxSkew" on some topology.
+                      For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                      labelSelector spread as 3/1/1:
+                      | zone1 | zone2 | zone3 |
+                      | P P P |   P   |   P   |
+                      If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                      to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                      MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                      won't make it *more* imbalanced.
+                      It's a required field.
+                    type: string
+                required:
+                - maxSkew
+                - topologyKey
+                - whenUnsatisfiable
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
+        priorityClassName:
+          description: |-
+            If specified, indicates the pod's priority.
+            If not specified, the pod priority will be default or zero if there is no
+            default.
+          type: string
+        readinessProbe:
+          description: |-
+            Periodic probe of VirtualMachineInstance service readiness.
+            VirtualmachineInstances will be removed from service endpoints if the probe fails.
+            Cannot be updated.
+            More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+          properties:
+            exec:
+              description: |-
+                One and only one of the following should be specified.
                 Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
                 If the guest agent is not available, this probe will fail.
               properties:
@@ -12340,11 +13460,102 @@ var CRDsValidation map[string]string = map[string]string{
               format: int32
               type: integer
           type: object
+        resourceClaims:
+          description: |-
+            ResourceClaims are the ResourceClaims which have to be allocated and reserved before the virt-launcher pod
+            of the VMI is allowed to start. GPUs and host devices reference them to consume the allocated devices.
+            Requires the DynamicResourceAllocation feature gate of Kubernetes.
+          items:
+            description: |-
+              PodResourceClaim references exactly one ResourceClaim through a ClaimSource.
+              It adds a name to it that uniquely identifies the ResourceClaim inside the Pod.
+              Containers that need access to the ResourceClaim reference it with this name.
+            properties:
+              name:
+                description: |-
+                  Name uniquely identifies this resource claim inside the pod.
+                  This must be a DNS_LABEL.
+                type: string
+              source:
+                description: Source describes where to find the ResourceClaim.
+                properties:
+                  resourceClaimName:
+                    description: |-
+                      ResourceClaimName is the name of a ResourceClaim object in the same
+                      namespace as this pod.
+                    type: string
+                  resourceClaimTemplateName:
+                    description: |-
+                      ResourceClaimTemplateName is the name of a ResourceClaimTemplate
+                      object in the same namespace as this pod.
+
+                      The template will be used to create a new ResourceClaim, which will
+                      be bound to this pod. When this pod is deleted, the ResourceClaim
+                      will also be deleted. The pod name and resource name, along with a
+                      generated component, will be used to form a unique name for the
+                      ResourceClaim, which will be recorded in pod.status.resourceClaimStatuses.
+
+                      This field is immutable and no changes will be made to the
+                      corresponding ResourceClaim by the control plane after creating the
+                      ResourceClaim.
+                    type: string
+                type: object
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         schedulerName:
           description: |-
             If specified, the VMI will be dispatched by specified scheduler.
             If not specified, the VMI will be dispatched by default scheduler.
           type: string
+        securityProfile:
+          description: |-
+            SecurityProfile selects a stricter seccomp profile and SELinux type for the virt-launcher compute container.
+            Only the profiles and types allowed in the KubeVirt configuration can be selected.
+          properties:
+            seccompLocalhostProfile:
+              description: |-
+                SeccompLocalhostProfile is the seccomp profile of the compute container, a path relative to the seccomp
+                profile root of the kubelet. It has to be listed in securityProfiles.allowedSeccompLocalhostProfiles.
+              type: string
+            selinuxType:
+              description: |-
+                SELinuxType is the SELinux type of the compute container.
+                It has to be listed in securityProfiles.allowedSELinuxTypes.
+              type: string
+          type: object
+        shutdownPolicy:
+          description: ShutdownPolicy controls how the guest is asked to shut down
+            before it is forcefully powered off.
+          properties:
+            acpiRetries:
+              description: |-
+                ACPIRetries is the number of times the ACPI power button event is sent again
+                after the first one was not honored by the guest.
+                If not set, the event is sent again until the guest is forcefully powered off.
+              format: int32
+              type: integer
+            acpiRetryIntervalSeconds:
+              description: |-
+                ACPIRetryIntervalSeconds is the time to wait between two ACPI power button events.
+                Defaults to 5
+              format: int32
+              type: integer
+            forceOffTimeoutSeconds:
+              description: |-
+                ForceOffTimeoutSeconds is the time after the first shutdown signal after which the guest
+                is forcefully powered off. It must not exceed terminationGracePeriodSeconds.
+                Defaults to terminationGracePeriodSeconds
+              format: int64
+              type: integer
+            guestAgentFallback:
+              description: |-
+                GuestAgentFallback requests the guest agent to shut down the guest once all
+                ACPI retries are exhausted. Requires acpiRetries to be set and a connected guest agent.
+              type: boolean
+          type: object
         startStrategy:
           description: StartStrategy can be set to "Paused" if Virtual Machine should
             be started in paused state.
@@ -13083,16 +14294,158 @@ var CRDsValidation map[string]string = map[string]string{
               format: int32
               type: integer
           type: object
+        deviceStatus:
+          description: DeviceStatus reflects the devices allocated through resource
+            claims for the GPUs and host devices
+          properties:
+            gpuStatuses:
+              description: GPUStatuses reflect the devices allocated for the GPUs
+              items:
+                description: DeviceStatusInfo reflects the device allocated through
+                  a resource claim for a GPU or a host device
+                properties:
+                  deviceName:
+                    description: DeviceName is the name of the allocated device in
+                      the ResourceSlice of its driver
+                    type: string
+                  name:
+                    description: Name is the name of the GPU or host device in the
+                      VMI spec
+                    type: string
+                  pciAddress:
+                    description: PCIAddress is the PCI address of the allocated device
+                      on the node
+                    type: string
+                  resourceClaimName:
+                    description: ResourceClaimName is the name of the ResourceClaim
+                      the device is allocated from
+                    type: string
+                required:
+                - deviceName
+                - name
+                - pciAddress
+                - resourceClaimName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            hostDeviceStatuses:
+              description: HostDeviceStatuses reflect the devices allocated for the
+                host devices
+              items:
+                description: DeviceStatusInfo reflects the device allocated through
+                  a resource claim for a GPU or a host device
+                properties:
+                  deviceName:
+                    description: DeviceName is the name of the allocated device in
+                      the ResourceSlice of its driver
+                    type: string
+                  name:
+                    description: Name is the name of the GPU or host device in the
+                      VMI spec
+                    type: string
+                  pciAddress:
+                    description: PCIAddress is the PCI address of the allocated device
+                      on the node
+                    type: string
+                  resourceClaimName:
+                    description: ResourceClaimName is the name of the ResourceClaim
+                      the device is allocated from
+                    type: string
+                required:
+                - deviceName
+                - name
+                - pciAddress
+                - resourceClaimName
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         evacuationNodeName:
           description: |-
             EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want
             to evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.
           type: string
+        firmwareImageStatus:
+          description: FirmwareImageStatus contains the firmware image the VMI was
+            pinned to at creation
+          properties:
+            checksum:
+              description: Checksum of the firmware binaries
+              format: int32
+              type: integer
+            image:
+              description: |-
+                Image is the container image which was selected when the VMI was created.
+                It stays the same for the lifetime of the VMI, also across migrations.
+              type: string
+            imagePullPolicy:
+              description: ImagePullPolicy of the firmware image container
+              type: string
+            name:
+              description: Name of the firmware image in the KubeVirt configuration
+              type: string
+            path:
+              description: Path is the directory inside the image containing the firmware
+                binaries
+              type: string
+          required:
+          - image
+          - name
+          type: object
         fsFreezeStatus:
           description: |-
             FSFreezeStatus is the state of the fs of the guest
             it can be either frozen or thawed
           type: string
+        guestNUMA:
+          description: GuestNUMA shows the NUMA topology presented to the guest, if
+            it has one.
+          properties:
+            cells:
+              description: Cells are the NUMA nodes of the guest
+              items:
+                description: GuestNUMACell describes a NUMA node of the guest
+                properties:
+                  cpus:
+                    description: CPUs are the vCPUs of the NUMA node
+                    items:
+                      format: int32
+                      type: integer
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  distances:
+                    description: |-
+                      Distances are the distances from the NUMA node to all NUMA nodes of the guest, ordered by their id,
+                      as reported in the ACPI SLIT
+                    items:
+                      format: int32
+                      type: integer
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  hostNode:
+                    description: HostNode is the host NUMA node the memory of the
+                      NUMA node is allocated from
+                    format: int32
+                    type: integer
+                  id:
+                    description: ID is the id of the NUMA node in the guest
+                    format: int32
+                    type: integer
+                  memory:
+                    anyOf:
+                    - type: integer
+                    - type: string
+                    description: Memory is the amount of memory of the NUMA node
+                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                    x-kubernetes-int-or-string: true
+                required:
+                - id
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - cells
+          type: object
         guestOSInfo:
           description: Guest OS Information
           properties:
@@ -14376,7 +15729,23 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a GPU device to the vmi.
                   items:
                     properties:
+                      claim:
+                        description: |-
+                          Claim references the ResourceClaim of the VMI the GPU is allocated from
+                          through Dynamic Resource Allocation.
+                          Either DeviceName or Claim has to be set.
+                        properties:
+                          claimName:
+                            description: ClaimName is the name of the entry in spec.resourceClaims
+                              the device is allocated from.
+                            type: string
+                        required:
+                        - claimName
+                        type: object
                       deviceName:
+                        description: |-
+                          DeviceName is the resource name of the GPU exposed by a device plugin.
+                          Either DeviceName or Claim has to be set.
                         type: string
                       name:
                         description: Name of the GPU device as exposed by a device
@@ -14409,7 +15778,6 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                         type: object
                     required:
-                    - deviceName
                     - name
                     type: object
                   type: array
@@ -14418,9 +15786,23 @@ var CRDsValidation map[string]string = map[string]string{
                   description: Whether to attach a host device to the vmi.
                   items:
                     properties:
+                      claim:
+                        description: |-
+                          Claim references the ResourceClaim of the VMI the host device is allocated from
+                          through Dynamic Resource Allocation.
+                          Either DeviceName or Claim has to be set.
+                        properties:
+                          claimName:
+                            description: ClaimName is the name of the entry in spec.resourceClaims
+                              the device is allocated from.
+                            type: string
+                        required:
+                        - claimName
+                        type: object
                       deviceName:
-                        description: DeviceName is the resource name of the host device
-                          exposed by a device plugin
+                        description: |-
+                          DeviceName is the resource name of the host device exposed by a device plugin.
+                          Either DeviceName or Claim has to be set.
                         type: string
                       name:
                         type: string
@@ -14429,7 +15811,6 @@ var CRDsValidation map[string]string = map[string]string{
                           and its tag will be provided to the guest via config drive
                         type: string
                     required:
-                    - deviceName
                     - name
                     type: object
                   type: array
@@ -14663,6 +16044,12 @@ var CRDsValidation map[string]string = map[string]string{
                         Persistent indicates the state of the TPM device should be kept accross reboots
                         Defaults to false
                       type: boolean
+                    version:
+                      description: |-
+                        Version of the emulated TPM, one of 1.2 or 2.0.
+                        TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                        Defaults to 2.0
+                      type: string
                   type: object
                 useVirtioTransitional:
                   description: |-
@@ -14976,6 +16363,12 @@ var CRDsValidation map[string]string = map[string]string{
                           type: boolean
                       type: object
                   type: object
+                imageName:
+                  description: |-
+                    ImageName selects a firmware image from spec.configuration.firmwareImages of the
+                    KubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.
+                    The image is pinned when the VMI is created and kept across migrations.
+                  type: string
                 kernelBoot:
                   description: Settings to set the kernel for booting.
                   properties:
@@ -15013,6 +16406,45 @@ var CRDsValidation map[string]string = map[string]string{
                     kernelArgs:
                       description: Arguments to be passed to the kernel at boot time
                       type: string
+                    kernelArgsFrom:
+                      description: |-
+                        KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.
+                        The arguments are read on every boot, so they can be changed without modifying the VMI.
+                        Mutually exclusive with kernelArgs.
+                      properties:
+                        key:
+                          description: Key within the ConfigMap which holds the kernel
+                            arguments
+                          type: string
+                        volumeName:
+                          description: VolumeName is the name of the ConfigMap volume
+                            of the VMI
+                          type: string
+                      required:
+                      - key
+                      - volumeName
+                      type: object
+                    volume:
+                      description: |-
+                        Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.
+                        Mutually exclusive with container.
+                      properties:
+                        initrdPath:
+                          description: The fully-qualified path to the ramdisk image
+                            inside the volume
+                          type: string
+                        kernelPath:
+                          description: The fully-qualified path to the kernel image
+                            inside the volume
+                          type: string
+                        name:
+                          description: |-
+                            Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI
+                            which contains the kernel artifacts. The volume must not be used by a disk.
+                          type: string
+                      required:
+                      - name
+                      type: object
                   type: object
                 serial:
                   description: The system-serial-number in SMBIOS
@@ -16780,7 +18212,24 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a GPU device to the vmi.
                           items:
                             properties:
+                              claim:
+                                description: |-
+                                  Claim references the ResourceClaim of the VMI the GPU is allocated from
+                                  through Dynamic Resource Allocation.
+                                  Either DeviceName or Claim has to be set.
+                                properties:
+                                  claimName:
+                                    description: ClaimName is the name of the entry
+                                      in spec.resourceClaims the device is allocated
+                                      from.
+                                    type: string
+                                required:
+                                - claimName
+                                type: object
                               deviceName:
+                                description: |-
+                                  DeviceName is the resource name of the GPU exposed by a device plugin.
+                                  Either DeviceName or Claim has to be set.
                                 type: string
                               name:
                                 description: Name of the GPU device as exposed by
@@ -16814,7 +18263,6 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                 type: object
                             required:
-                            - deviceName
                             - name
                             type: object
                           type: array
@@ -16823,19 +18271,33 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to attach a host device to the vmi.
                           items:
                             properties:
-                              deviceName:
-                                description: DeviceName is the resource name of the
-                                  host device exposed by a device plugin
-                                type: string
-                              name:
-                                type: string
+                              claim:
+                                description: |-
+                                  Claim references the ResourceClaim of the VMI the host device is allocated from
+                                  through Dynamic Resource Allocation.
+                                  Either DeviceName or Claim has to be set.
+                                properties:
+                                  claimName:
+                                    description: ClaimName is the name of the entry
+                                      in spec.resourceClaims the device is allocated
+                                      from.
+                                    type: string
+                                required:
+                                - claimName
+                                type: object
+                              deviceName:
+                                description: |-
+                                  DeviceName is the resource name of the host device exposed by a device plugin.
+                                  Either DeviceName or Claim has to be set.
+                                type: string
+                              name:
+                                type: string
                               tag:
                                 description: If specified, the virtual network interface
                                   address and its tag will be provided to the guest
                                   via config drive
                                 type: string
                             required:
-                            - deviceName
                             - name
                             type: object
                           type: array
@@ -17071,6 +18533,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 Persistent indicates the state of the TPM device should be kept accross reboots
                                 Defaults to false
                               type: boolean
+                            version:
+                              description: |-
+                                Version of the emulated TPM, one of 1.2 or 2.0.
+                                TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                                Defaults to 2.0
+                              type: string
                           type: object
                         useVirtioTransitional:
                           description: |-
@@ -17387,6 +18855,12 @@ var CRDsValidation map[string]string = map[string]string{
                                   type: boolean
                               type: object
                           type: object
+                        imageName:
+                          description: |-
+                            ImageName selects a firmware image from spec.configuration.firmwareImages of the
+                            KubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.
+                            The image is pinned when the VMI is created and kept across migrations.
+                          type: string
                         kernelBoot:
                           description: Settings to set the kernel for booting.
                           properties:
@@ -17426,6 +18900,45 @@ var CRDsValidation map[string]string = map[string]string{
                               description: Arguments to be passed to the kernel at
                                 boot time
                               type: string
+                            kernelArgsFrom:
+                              description: |-
+                                KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.
+                                The arguments are read on every boot, so they can be changed without modifying the VMI.
+                                Mutually exclusive with kernelArgs.
+                              properties:
+                                key:
+                                  description: Key within the ConfigMap which holds
+                                    the kernel arguments
+                                  type: string
+                                volumeName:
+                                  description: VolumeName is the name of the ConfigMap
+                                    volume of the VMI
+                                  type: string
+                              required:
+                              - key
+                              - volumeName
+                              type: object
+                            volume:
+                              description: |-
+                                Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.
+                                Mutually exclusive with container.
+                              properties:
+                                initrdPath:
+                                  description: The fully-qualified path to the ramdisk
+                                    image inside the volume
+                                  type: string
+                                kernelPath:
+                                  description: The fully-qualified path to the kernel
+                                    image inside the volume
+                                  type: string
+                                name:
+                                  description: |-
+                                    Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI
+                                    which contains the kernel artifacts. The volume must not be used by a disk.
+                                  type: string
+                              required:
+                              - name
+                              type: object
                           type: object
                         serial:
                           description: The system-serial-number in SMBIOS
@@ -17548,6 +19061,17 @@ var CRDsValidation map[string]string = map[string]string{
                   required:
                   - devices
                   type: object
+                ephemeralStorage:
+                  description: EphemeralStorage configures the node-local storage backing
+                    containerDisk, ephemeral and emptyDisk volumes.
+                  properties:
+                    encrypted:
+                      description: |-
+                        Encrypted enables the encryption of the qcow2 overlays of containerDisk and ephemeral volumes and of emptyDisk
+                        volumes. The key is generated per VirtualMachineInstance, is only kept in memory and is discarded once the
+                        VirtualMachineInstance stops.
+                      type: boolean
+                  type: object
                 evictionStrategy:
                   description: |-
                     EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -17755,6 +19279,247 @@ var CRDsValidation map[string]string = map[string]string{
                     Selector which must match a node's labels for the vmi to be scheduled on that node.
                     More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                   type: object
+                podOverlay:
+                  description: PodOverlay adds a safelisted set of fields to the virt-launcher
+                    pod of the VirtualMachineInstance.
+                  properties:
+                    labels:
+                      additionalProperties:
+                        type: string
+                      description: |-
+                        Labels are added to the virt-launcher pod only, without being set on the VirtualMachineInstance.
+                        Labels in the kubevirt.io domain are reserved and not allowed.
+                      type: object
+                    runtimeClassName:
+                      description: |-
+                        RuntimeClassName sets the runtime class of the virt-launcher pod.
+                        It has to match the default runtime class of the cluster if one is configured.
+                      type: string
+                    tolerations:
+                      description: Tolerations are added to the tolerations of the
+                        VirtualMachineInstance.
+                      items:
+                        description: |-
+                          The pod this Toleration is attached to tolerates any taint that matches
+                          the triple <key,value,effect> using the matching operator <operator>.
+                        properties:
+                          effect:
+                            description: |-
+                              Effect indicates the taint effect to match. Empty means match all taint effects.
+                              When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                            type: string
+                          key:
+                            description: |-
+                              Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                              If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                            type: string
+                          operator:
+                            description: |-
+                              Operator represents a key's relationship to the value.
+                              Valid operators are Exists and Equal. Defaults to Equal.
+                              Exists is equivalent to wildcard for value, so that a pod can
+                              tolerate all taints of a particular category.
+                            type: string
+                          tolerationSeconds:
+                            description: |-
+                              TolerationSeconds represents the period of time the toleration (which must be
+                              of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                              it is not set, which means tolerate the taint forever (do not evict). Zero and
+                              negative values will be treated as 0 (evict immediately) by the system.
+                            format: int64
+                            type: integer
+                          value:
+                            description: |-
+                              Value is the taint value the toleration matches to.
+                              If the operator is Exists, the value should be empty, otherwise just a regular string.
+                            type: string
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    topologySpreadConstraints:
+                      description: |-
+                        TopologySpreadConstraints are merged into the topology spread constraints of the VirtualMachineInstance.
+                        A constraint with the same topologyKey and whenUnsatisfiable as one of the VirtualMachineInstance replaces it.
+                      items:
+                        description: TopologySpreadConstraint specifies how to spread
+                          matching pods among the given topology.
+                        properties:
+                          labelSelector:
+                            description: |-
+                              LabelSelector is used to find matching pods.
+                              Pods that match this label selector are counted to determine the number of pods
+                              in their corresponding topology domain.
+                            properties:
+                              matchExpressions:
+                                description: matchExpressions is a list of label selector
+                                  requirements. The requirements are ANDed.
+                                items:
+                                  description: |-
+                                    A label selector requirement is a selector that contains values, a key, and an operator that
+                                    relates the key and values.
+                                  properties:
+                                    key:
+                                      description: key is the label key that the selector
+                                        applies to.
+                                      type: string
+                                    operator:
+                                      description: |-
+                                        operator represents a key's relationship to a set of values.
+                                        Valid operators are In, NotIn, Exists and DoesNotExist.
+                                      type: string
+                                    values:
+                                      description: |-
+                                        values is an array of string values. If the operator is In or NotIn,
+                                        the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                        the values array must be empty. This array is replaced during a strategic
+                                        merge patch.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  required:
+                                  - key
+                                  - operator
+                                  type: object
+                                type: array
+                                x-kubernetes-list-type: atomic
+                              matchLabels:
+                                additionalProperties:
+                                  type: string
+                                description: |-
+                                  matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                  map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                  operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                type: object
+                            type: object
+                            x-kubernetes-map-type: atomic
+                          matchLabelKeys:
+                            description: |-
+                              MatchLabelKeys is a set of pod label keys to select the pods over which
+                              spreading will be calculated. The keys are used to lookup values from the
+                              incoming pod labels, those key-value labels are ANDed with labelSelector
+                              to select the group of existing pods over which spreading will be calculated
+                              for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                              MatchLabelKeys cannot be set when LabelSelector isn't set.
+                              Keys that don't exist in the incoming pod labels will
+                              be ignored. A null or empty list means only match against labelSelector.
+
+
+                              This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                            items:
+                              type: string
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          maxSkew:
+                            description: |-
+                              MaxSkew describes the degree to which pods may be unevenly distributed.
+                              When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                              between the number of matching pods in the target topology and the global minimum.
+                              The global minimum is the minimum number of matching pods in an eligible domain
+                              or zero if the number of eligible domains is less than MinDomains.
+                              For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                              labelSelector spread as 2/2/1:
+                              In this case, the global minimum is 1.
+                              | zone1 | zone2 | zone3 |
+                              |  P P  |  P P  |   P   |
+                              - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                              scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                              violate MaxSkew(1).
+                              - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                              When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                              to topologies that satisfy it.
+                              It's a required field. Default value is 1 and 0 is not allowed.
+                            format: int32
+                            type: integer
+                          minDomains:
+                            description: |-
+                              MinDomains indicates a minimum number of eligible domains.
+                              When the number of eligible domains with matching topology keys is less than minDomains,
+                              Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                              And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                              this value has no effect on scheduling.
+                              As a result, when the number of eligible domains is less than minDomains,
+                              scheduler won't schedule more than maxSkew Pods to those domains.
+                              If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                              Valid values are integers greater than 0.
+                              When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+
+                              For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                              labelSelector spread as 2/2/2:
+                              | zone1 | zone2 | zone3 |
+                              |  P P  |  P P  |  P P  |
+                              The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                              In this situation, new pod with the same labelSelector cannot be scheduled,
+                              because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                              it will violate MaxSkew.
+                            format: int32
+                            type: integer
+                          nodeAffinityPolicy:
+                            description: |-
+                              NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                              when calculating pod topology spread skew. Options are:
+                              - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                              - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+
+                              If this value is nil, the behavior is equivalent to the Honor policy.
+                              This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                            type: string
+                          nodeTaintsPolicy:
+                            description: |-
+                              NodeTaintsPolicy indicates how we will treat node taints when calculating
+                              pod topology spread skew. Options are:
+                              - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                              has a toleration, are included.
+                              - Ignore: node taints are ignored. All nodes are included.
+
+
+                              If this value is nil, the behavior is equivalent to the Ignore policy.
+                              This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                            type: string
+                          topologyKey:
+                            description: |-
+                              TopologyKey is the key of node labels. Nodes that have a label with this key
+                              and identical values are considered to be in the same topology.
+                              We consider each <key, value> as a "bucket", and try to put balanced number
+                              of pods into each bucket.
+                              We define a domain as a particular instance of a topology.
+                              Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                              nodeAffinityPolicy and nodeTaintsPolicy.
+                              e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                              And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                              It's a required field.
+                            type: string
+                          whenUnsatisfiable:
+                            description: |-
+                              WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                              the spread constraint.
+                              - DoNotSchedule (default) tells the scheduler not to schedule it.
+                              - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                                but giving higher precedence to topologies that would help reduce the
+                                skew.
+                              A constraint is considered "Unsatisfiable" for an incoming pod
+                              if and only if every possible node assignment for that pod would violate
+                              "MaxSkew" on some topology.
+                              For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                              labelSelector spread as 3/1/1:
+                              | zone1 | zone2 | zone3 |
+                              | P P P |   P   |   P   |
+                              If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                              to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                              MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                              won't make it *more* imbalanced.
+                              It's a required field.
+                            type: string
+                        required:
+                        - maxSkew
+                        - topologyKey
+                        - whenUnsatisfiable
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                  type: object
                 priorityClassName:
                   description: |-
                     If specified, indicates the pod's priority.
@@ -17897,11 +19662,102 @@ var CRDsValidation map[string]string = map[string]string{
                       format: int32
                       type: integer
                   type: object
+                resourceClaims:
+                  description: |-
+                    ResourceClaims are the ResourceClaims which have to be allocated and reserved before the virt-launcher pod
+                    of the VMI is allowed to start. GPUs and host devices reference them to consume the allocated devices.
+                    Requires the DynamicResourceAllocation feature gate of Kubernetes.
+                  items:
+                    description: |-
+                      PodResourceClaim references exactly one ResourceClaim through a ClaimSource.
+                      It adds a name to it that uniquely identifies the ResourceClaim inside the Pod.
+                      Containers that need access to the ResourceClaim reference it with this name.
+                    properties:
+                      name:
+                        description: |-
+                          Name uniquely identifies this resource claim inside the pod.
+                          This must be a DNS_LABEL.
+                        type: string
+                      source:
+                        description: Source describes where to find the ResourceClaim.
+                        properties:
+                          resourceClaimName:
+                            description: |-
+                              ResourceClaimName is the name of a ResourceClaim object in the same
+                              namespace as this pod.
+                            type: string
+                          resourceClaimTemplateName:
+                            description: |-
+                              ResourceClaimTemplateName is the name of a ResourceClaimTemplate
+                              object in the same namespace as this pod.
+
+                              The template will be used to create a new ResourceClaim, which will
+                              be bound to this pod. When this pod is deleted, the ResourceClaim
+                              will also be deleted. The pod name and resource name, along with a
+                              generated component, will be used to form a unique name for the
+                              ResourceClaim, which will be recorded in pod.status.resourceClaimStatuses.
+
+                              This field is immutable and no changes will be made to the
+                              corresponding ResourceClaim by the control plane after creating the
+                              ResourceClaim.
+                            type: string
+                        type: object
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 schedulerName:
                   description: |-
                     If specified, the VMI will be dispatched by specified scheduler.
                     If not specified, the VMI will be dispatched by default scheduler.
                   type: string
+                securityProfile:
+                  description: |-
+                    SecurityProfile selects a stricter seccomp profile and SELinux type for the virt-launcher compute container.
+                    Only the profiles and types allowed in the KubeVirt configuration can be selected.
+                  properties:
+                    seccompLocalhostProfile:
+                      description: |-
+                        SeccompLocalhostProfile is the seccomp profile of the compute container, a path relative to the seccomp
+                        profile root of the kubelet. It has to be listed in securityProfiles.allowedSeccompLocalhostProfiles.
+                      type: string
+                    selinuxType:
+                      description: |-
+                        SELinuxType is the SELinux type of the compute container.
+                        It has to be listed in securityProfiles.allowedSELinuxTypes.
+                      type: string
+                  type: object
+                shutdownPolicy:
+                  description: ShutdownPolicy controls how the guest is asked to shut
+                    down before it is forcefully powered off.
+                  properties:
+                    acpiRetries:
+                      description: |-
+                        ACPIRetries is the number of times the ACPI power button event is sent again
+                        after the first one was not honored by the guest.
+                        If not set, the event is sent again until the guest is forcefully powered off.
+                      format: int32
+                      type: integer
+                    acpiRetryIntervalSeconds:
+                      description: |-
+                        ACPIRetryIntervalSeconds is the time to wait between two ACPI power button events.
+                        Defaults to 5
+                      format: int32
+                      type: integer
+                    forceOffTimeoutSeconds:
+                      description: |-
+                        ForceOffTimeoutSeconds is the time after the first shutdown signal after which the guest
+                        is forcefully powered off. It must not exceed terminationGracePeriodSeconds.
+                        Defaults to terminationGracePeriodSeconds
+                      format: int64
+                      type: integer
+                    guestAgentFallback:
+                      description: |-
+                        GuestAgentFallback requests the guest agent to shut down the guest once all
+                        ACPI retries are exhausted. Requires acpiRetries to be set and a connected guest agent.
+                      type: boolean
+                  type: object
                 startStrategy:
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
@@ -18728,7 +20584,23 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any GPU devices associated with the instancetype.
           items:
             properties:
+              claim:
+                description: |-
+                  Claim references the ResourceClaim of the VMI the GPU is allocated from
+                  through Dynamic Resource Allocation.
+                  Either DeviceName or Claim has to be set.
+                properties:
+                  claimName:
+                    description: ClaimName is the name of the entry in spec.resourceClaims
+                      the device is allocated from.
+                    type: string
+                required:
+                - claimName
+                type: object
               deviceName:
+                description: |-
+                  DeviceName is the resource name of the GPU exposed by a device plugin.
+                  Either DeviceName or Claim has to be set.
                 type: string
               name:
                 description: Name of the GPU device as exposed by a device plugin
@@ -18760,7 +20632,6 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                 type: object
             required:
-            - deviceName
             - name
             type: object
           type: array
@@ -18769,9 +20640,23 @@ var CRDsValidation map[string]string = map[string]string{
           description: Optionally defines any HostDevices associated with the instancetype.
           items:
             properties:
+              claim:
+                description: |-
+                  Claim references the ResourceClaim of the VMI the host device is allocated from
+                  through Dynamic Resource Allocation.
+                  Either DeviceName or Claim has to be set.
+                properties:
+                  claimName:
+                    description: ClaimName is the name of the entry in spec.resourceClaims
+                      the device is allocated from.
+                    type: string
+                required:
+                - claimName
+                type: object
               deviceName:
-                description: DeviceName is the resource name of the host device exposed
-                  by a device plugin
+                description: |-
+                  DeviceName is the resource name of the host device exposed by a device plugin.
+                  Either DeviceName or Claim has to be set.
                 type: string
               name:
                 type: string
@@ -18780,7 +20665,6 @@ var CRDsValidation map[string]string = map[string]string{
                   its tag will be provided to the guest via config drive
                 type: string
             required:
-            - deviceName
             - name
             type: object
           type: array
@@ -21267,7 +23151,24 @@ var CRDsValidation map[string]string = map[string]string{
                                     vmi.
                                   items:
                                     properties:
+                                      claim:
+                                        description: |-
+                                          Claim references the ResourceClaim of the VMI the GPU is allocated from
+                                          through Dynamic Resource Allocation.
+                                          Either DeviceName or Claim has to be set.
+                                        properties:
+                                          claimName:
+                                            description: ClaimName is the name of
+                                              the entry in spec.resourceClaims the
+                                              device is allocated from.
+                                            type: string
+                                        required:
+                                        - claimName
+                                        type: object
                                       deviceName:
+                                        description: |-
+                                          DeviceName is the resource name of the GPU exposed by a device plugin.
+                                          Either DeviceName or Claim has to be set.
                                         type: string
                                       name:
                                         description: Name of the GPU device as exposed
@@ -21301,7 +23202,6 @@ var CRDsValidation map[string]string = map[string]string{
                                             type: object
                                         type: object
                                     required:
-                                    - deviceName
                                     - name
                                     type: object
                                   type: array
@@ -21311,9 +23211,24 @@ var CRDsValidation map[string]string = map[string]string{
                                     the vmi.
                                   items:
                                     properties:
+                                      claim:
+                                        description: |-
+                                          Claim references the ResourceClaim of the VMI the host device is allocated from
+                                          through Dynamic Resource Allocation.
+                                          Either DeviceName or Claim has to be set.
+                                        properties:
+                                          claimName:
+                                            description: ClaimName is the name of
+                                              the entry in spec.resourceClaims the
+                                              device is allocated from.
+                                            type: string
+                                        required:
+                                        - claimName
+                                        type: object
                                       deviceName:
-                                        description: DeviceName is the resource name
-                                          of the host device exposed by a device plugin
+                                        description: |-
+                                          DeviceName is the resource name of the host device exposed by a device plugin.
+                                          Either DeviceName or Claim has to be set.
                                         type: string
                                       name:
                                         type: string
@@ -21323,7 +23238,6 @@ var CRDsValidation map[string]string = map[string]string{
                                           to the guest via config drive
                                         type: string
                                     required:
-                                    - deviceName
                                     - name
                                     type: object
                                   type: array
@@ -21567,6 +23481,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         Persistent indicates the state of the TPM device should be kept accross reboots
                                         Defaults to false
                                       type: boolean
+                                    version:
+                                      description: |-
+                                        Version of the emulated TPM, one of 1.2 or 2.0.
+                                        TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                                        Defaults to 2.0
+                                      type: string
                                   type: object
                                 useVirtioTransitional:
                                   description: |-
@@ -21886,6 +23806,12 @@ var CRDsValidation map[string]string = map[string]string{
                                           type: boolean
                                       type: object
                                   type: object
+                                imageName:
+                                  description: |-
+                                    ImageName selects a firmware image from spec.configuration.firmwareImages of the
+                                    KubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.
+                                    The image is pinned when the VMI is created and kept across migrations.
+                                  type: string
                                 kernelBoot:
                                   description: Settings to set the kernel for booting.
                                   properties:
@@ -21926,6 +23852,45 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Arguments to be passed to the kernel
                                         at boot time
                                       type: string
+                                    kernelArgsFrom:
+                                      description: |-
+                                        KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.
+                                        The arguments are read on every boot, so they can be changed without modifying the VMI.
+                                        Mutually exclusive with kernelArgs.
+                                      properties:
+                                        key:
+                                          description: Key within the ConfigMap which
+                                            holds the kernel arguments
+                                          type: string
+                                        volumeName:
+                                          description: VolumeName is the name of the
+                                            ConfigMap volume of the VMI
+                                          type: string
+                                      required:
+                                      - key
+                                      - volumeName
+                                      type: object
+                                    volume:
+                                      description: |-
+                                        Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.
+                                        Mutually exclusive with container.
+                                      properties:
+                                        initrdPath:
+                                          description: The fully-qualified path to
+                                            the ramdisk image inside the volume
+                                          type: string
+                                        kernelPath:
+                                          description: The fully-qualified path to
+                                            the kernel image inside the volume
+                                          type: string
+                                        name:
+                                          description: |-
+                                            Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI
+                                            which contains the kernel artifacts. The volume must not be used by a disk.
+                                          type: string
+                                      required:
+                                      - name
+                                      type: object
                                   type: object
                                 serial:
                                   description: The system-serial-number in SMBIOS
@@ -22052,6 +24017,17 @@ var CRDsValidation map[string]string = map[string]string{
                           required:
                           - devices
                           type: object
+                        ephemeralStorage:
+                          description: EphemeralStorage configures the node-local storage backing
+                            containerDisk, ephemeral and emptyDisk volumes.
+                          properties:
+                            encrypted:
+                              description: |-
+                                Encrypted enables the encryption of the qcow2 overlays of containerDisk and ephemeral volumes and of emptyDisk
+                                volumes. The key is generated per VirtualMachineInstance, is only kept in memory and is discarded once the
+                                VirtualMachineInstance stops.
+                              type: boolean
+                          type: object
                         evictionStrategy:
                           description: |-
                             EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -22246,18 +24222,260 @@ var CRDsValidation map[string]string = map[string]string{
                                       Default 10.0.2.0/24 if not specified.
                                     type: string
                                 type: object
-                            required:
-                            - name
-                            type: object
-                          maxItems: 256
-                          type: array
-                        nodeSelector:
-                          additionalProperties:
-                            type: string
-                          description: |-
-                            NodeSelector is a selector which must be true for the vmi to fit on a node.
-                            Selector which must match a node's labels for the vmi to be scheduled on that node.
-                            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+                            required:
+                            - name
+                            type: object
+                          maxItems: 256
+                          type: array
+                        nodeSelector:
+                          additionalProperties:
+                            type: string
+                          description: |-
+                            NodeSelector is a selector which must be true for the vmi to fit on a node.
+                            Selector which must match a node's labels for the vmi to be scheduled on that node.
+                            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+                          type: object
+                        podOverlay:
+                          description: PodOverlay adds a safelisted set of fields
+                            to the virt-launcher pod of the VirtualMachineInstance.
+                          properties:
+                            labels:
+                              additionalProperties:
+                                type: string
+                              description: |-
+                                Labels are added to the virt-launcher pod only, without being set on the VirtualMachineInstance.
+                                Labels in the kubevirt.io domain are reserved and not allowed.
+                              type: object
+                            runtimeClassName:
+                              description: |-
+                                RuntimeClassName sets the runtime class of the virt-launcher pod.
+                                It has to match the default runtime class of the cluster if one is configured.
+                              type: string
+                            tolerations:
+                              description: Tolerations are added to the tolerations
+                                of the VirtualMachineInstance.
+                              items:
+                                description: |-
+                                  The pod this Toleration is attached to tolerates any taint that matches
+                                  the triple <key,value,effect> using the matching operator <operator>.
+                                properties:
+                                  effect:
+                                    description: |-
+                                      Effect indicates the taint effect to match. Empty means match all taint effects.
+                                      When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                                    type: string
+                                  key:
+                                    description: |-
+                                      Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                                      If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                                    type: string
+                                  operator:
+                                    description: |-
+                                      Operator represents a key's relationship to the value.
+                                      Valid operators are Exists and Equal. Defaults to Equal.
+                                      Exists is equivalent to wildcard for value, so that a pod can
+                                      tolerate all taints of a particular category.
+                                    type: string
+                                  tolerationSeconds:
+                                    description: |-
+                                      TolerationSeconds represents the period of time the toleration (which must be
+                                      of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                                      it is not set, which means tolerate the taint forever (do not evict). Zero and
+                                      negative values will be treated as 0 (evict immediately) by the system.
+                                    format: int64
+                                    type: integer
+                                  value:
+                                    description: |-
+                                      Value is the taint value the toleration matches to.
+                                      If the operator is Exists, the value should be empty, otherwise just a regular string.
+                                    type: string
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            topologySpreadConstraints:
+                              description: |-
+                                TopologySpreadConstraints are merged into the topology spread constraints of the VirtualMachineInstance.
+                                A constraint with the same topologyKey and whenUnsatisfiable as one of the VirtualMachineInstance replaces it.
+                              items:
+                                description: TopologySpreadConstraint specifies how
+                                  to spread matching pods among the given topology.
+                                properties:
+                                  labelSelector:
+                                    description: |-
+                                      LabelSelector is used to find matching pods.
+                                      Pods that match this label selector are counted to determine the number of pods
+                                      in their corresponding topology domain.
+                                    properties:
+                                      matchExpressions:
+                                        description: matchExpressions is a list of
+                                          label selector requirements. The requirements
+                                          are ANDed.
+                                        items:
+                                          description: |-
+                                            A label selector requirement is a selector that contains values, a key, and an operator that
+                                            relates the key and values.
+                                          properties:
+                                            key:
+                                              description: key is the label key that
+                                                the selector applies to.
+                                              type: string
+                                            operator:
+                                              description: |-
+                                                operator represents a key's relationship to a set of values.
+                                                Valid operators are In, NotIn, Exists and DoesNotExist.
+                                              type: string
+                                            values:
+                                              description: |-
+                                                values is an array of string values. If the operator is In or NotIn,
+                                                the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                the values array must be empty. This array is replaced during a strategic
+                                                merge patch.
+                                              items:
+                                                type: string
+                                              type: array
+                                              x-kubernetes-list-type: atomic
+                                          required:
+                                          - key
+                                          - operator
+                                          type: object
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      matchLabels:
+                                        additionalProperties:
+                                          type: string
+                                        description: |-
+                                          matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                          map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                          operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                        type: object
+                                    type: object
+                                    x-kubernetes-map-type: atomic
+                                  matchLabelKeys:
+                                    description: |-
+                                      MatchLabelKeys is a set of pod label keys to select the pods over which
+                                      spreading will be calculated. The keys are used to lookup values from the
+                                      incoming pod labels, those key-value labels are ANDed with labelSelector
+                                      to select the group of existing pods over which spreading will be calculated
+                                      for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                                      MatchLabelKeys cannot be set when LabelSelector isn't set.
+                                      Keys that don't exist in the incoming pod labels will
+                                      be ignored. A null or empty list means only match against labelSelector.
+
+
+                                      This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  maxSkew:
+                                    description: |-
+                                      MaxSkew describes the degree to which pods may be unevenly distributed.
+                                      When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                                      between the number of matching pods in the target topology and the global minimum.
+                                      The global minimum is the minimum number of matching pods in an eligible domain
+                                      or zero if the number of eligible domains is less than MinDomains.
+                                      For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                                      labelSelector spread as 2/2/1:
+                                      In this case, the global minimum is 1.
+                                      | zone1 | zone2 | zone3 |
+                                      |  P P  |  P P  |   P   |
+                                      - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                                      scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                                      violate MaxSkew(1).
+                                      - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                                      When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                                      to topologies that satisfy it.
+                                      It's a required field. Default value is 1 and 0 is not allowed.
+                                    format: int32
+                                    type: integer
+                                  minDomains:
+                                    description: |-
+                                      MinDomains indicates a minimum number of eligible domains.
+                                      When the number of eligible domains with matching topology keys is less than minDomains,
+                                      Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                                      And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                                      this value has no effect on scheduling.
+                                      As a result, when the number of eligible domains is less than minDomains,
+                                      scheduler won't schedule more than maxSkew Pods to those domains.
+                                      If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                                      Valid values are integers greater than 0.
+                                      When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+
+                                      For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                                      labelSelector spread as 2/2/2:
+                                      | zone1 | zone2 | zone3 |
+                                      |  P P  |  P P  |  P P  |
+                                      The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                                      In this situation, new pod with the same labelSelector cannot be scheduled,
+                                      because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                                      it will violate MaxSkew.
+                                    format: int32
+                                    type: integer
+                                  nodeAffinityPolicy:
+                                    description: |-
+                                      NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                                      when calculating pod topology spread skew. Options are:
+                                      - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                                      - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+
+                                      If this value is nil, the behavior is equivalent to the Honor policy.
+                                      This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                                    type: string
+                                  nodeTaintsPolicy:
+                                    description: |-
+                                      NodeTaintsPolicy indicates how we will treat node taints when calculating
+                                      pod topology spread skew. Options are:
+                                      - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                                      has a toleration, are included.
+                                      - Ignore: node taints are ignored. All nodes are included.
+
+
+                                      If this value is nil, the behavior is equivalent to the Ignore policy.
+                                      This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                                    type: string
+                                  topologyKey:
+                                    description: |-
+                                      TopologyKey is the key of node labels. Nodes that have a label with this key
+                                      and identical values are considered to be in the same topology.
+                                      We consider each <key, value> as a "bucket", and try to put balanced number
+                                      of pods into each bucket.
+                                      We define a domain as a particular instance of a topology.
+                                      Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                                      nodeAffinityPolicy and nodeTaintsPolicy.
+                                      e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                                      And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                                      It's a required field.
+                                    type: string
+                                  whenUnsatisfiable:
+                                    description: |-
+                                      WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                                      the spread constraint.
+                                      - DoNotSchedule (default) tells the scheduler not to schedule it.
+                                      - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                                        but giving higher precedence to topologies that would help reduce the
+                                        skew.
+                                      A constraint is considered "Unsatisfiable" for an incoming pod
+                                      if and only if every possible node assignment for that pod would violate
+                                      "MaxSkew" on some topology.
+                                      For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                                      labelSelector spread as 3/1/1:
+                                      | zone1 | zone2 | zone3 |
+                                      | P P P |   P   |   P   |
+                                      If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                                      to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                                      MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                                      won't make it *more* imbalanced.
+                                      It's a required field.
+                                    type: string
+                                required:
+                                - maxSkew
+                                - topologyKey
+                                - whenUnsatisfiable
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
                           type: object
                         priorityClassName:
                           description: |-
@@ -22401,11 +24619,102 @@ var CRDsValidation map[string]string = map[string]string{
                               format: int32
                               type: integer
                           type: object
+                        resourceClaims:
+                          description: |-
+                            ResourceClaims are the ResourceClaims which have to be allocated and reserved before the virt-launcher pod
+                            of the VMI is allowed to start. GPUs and host devices reference them to consume the allocated devices.
+                            Requires the DynamicResourceAllocation feature gate of Kubernetes.
+                          items:
+                            description: |-
+                              PodResourceClaim references exactly one ResourceClaim through a ClaimSource.
+                              It adds a name to it that uniquely identifies the ResourceClaim inside the Pod.
+                              Containers that need access to the ResourceClaim reference it with this name.
+                            properties:
+                              name:
+                                description: |-
+                                  Name uniquely identifies this resource claim inside the pod.
+                                  This must be a DNS_LABEL.
+                                type: string
+                              source:
+                                description: Source describes where to find the ResourceClaim.
+                                properties:
+                                  resourceClaimName:
+                                    description: |-
+                                      ResourceClaimName is the name of a ResourceClaim object in the same
+                                      namespace as this pod.
+                                    type: string
+                                  resourceClaimTemplateName:
+                                    description: |-
+                                      ResourceClaimTemplateName is the name of a ResourceClaimTemplate
+                                      object in the same namespace as this pod.
+
+                                      The template will be used to create a new ResourceClaim, which will
+                                      be bound to this pod. When this pod is deleted, the ResourceClaim
+                                      will also be deleted. The pod name and resource name, along with a
+                                      generated component, will be used to form a unique name for the
+                                      ResourceClaim, which will be recorded in pod.status.resourceClaimStatuses.
+
+                                      This field is immutable and no changes will be made to the
+                                      corresponding ResourceClaim by the control plane after creating the
+                                      ResourceClaim.
+                                    type: string
+                                type: object
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         schedulerName:
                           description: |-
                             If specified, the VMI will be dispatched by specified scheduler.
                             If not specified, the VMI will be dispatched by default scheduler.
                           type: string
+                        securityProfile:
+                          description: |-
+                            SecurityProfile selects a stricter seccomp profile and SELinux type for the virt-launcher compute container.
+                            Only the profiles and types allowed in the KubeVirt configuration can be selected.
+                          properties:
+                            seccompLocalhostProfile:
+                              description: |-
+                                SeccompLocalhostProfile is the seccomp profile of the compute container, a path relative to the seccomp
+                                profile root of the kubelet. It has to be listed in securityProfiles.allowedSeccompLocalhostProfiles.
+                              type: string
+                            selinuxType:
+                              description: |-
+                                SELinuxType is the SELinux type of the compute container.
+                                It has to be listed in securityProfiles.allowedSELinuxTypes.
+                              type: string
+                          type: object
+                        shutdownPolicy:
+                          description: ShutdownPolicy controls how the guest is asked
+                            to shut down before it is forcefully powered off.
+                          properties:
+                            acpiRetries:
+                              description: |-
+                                ACPIRetries is the number of times the ACPI power button event is sent again
+                                after the first one was not honored by the guest.
+                                If not set, the event is sent again until the guest is forcefully powered off.
+                              format: int32
+                              type: integer
+                            acpiRetryIntervalSeconds:
+                              description: |-
+                                ACPIRetryIntervalSeconds is the time to wait between two ACPI power button events.
+                                Defaults to 5
+                              format: int32
+                              type: integer
+                            forceOffTimeoutSeconds:
+                              description: |-
+                                ForceOffTimeoutSeconds is the time after the first shutdown signal after which the guest
+                                is forcefully powered off. It must not exceed terminationGracePeriodSeconds.
+                                Defaults to terminationGracePeriodSeconds
+                              format: int64
+                              type: integer
+                            guestAgentFallback:
+                              description: |-
+                                GuestAgentFallback requests the guest agent to shut down the guest once all
+                                ACPI retries are exhausted. Requires acpiRetries to be set and a connected guest agent.
+                              type: boolean
+                          type: object
                         startStrategy:
                           description: StartStrategy can be set to "Paused" if Virtual
                             Machine should be started in paused state.
@@ -23453,6 +25762,12 @@ var CRDsValidation map[string]string = map[string]string{
                     Persistent indicates the state of the TPM device should be kept accross reboots
                     Defaults to false
                   type: boolean
+                version:
+                  description: |-
+                    Version of the emulated TPM, one of 1.2 or 2.0.
+                    TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                    Defaults to 2.0
+                  type: string
               type: object
             preferredUseVirtioTransitional:
               description: PreferredUseVirtioTransitional optionally defines the preferred
@@ -26433,7 +28748,24 @@ var CRDsValidation map[string]string = map[string]string{
                                         to the vmi.
                                       items:
                                         properties:
+                                          claim:
+                                            description: |-
+                                              Claim references the ResourceClaim of the VMI the GPU is allocated from
+                                              through Dynamic Resource Allocation.
+                                              Either DeviceName or Claim has to be set.
+                                            properties:
+                                              claimName:
+                                                description: ClaimName is the name
+                                                  of the entry in spec.resourceClaims
+                                                  the device is allocated from.
+                                                type: string
+                                            required:
+                                            - claimName
+                                            type: object
                                           deviceName:
+                                            description: |-
+                                              DeviceName is the resource name of the GPU exposed by a device plugin.
+                                              Either DeviceName or Claim has to be set.
                                             type: string
                                           name:
                                             description: Name of the GPU device as
@@ -26468,7 +28800,6 @@ var CRDsValidation map[string]string = map[string]string{
                                                 type: object
                                             type: object
                                         required:
-                                        - deviceName
                                         - name
                                         type: object
                                       type: array
@@ -26478,10 +28809,24 @@ var CRDsValidation map[string]string = map[string]string{
                                         to the vmi.
                                       items:
                                         properties:
+                                          claim:
+                                            description: |-
+                                              Claim references the ResourceClaim of the VMI the host device is allocated from
+                                              through Dynamic Resource Allocation.
+                                              Either DeviceName or Claim has to be set.
+                                            properties:
+                                              claimName:
+                                                description: ClaimName is the name
+                                                  of the entry in spec.resourceClaims
+                                                  the device is allocated from.
+                                                type: string
+                                            required:
+                                            - claimName
+                                            type: object
                                           deviceName:
-                                            description: DeviceName is the resource
-                                              name of the host device exposed by a
-                                              device plugin
+                                            description: |-
+                                              DeviceName is the resource name of the host device exposed by a device plugin.
+                                              Either DeviceName or Claim has to be set.
                                             type: string
                                           name:
                                             type: string
@@ -26492,7 +28837,6 @@ var CRDsValidation map[string]string = map[string]string{
                                               drive
                                             type: string
                                         required:
-                                        - deviceName
                                         - name
                                         type: object
                                       type: array
@@ -26737,6 +29081,12 @@ var CRDsValidation map[string]string = map[string]string{
                                             Persistent indicates the state of the TPM device should be kept accross reboots
                                             Defaults to false
                                           type: boolean
+                                        version:
+                                          description: |-
+                                            Version of the emulated TPM, one of 1.2 or 2.0.
+                                            TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+                                            Defaults to 2.0
+                                          type: string
                                       type: object
                                     useVirtioTransitional:
                                       description: |-
@@ -27056,6 +29406,12 @@ var CRDsValidation map[string]string = map[string]string{
                                               type: boolean
                                           type: object
                                       type: object
+                                    imageName:
+                                      description: |-
+                                        ImageName selects a firmware image from spec.configuration.firmwareImages of the
+                                        KubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.
+                                        The image is pinned when the VMI is created and kept across migrations.
+                                      type: string
                                     kernelBoot:
                                       description: Settings to set the kernel for
                                         booting.
@@ -27097,6 +29453,45 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: Arguments to be passed to the
                                             kernel at boot time
                                           type: string
+                                        kernelArgsFrom:
+                                          description: |-
+                                            KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.
+                                            The arguments are read on every boot, so they can be changed without modifying the VMI.
+                                            Mutually exclusive with kernelArgs.
+                                          properties:
+                                            key:
+                                              description: Key within the ConfigMap
+                                                which holds the kernel arguments
+                                              type: string
+                                            volumeName:
+                                              description: VolumeName is the name
+                                                of the ConfigMap volume of the VMI
+                                              type: string
+                                          required:
+                                          - key
+                                          - volumeName
+                                          type: object
+                                        volume:
+                                          description: |-
+                                            Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.
+                                            Mutually exclusive with container.
+                                          properties:
+                                            initrdPath:
+                                              description: The fully-qualified path
+                                                to the ramdisk image inside the volume
+                                              type: string
+                                            kernelPath:
+                                              description: The fully-qualified path
+                                                to the kernel image inside the volume
+                                              type: string
+                                            name:
+                                              description: |-
+                                                Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI
+                                                which contains the kernel artifacts. The volume must not be used by a disk.
+                                              type: string
+                                          required:
+                                          - name
+                                          type: object
                                       type: object
                                     serial:
                                       description: The system-serial-number in SMBIOS
@@ -27223,6 +29618,17 @@ var CRDsValidation map[string]string = map[string]string{
                               required:
                               - devices
                               type: object
+                            ephemeralStorage:
+                              description: EphemeralStorage configures the node-local storage backing
+                                containerDisk, ephemeral and emptyDisk volumes.
+                              properties:
+                                encrypted:
+                                  description: |-
+                                    Encrypted enables the encryption of the qcow2 overlays of containerDisk and ephemeral volumes and of emptyDisk
+                                    volumes. The key is generated per VirtualMachineInstance, is only kept in memory and is discarded once the
+                                    VirtualMachineInstance stops.
+                                  type: boolean
+                              type: object
                             evictionStrategy:
                               description: |-
                                 EvictionStrategy describes the strategy to follow when a node drain occurs.
@@ -27432,6 +29838,249 @@ var CRDsValidation map[string]string = map[string]string{
                                 Selector which must match a node's labels for the vmi to be scheduled on that node.
                                 More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                               type: object
+                            podOverlay:
+                              description: PodOverlay adds a safelisted set of fields
+                                to the virt-launcher pod of the VirtualMachineInstance.
+                              properties:
+                                labels:
+                                  additionalProperties:
+                                    type: string
+                                  description: |-
+                                    Labels are added to the virt-launcher pod only, without being set on the VirtualMachineInstance.
+                                    Labels in the kubevirt.io domain are reserved and not allowed.
+                                  type: object
+                                runtimeClassName:
+                                  description: |-
+                                    RuntimeClassName sets the runtime class of the virt-launcher pod.
+                                    It has to match the default runtime class of the cluster if one is configured.
+                                  type: string
+                                tolerations:
+                                  description: Tolerations are added to the tolerations
+                                    of the VirtualMachineInstance.
+                                  items:
+                                    description: |-
+                                      The pod this Toleration is attached to tolerates any taint that matches
+                                      the triple <key,value,effect> using the matching operator <operator>.
+                                    properties:
+                                      effect:
+                                        description: |-
+                                          Effect indicates the taint effect to match. Empty means match all taint effects.
+                                          When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                                        type: string
+                                      key:
+                                        description: |-
+                                          Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                                          If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                                        type: string
+                                      operator:
+                                        description: |-
+                                          Operator represents a key's relationship to the value.
+                                          Valid operators are Exists and Equal. Defaults to Equal.
+                                          Exists is equivalent to wildcard for value, so that a pod can
+                                          tolerate all taints of a particular category.
+                                        type: string
+                                      tolerationSeconds:
+                                        description: |-
+                                          TolerationSeconds represents the period of time the toleration (which must be
+                                          of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                                          it is not set, which means tolerate the taint forever (do not evict). Zero and
+                                          negative values will be treated as 0 (evict immediately) by the system.
+                                        format: int64
+                                        type: integer
+                                      value:
+                                        description: |-
+                                          Value is the taint value the toleration matches to.
+                                          If the operator is Exists, the value should be empty, otherwise just a regular string.
+                                        type: string
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                topologySpreadConstraints:
+                                  description: |-
+                                    TopologySpreadConstraints are merged into the topology spread constraints of the VirtualMachineInstance.
+                                    A constraint with the same topologyKey and whenUnsatisfiable as one of the VirtualMachineInstance replaces it.
+                                  items:
+                                    description: TopologySpreadConstraint specifies
+                                      how to spread matching pods among the given
+                                      topology.
+                                    properties:
+                                      labelSelector:
+                                        description: |-
+                                          LabelSelector is used to find matching pods.
+                                          Pods that match this label selector are counted to determine the number of pods
+                                          in their corresponding topology domain.
+                                        properties:
+                                          matchExpressions:
+                                            description: matchExpressions is a list
+                                              of label selector requirements. The
+                                              requirements are ANDed.
+                                            items:
+                                              description: |-
+                                                A label selector requirement is a selector that contains values, a key, and an operator that
+                                                relates the key and values.
+                                              properties:
+                                                key:
+                                                  description: key is the label key
+                                                    that the selector applies to.
+                                                  type: string
+                                                operator:
+                                                  description: |-
+                                                    operator represents a key's relationship to a set of values.
+                                                    Valid operators are In, NotIn, Exists and DoesNotExist.
+                                                  type: string
+                                                values:
+                                                  description: |-
+                                                    values is an array of string values. If the operator is In or NotIn,
+                                                    the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                                    the values array must be empty. This array is replaced during a strategic
+                                                    merge patch.
+                                                  items:
+                                                    type: string
+                                                  type: array
+                                                  x-kubernetes-list-type: atomic
+                                              required:
+                                              - key
+                                              - operator
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          matchLabels:
+                                            additionalProperties:
+                                              type: string
+                                            description: |-
+                                              matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                              map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                              operator is "In", and the values array contains only "value". The requirements are ANDed.
+                                            type: object
+                                        type: object
+                                        x-kubernetes-map-type: atomic
+                                      matchLabelKeys:
+                                        description: |-
+                                          MatchLabelKeys is a set of pod label keys to select the pods over which
+                                          spreading will be calculated. The keys are used to lookup values from the
+                                          incoming pod labels, those key-value labels are ANDed with labelSelector
+                                          to select the group of existing pods over which spreading will be calculated
+                                          for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                                          MatchLabelKeys cannot be set when LabelSelector isn't set.
+                                          Keys that don't exist in the incoming pod labels will
+                                          be ignored. A null or empty list means only match against labelSelector.
+
+
+                                          This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                                        items:
+                                          type: string
+                                        type: array
+                                        x-kubernetes-list-type: atomic
+                                      maxSkew:
+                                        description: |-
+                                          MaxSkew describes the degree to which pods may be unevenly distributed.
+                                          When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                                          between the number of matching pods in the target topology and the global minimum.
+                                          The global minimum is the minimum number of matching pods in an eligible domain
+                                          or zero if the number of eligible domains is less than MinDomains.
+                                          For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                                          labelSelector spread as 2/2/1:
+                                          In this case, the global minimum is 1.
+                                          | zone1 | zone2 | zone3 |
+                                          |  P P  |  P P  |   P   |
+                                          - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                                          scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                                          violate MaxSkew(1).
+                                          - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                                          When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                                          to topologies that satisfy it.
+                                          It's a required field. Default value is 1 and 0 is not allowed.
+                                        format: int32
+                                        type: integer
+                                      minDomains:
+                                        description: |-
+                                          MinDomains indicates a minimum number of eligible domains.
+                                          When the number of eligible domains with matching topology keys is less than minDomains,
+                                          Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                                          And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                                          this value has no effect on scheduling.
+                                          As a result, when the number of eligible domains is less than minDomains,
+                                          scheduler won't schedule more than maxSkew Pods to those domains.
+                                          If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                                          Valid values are integers greater than 0.
+                                          When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+
+                                          For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                                          labelSelector spread as 2/2/2:
+                                          | zone1 | zone2 | zone3 |
+                                          |  P P  |  P P  |  P P  |
+                                          The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                                          In this situation, new pod with the same labelSelector cannot be scheduled,
+                                          because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                                          it will violate MaxSkew.
+                                        format: int32
+                                        type: integer
+                                      nodeAffinityPolicy:
+                                        description: |-
+                                          NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                                          when calculating pod topology spread skew. Options are:
+                                          - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                                          - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+
+                                          If this value is nil, the behavior is equivalent to the Honor policy.
+                                          This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                                        type: string
+                                      nodeTaintsPolicy:
+                                        description: |-
+                                          NodeTaintsPolicy indicates how we will treat node taints when calculating
+                                          pod topology spread skew. Options are:
+                                          - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                                          has a toleration, are included.
+                                          - Ignore: node taints are ignored. All nodes are included.
+
+
+                                          If this value is nil, the behavior is equivalent to the Ignore policy.
+                                          This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                                        type: string
+                                      topologyKey:
+                                        description: |-
+                                          TopologyKey is the key of node labels. Nodes that have a label with this key
+                                          and identical values are considered to be in the same topology.
+                                          We consider each <key, value> as a "bucket", and try to put balanced number
+                                          of pods into each bucket.
+                                          We define a domain as a particular instance of a topology.
+                                          Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                                          nodeAffinityPolicy and nodeTaintsPolicy.
+                                          e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                                          And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                                          It's a required field.
+                                        type: string
+                                      whenUnsatisfiable:
+                                        description: |-
+                                          WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                                          the spread constraint.
+                                          - DoNotSchedule (default) tells the scheduler not to schedule it.
+                                          - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                                            but giving higher precedence to topologies that would help reduce the
+                                            skew.
+                                          A constraint is considered "Unsatisfiable" for an incoming pod
+                                          if and only if every possible node assignment for that pod would violate
+                                          "MaxSkew" on some topology.
+                                          For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                                          labelSelector spread as 3/1/1:
+                                          | zone1 | zone2 | zone3 |
+                                          | P P P |   P   |   P   |
+                                          If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                                          to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                                          MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                                          won't make it *more* imbalanced.
+                                          It's a required field.
+                                        type: string
+                                    required:
+                                    - maxSkew
+                                    - topologyKey
+                                    - whenUnsatisfiable
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              type: object
                             priorityClassName:
                               description: |-
                                 If specified, indicates the pod's priority.
@@ -27575,11 +30224,103 @@ var CRDsValidation map[string]string = map[string]string{
                                   format: int32
                                   type: integer
                               type: object
+                            resourceClaims:
+                              description: |-
+                                ResourceClaims are the ResourceClaims which have to be allocated and reserved before the virt-launcher pod
+                                of the VMI is allowed to start. GPUs and host devices reference them to consume the allocated devices.
+                                Requires the DynamicResourceAllocation feature gate of Kubernetes.
+                              items:
+                                description: |-
+                                  PodResourceClaim references exactly one ResourceClaim through a ClaimSource.
+                                  It adds a name to it that uniquely identifies the ResourceClaim inside the Pod.
+                                  Containers that need access to the ResourceClaim reference it with this name.
+                                properties:
+                                  name:
+                                    description: |-
+                                      Name uniquely identifies this resource claim inside the pod.
+                                      This must be a DNS_LABEL.
+                                    type: string
+                                  source:
+                                    description: Source describes where to find the ResourceClaim.
+                                    properties:
+                                      resourceClaimName:
+                                        description: |-
+                                          ResourceClaimName is the name of a ResourceClaim object in the same
+                                          namespace as this pod.
+                                        type: string
+                                      resourceClaimTemplateName:
+                                        description: |-
+                                          ResourceClaimTemplateName is the name of a ResourceClaimTemplate
+                                          object in the same namespace as this pod.
+
+                                          The template will be used to create a new ResourceClaim, which will
+                                          be bound to this pod. When this pod is deleted, the ResourceClaim
+                                          will also be deleted. The pod name and resource name, along with a
+                                          generated component, will be used to form a unique name for the
+                                          ResourceClaim, which will be recorded in pod.status.resourceClaimStatuses.
+
+                                          This field is immutable and no changes will be made to the
+                                          corresponding ResourceClaim by the control plane after creating the
+                                          ResourceClaim.
+                                        type: string
+                                    type: object
+                                required:
+                                - name
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
                             schedulerName:
                               description: |-
                                 If specified, the VMI will be dispatched by specified scheduler.
                                 If not specified, the VMI will be dispatched by default scheduler.
                               type: string
+                            securityProfile:
+                              description: |-
+                                SecurityProfile selects a stricter seccomp profile and SELinux type for the virt-launcher compute container.
+                                Only the profiles and types allowed in the KubeVirt configuration can be selected.
+                              properties:
+                                seccompLocalhostProfile:
+                                  description: |-
+                                    SeccompLocalhostProfile is the seccomp profile of the compute container, a path relative to the seccomp
+                                    profile root of the kubelet. It has to be listed in securityProfiles.allowedSeccompLocalhostProfiles.
+                                  type: string
+                                selinuxType:
+                                  description: |-
+                                    SELinuxType is the SELinux type of the compute container.
+                                    It has to be listed in securityProfiles.allowedSELinuxTypes.
+                                  type: string
+                              type: object
+                            shutdownPolicy:
+                              description: ShutdownPolicy controls how the guest is
+                                asked to shut down before it is forcefully powered
+                                off.
+                              properties:
+                                acpiRetries:
+                                  description: |-
+                                    ACPIRetries is the number of times the ACPI power button event is sent again
+                                    after the first one was not honored by the guest.
+                                    If not set, the event is sent again until the guest is forcefully powered off.
+                                  format: int32
+                                  type: integer
+                                acpiRetryIntervalSeconds:
+                                  description: |-
+                                    ACPIRetryIntervalSeconds is the time to wait between two ACPI power button events.
+                                    Defaults to 5
+                                  format: int32
+                                  type: integer
+                                forceOffTimeoutSeconds:
+                                  description: |-
+                                    ForceOffTimeoutSeconds is the time after the first shutdown signal after which the guest
+                                    is forcefully powered off. It must not exceed terminationGracePeriodSeconds.
+                                    Defaults to terminationGracePeriodSeconds
+                                  format: int64
+                                  type: integer
+                                guestAgentFallback:
+                                  description: |-
+                                    GuestAgentFallback requests the guest agent to shut down the guest once all
+                                    ACPI retries are exhausted. Requires acpiRetries to be set and a connected guest agent.
+                                  type: boolean
+                              type: object
                             startStrategy:
                               description: StartStrategy can be set to "Paused" if
                                 Virtual Machine should be started in paused state.
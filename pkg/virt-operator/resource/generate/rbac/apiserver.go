@@ -86,7 +86,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"pods",
 				},
 				Verbs: []string{
-					"get", "list", "delete", "patch",
+					"get", "list", "watch", "delete", "patch",
 				},
 			},
 			{
@@ -269,6 +269,40 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"nodes",
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"k8s.cni.cncf.io",
+				},
+				Resources: []string{
+					"network-attachment-definitions",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+			{
+				APIGroups: []string{
+					"admissionregistration.k8s.io",
+				},
+				Resources: []string{
+					"validatingwebhookconfigurations",
+					"mutatingwebhookconfigurations",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 		},
 	}
 }
@@ -352,6 +386,29 @@ func newApiServerRole(namespace string) *rbacv1.Role {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"endpoints",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+			{
+				APIGroups: []string{
+					"apps",
+				},
+				Resources: []string{
+					"deployments",
+					"daemonsets",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 		},
 	}
 }
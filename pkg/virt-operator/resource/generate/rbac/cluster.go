@@ -42,6 +42,7 @@ const (
 	apiVersion            = "version"
 	apiGuestFs            = "guestfs"
 	apiExpandVmSpec       = "expand-vm-spec"
+	apiHealth             = "health"
 	apiKubevirts          = "kubevirts"
 	apiVM                 = "virtualmachines"
 	apiVMInstances        = "virtualmachineinstances"
@@ -531,6 +532,17 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiHealth,
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
 			{
 				APIGroups: []string{
 					migrations.GroupName,
@@ -571,6 +583,17 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiHealth,
+				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
 			{
 				APIGroups: []string{
 					virtv1.SubresourceGroupName,
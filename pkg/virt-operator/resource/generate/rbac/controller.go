@@ -271,6 +271,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "update", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"resource.k8s.io",
+				},
+				Resources: []string{
+					"resourceclaims",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+			{
+				APIGroups: []string{
+					"resource.k8s.io",
+				},
+				Resources: []string{
+					"resourceslices",
+				},
+				Verbs: []string{
+					"list",
+				},
+			},
 			{
 				APIGroups: []string{
 					"apps",
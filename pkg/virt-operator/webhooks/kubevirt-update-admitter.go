@@ -23,7 +23,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 
@@ -39,6 +44,8 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -111,6 +118,46 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
 
+	if newKV.Spec.Configuration.CrashLoopBackOff != nil {
+		results = append(results,
+			validateCrashLoopBackOffConfiguration(field.NewPath("spec").Child("configuration", "crashLoopBackOff"), newKV.Spec.Configuration.CrashLoopBackOff)...)
+	}
+
+	if len(newKV.Spec.Configuration.FirmwareImages) > 0 {
+		results = append(results,
+			validateFirmwareImages(field.NewPath("spec").Child("configuration", "firmwareImages"), newKV.Spec.Configuration.FirmwareImages)...)
+	}
+
+	if newKV.Spec.Configuration.InstancetypeUpdateStrategy != nil {
+		results = append(results,
+			validateInstancetypeUpdateStrategy(field.NewPath("spec").Child("configuration", "instancetypeUpdateStrategy"), newKV.Spec.Configuration.InstancetypeUpdateStrategy)...)
+	}
+
+	if newKV.Spec.Configuration.ToolsDisk != nil {
+		results = append(results,
+			validateToolsDisk(field.NewPath("spec").Child("configuration", "toolsDisk"), newKV.Spec.Configuration.ToolsDisk)...)
+	}
+
+	if newKV.Spec.Configuration.SecurityProfiles != nil {
+		results = append(results,
+			validateSecurityProfiles(field.NewPath("spec").Child("configuration", "securityProfiles"), newKV.Spec.Configuration.SecurityProfiles)...)
+	}
+
+	if newKV.Spec.Configuration.ExportOIDC != nil {
+		results = append(results,
+			validateExportOIDC(field.NewPath("spec").Child("configuration", "exportOIDC"), newKV.Spec.Configuration.ExportOIDC)...)
+	}
+
+	if newKV.Spec.Configuration.SubresourceRateLimits != nil {
+		results = append(results,
+			validateSubresourceRateLimits(field.NewPath("spec").Child("configuration", "subresourceRateLimits"), newKV.Spec.Configuration.SubresourceRateLimits)...)
+	}
+
+	if newKV.Spec.Configuration.StreamDrainSeconds != nil {
+		results = append(results,
+			validateStreamDrainSeconds(field.NewPath("spec").Child("configuration", "streamDrainSeconds"), *newKV.Spec.Configuration.StreamDrainSeconds)...)
+	}
+
 	response := validating_webhooks.NewAdmissionResponse(results)
 
 	if featureGatesChanged(&currKV.Spec, &newKV.Spec) {
@@ -244,6 +291,10 @@ func validateTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.St
 		return statuses
 	}
 
+	if tlsConfiguration.FIPSMode {
+		statuses = append(statuses, validateFIPSTLSConfiguration(tlsConfiguration)...)
+	}
+
 	if tlsConfiguration.MinTLSVersion == v1.VersionTLS13 || tlsConfiguration.MinTLSVersion == "" {
 		if len(tlsConfiguration.Ciphers) > 0 {
 			statuses = append(statuses, metav1.StatusCause{
@@ -273,6 +324,37 @@ func validateTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.St
 	return statuses
 }
 
+func validateFIPSTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.StatusCause {
+	var statuses []metav1.StatusCause
+
+	if tlsConfiguration.MinTLSVersion == v1.VersionTLS13 && !kvtls.FIPSModuleEnabled() {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "VersionTLS13 requires a FIPS 140 validated module when spec.configuration.tlsConfiguration.fipsMode is enabled",
+			Field:   "spec.configuration.tlsConfiguration.minTLSVersion",
+		})
+	} else if !kvtls.IsFIPSTLSVersion(tlsConfiguration.MinTLSVersion) {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not allowed when spec.configuration.tlsConfiguration.fipsMode is enabled", tlsConfiguration.MinTLSVersion),
+			Field:   "spec.configuration.tlsConfiguration.minTLSVersion",
+		})
+	}
+
+	var idByName = kvtls.CipherSuiteNameMap()
+	for index, cipher := range tlsConfiguration.Ciphers {
+		if _, exists := idByName[cipher]; exists && !kvtls.IsFIPSCipherSuite(cipher) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is not a FIPS approved cipher", cipher),
+				Field:   fmt.Sprintf("spec.configuration.tlsConfiguration.ciphers#%d", index),
+			})
+		}
+	}
+
+	return statuses
+}
+
 func validateSeccompConfiguration(field *field.Path, seccompConf *v1.SeccompConfiguration) []metav1.StatusCause {
 	statuses := []metav1.StatusCause{}
 	if seccompConf == nil || seccompConf.VirtualMachineInstanceProfile == nil {
@@ -432,6 +514,273 @@ func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	return statuses
 }
 
+func validateCrashLoopBackOffConfiguration(field *field.Path, crashLoopConf *v1.CrashLoopBackOffConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	if crashLoopConf.MaxDelaySeconds != nil && *crashLoopConf.MaxDelaySeconds <= 0 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("maxDelaySeconds").String(),
+			Message: fmt.Sprintf("%s must be greater than 0", field.Child("maxDelaySeconds").String()),
+		})
+	}
+
+	if crashLoopConf.MinRunDurationSeconds != nil && *crashLoopConf.MinRunDurationSeconds < 0 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("minRunDurationSeconds").String(),
+			Message: fmt.Sprintf("%s cannot be negative", field.Child("minRunDurationSeconds").String()),
+		})
+	}
+
+	for i, limit := range crashLoopConf.RestartLimits {
+		limitField := field.Child("restartLimits").Index(i)
+		if limit.RunStrategy != v1.RunStrategyAlways && limit.RunStrategy != v1.RunStrategyRerunOnFailure {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   limitField.Child("runStrategy").String(),
+				Message: fmt.Sprintf("%s must be either %s or %s", limitField.Child("runStrategy").String(), v1.RunStrategyAlways, v1.RunStrategyRerunOnFailure),
+			})
+		}
+		if limit.MaxConsecutiveFailures <= 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   limitField.Child("maxConsecutiveFailures").String(),
+				Message: fmt.Sprintf("%s must be greater than 0", limitField.Child("maxConsecutiveFailures").String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateFirmwareImages(field *field.Path, firmwareImages []v1.FirmwareImage) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	names := map[string]struct{}{}
+	for i, image := range firmwareImages {
+		imageField := field.Index(i)
+		if image.Name == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   imageField.Child("name").String(),
+				Message: fmt.Sprintf("%s is required", imageField.Child("name").String()),
+			})
+		} else if _, exists := names[image.Name]; exists {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   imageField.Child("name").String(),
+				Message: fmt.Sprintf("%s %q is used by multiple firmware images", imageField.Child("name").String(), image.Name),
+			})
+		}
+		names[image.Name] = struct{}{}
+
+		if image.Image == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   imageField.Child("image").String(),
+				Message: fmt.Sprintf("%s is required", imageField.Child("image").String()),
+			})
+		}
+
+		if image.Path != "" && !filepath.IsAbs(image.Path) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   imageField.Child("path").String(),
+				Message: fmt.Sprintf("%s must be an absolute path", imageField.Child("path").String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateInstancetypeUpdateStrategy(field *field.Path, updateStrategy *v1.InstancetypeUpdateStrategy) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	for i, method := range updateStrategy.Methods {
+		if method != v1.InstancetypeUpdateMethodLiveMigrate && method != v1.InstancetypeUpdateMethodRestart {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   field.Child("methods").Index(i).String(),
+				Message: fmt.Sprintf("%s must be either %s or %s", field.Child("methods").Index(i).String(), v1.InstancetypeUpdateMethodLiveMigrate, v1.InstancetypeUpdateMethodRestart),
+			})
+		}
+	}
+
+	if updateStrategy.MaxUnavailable != nil {
+		maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(updateStrategy.MaxUnavailable, 100, false)
+		if err != nil || maxUnavailable <= 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("maxUnavailable").String(),
+				Message: fmt.Sprintf("%s must be a number or a percentage greater than 0", field.Child("maxUnavailable").String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateToolsDisk(field *field.Path, toolsDisk *v1.ToolsDiskConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	if toolsDisk.Image == "" {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   field.Child("image").String(),
+			Message: fmt.Sprintf("%s is required", field.Child("image").String()),
+		})
+	}
+
+	if toolsDisk.NamespaceLabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(toolsDisk.NamespaceLabelSelector); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child("namespaceLabelSelector").String(),
+				Message: fmt.Sprintf("%s is invalid: %v", field.Child("namespaceLabelSelector").String(), err),
+			})
+		}
+	}
+
+	return statuses
+}
+
+// selinuxTypeRegex matches the SELinux types which can be set in the SELinux options of a container
+var selinuxTypeRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_.]*$`)
+
+func validateSecurityProfiles(field *field.Path, securityProfiles *v1.SecurityProfilesConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	// The kubelet resolves localhost profiles relative to its seccomp profile root, they must not leave it
+	for i, profile := range securityProfiles.AllowedSeccompLocalhostProfiles {
+		profileField := field.Child("allowedSeccompLocalhostProfiles").Index(i)
+		if profile == "" || filepath.IsAbs(profile) || slices.Contains(strings.Split(profile, "/"), "..") {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   profileField.String(),
+				Message: fmt.Sprintf("%s must be a relative path without '..'", profileField.String()),
+			})
+		}
+	}
+
+	for i, selinuxType := range securityProfiles.AllowedSELinuxTypes {
+		typeField := field.Child("allowedSELinuxTypes").Index(i)
+		if !selinuxTypeRegex.MatchString(selinuxType) {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   typeField.String(),
+				Message: fmt.Sprintf("%s must be a valid SELinux type", typeField.String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateExportOIDC(field *field.Path, exportOIDC *v1.ExportOIDCConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	issuerURL, err := url.Parse(exportOIDC.IssuerURL)
+	if err != nil || issuerURL.Scheme != "https" || issuerURL.Host == "" || issuerURL.RawQuery != "" || issuerURL.Fragment != "" {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("issuerURL").String(),
+			Message: fmt.Sprintf("%s must be an https URL without query and fragment", field.Child("issuerURL").String()),
+		})
+	}
+
+	if exportOIDC.ClientID == "" {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   field.Child("clientID").String(),
+			Message: fmt.Sprintf("%s is required", field.Child("clientID").String()),
+		})
+	}
+
+	// The issuer's users can't be trusted all alike, every group has to be bound to the namespaces it can export from
+	if len(exportOIDC.GroupBindings) == 0 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   field.Child("groupBindings").String(),
+			Message: fmt.Sprintf("%s is required", field.Child("groupBindings").String()),
+		})
+	}
+	for i, binding := range exportOIDC.GroupBindings {
+		bindingField := field.Child("groupBindings").Index(i)
+		if binding.Group == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   bindingField.Child("group").String(),
+				Message: fmt.Sprintf("%s is required", bindingField.Child("group").String()),
+			})
+		}
+		if len(binding.Namespaces) == 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   bindingField.Child("namespaces").String(),
+				Message: fmt.Sprintf("%s is required", bindingField.Child("namespaces").String()),
+			})
+		}
+		for j, namespace := range binding.Namespaces {
+			namespaceField := bindingField.Child("namespaces").Index(j)
+			if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   namespaceField.String(),
+					Message: fmt.Sprintf("%s must be a valid namespace name", namespaceField.String()),
+				})
+			}
+		}
+	}
+
+	return statuses
+}
+
+func validateSubresourceRateLimits(field *field.Path, rateLimits *v1.SubresourceRateLimitsConfiguration) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	limits := []struct {
+		name  string
+		limit *v1.TokenBucketRateLimiter
+	}{
+		{"perUser", rateLimits.PerUser},
+		{"perNamespace", rateLimits.PerNamespace},
+	}
+	for _, l := range limits {
+		if l.limit == nil {
+			continue
+		}
+		if l.limit.QPS <= 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child(l.name, "qps").String(),
+				Message: fmt.Sprintf("%s must be greater than 0", field.Child(l.name, "qps").String()),
+			})
+		}
+		if l.limit.Burst <= 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   field.Child(l.name, "burst").String(),
+				Message: fmt.Sprintf("%s must be greater than 0", field.Child(l.name, "burst").String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateStreamDrainSeconds(field *field.Path, streamDrainSeconds int64) []metav1.StatusCause {
+	if streamDrainSeconds < 0 || streamDrainSeconds > virtconfig.MaxStreamDrainSeconds {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s must be between 0 and %d", field.String(), virtconfig.MaxStreamDrainSeconds),
+		}}
+	}
+	return nil
+}
+
 func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 	currDevConfig := currKVSpec.Configuration.DeveloperConfiguration
 	newDevConfig := newKVSpec.Configuration.DeveloperConfiguration
@@ -31,12 +31,14 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
+	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
 )
 
 var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
@@ -68,6 +70,225 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		}, []string{vmProfileField.Child("customProfile", "runtimeDefaultProfile").String(), vmProfileField.Child("customProfile", "localhostProfile").String()}),
 	)
 
+	crashLoopField := test.Child("crashLoopBackOff")
+
+	DescribeTable("validateCrashLoopBackOffConfiguration", func(crashLoopConfiguration *v1.CrashLoopBackOffConfiguration, expectedFields []string) {
+		causes := validateCrashLoopBackOffConfiguration(crashLoopField, crashLoopConfiguration)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept an empty configuration", &v1.CrashLoopBackOffConfiguration{}, nil),
+		Entry("accept a valid configuration", &v1.CrashLoopBackOffConfiguration{
+			MaxDelaySeconds:       pointer.Int(600),
+			MinRunDurationSeconds: pointer.Int64(30),
+			RestartLimits: []v1.RestartLimit{
+				{RunStrategy: v1.RunStrategyAlways, MaxConsecutiveFailures: 5},
+				{RunStrategy: v1.RunStrategyRerunOnFailure, MaxConsecutiveFailures: 3},
+			},
+		}, nil),
+		Entry("reject a non positive maxDelaySeconds", &v1.CrashLoopBackOffConfiguration{
+			MaxDelaySeconds: pointer.Int(0),
+		}, []string{crashLoopField.Child("maxDelaySeconds").String()}),
+		Entry("reject a negative minRunDurationSeconds", &v1.CrashLoopBackOffConfiguration{
+			MinRunDurationSeconds: pointer.Int64(-1),
+		}, []string{crashLoopField.Child("minRunDurationSeconds").String()}),
+		Entry("reject restart limits for run strategies which do not restart", &v1.CrashLoopBackOffConfiguration{
+			RestartLimits: []v1.RestartLimit{
+				{RunStrategy: v1.RunStrategyManual, MaxConsecutiveFailures: 5},
+			},
+		}, []string{crashLoopField.Child("restartLimits").Index(0).Child("runStrategy").String()}),
+		Entry("reject a non positive maxConsecutiveFailures", &v1.CrashLoopBackOffConfiguration{
+			RestartLimits: []v1.RestartLimit{
+				{RunStrategy: v1.RunStrategyAlways, MaxConsecutiveFailures: 0},
+			},
+		}, []string{crashLoopField.Child("restartLimits").Index(0).Child("maxConsecutiveFailures").String()}),
+	)
+
+	firmwareImagesField := test.Child("firmwareImages")
+
+	DescribeTable("validateFirmwareImages", func(firmwareImages []v1.FirmwareImage, expectedFields []string) {
+		causes := validateFirmwareImages(firmwareImagesField, firmwareImages)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept valid images", []v1.FirmwareImage{
+			{Name: "ovmf-stable", Image: "registry:5000/ovmf:stable"},
+			{Name: "ovmf-custom", Image: "registry:5000/ovmf:custom", Path: "/firmware"},
+		}, nil),
+		Entry("reject an image without name", []v1.FirmwareImage{
+			{Image: "registry:5000/ovmf:stable"},
+		}, []string{firmwareImagesField.Index(0).Child("name").String()}),
+		Entry("reject duplicate names", []v1.FirmwareImage{
+			{Name: "ovmf", Image: "registry:5000/ovmf:stable"},
+			{Name: "ovmf", Image: "registry:5000/ovmf:custom"},
+		}, []string{firmwareImagesField.Index(1).Child("name").String()}),
+		Entry("reject an image without image", []v1.FirmwareImage{
+			{Name: "ovmf"},
+		}, []string{firmwareImagesField.Index(0).Child("image").String()}),
+		Entry("reject a relative path", []v1.FirmwareImage{
+			{Name: "ovmf", Image: "registry:5000/ovmf:stable", Path: "firmware"},
+		}, []string{firmwareImagesField.Index(0).Child("path").String()}),
+	)
+
+	instancetypeUpdateField := test.Child("instancetypeUpdateStrategy")
+
+	DescribeTable("validateInstancetypeUpdateStrategy", func(updateStrategy *v1.InstancetypeUpdateStrategy, expectedFields []string) {
+		causes := validateInstancetypeUpdateStrategy(instancetypeUpdateField, updateStrategy)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid strategy", &v1.InstancetypeUpdateStrategy{
+			Methods:        []v1.InstancetypeUpdateMethod{v1.InstancetypeUpdateMethodLiveMigrate, v1.InstancetypeUpdateMethodRestart},
+			MaxUnavailable: &[]intstr.IntOrString{intstr.FromString("20%")}[0],
+		}, nil),
+		Entry("reject an unknown method", &v1.InstancetypeUpdateStrategy{
+			Methods: []v1.InstancetypeUpdateMethod{v1.InstancetypeUpdateMethodRestart, "Evict"},
+		}, []string{instancetypeUpdateField.Child("methods").Index(1).String()}),
+		Entry("reject a zero maxUnavailable", &v1.InstancetypeUpdateStrategy{
+			MaxUnavailable: &[]intstr.IntOrString{intstr.FromInt32(0)}[0],
+		}, []string{instancetypeUpdateField.Child("maxUnavailable").String()}),
+		Entry("reject an invalid percentage", &v1.InstancetypeUpdateStrategy{
+			MaxUnavailable: &[]intstr.IntOrString{intstr.FromString("twenty")}[0],
+		}, []string{instancetypeUpdateField.Child("maxUnavailable").String()}),
+	)
+
+	toolsDiskField := test.Child("toolsDisk")
+
+	DescribeTable("validateToolsDisk", func(toolsDisk *v1.ToolsDiskConfiguration, expectedFields []string) {
+		causes := validateToolsDisk(toolsDiskField, toolsDisk)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid tools disk", &v1.ToolsDiskConfiguration{
+			Image:                  "registry:5000/tools:latest",
+			NamespaceLabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tools": "true"}},
+		}, nil),
+		Entry("reject a tools disk without image", &v1.ToolsDiskConfiguration{}, []string{toolsDiskField.Child("image").String()}),
+		Entry("reject an invalid namespace label selector", &v1.ToolsDiskConfiguration{
+			Image: "registry:5000/tools:latest",
+			NamespaceLabelSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "tools", Operator: "Matches"},
+			}},
+		}, []string{toolsDiskField.Child("namespaceLabelSelector").String()}),
+	)
+
+	securityProfilesField := test.Child("securityProfiles")
+
+	DescribeTable("validateSecurityProfiles", func(securityProfiles *v1.SecurityProfilesConfiguration, expectedFields []string) {
+		causes := validateSecurityProfiles(securityProfilesField, securityProfiles)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, cause := range causes {
+			Expect(cause.Field).To(Equal(expectedFields[i]))
+		}
+	},
+		Entry("accept a valid safelist", &v1.SecurityProfilesConfiguration{
+			AllowedSeccompLocalhostProfiles: []string{"kubevirt/hardened.json", "profile.json"},
+			AllowedSELinuxTypes:             []string{"virt_launcher_hardened.process", "container_t"},
+		}, nil),
+		Entry("reject an empty seccomp profile", &v1.SecurityProfilesConfiguration{
+			AllowedSeccompLocalhostProfiles: []string{"kubevirt/hardened.json", ""},
+		}, []string{securityProfilesField.Child("allowedSeccompLocalhostProfiles").Index(1).String()}),
+		Entry("reject an absolute seccomp profile path", &v1.SecurityProfilesConfiguration{
+			AllowedSeccompLocalhostProfiles: []string{"/etc/seccomp/hardened.json"},
+		}, []string{securityProfilesField.Child("allowedSeccompLocalhostProfiles").Index(0).String()}),
+		Entry("reject a seccomp profile path leaving the profile root", &v1.SecurityProfilesConfiguration{
+			AllowedSeccompLocalhostProfiles: []string{"kubevirt/../../hardened.json"},
+		}, []string{securityProfilesField.Child("allowedSeccompLocalhostProfiles").Index(0).String()}),
+		Entry("reject an empty SELinux type", &v1.SecurityProfilesConfiguration{
+			AllowedSELinuxTypes: []string{""},
+		}, []string{securityProfilesField.Child("allowedSELinuxTypes").Index(0).String()}),
+		Entry("reject an invalid SELinux type", &v1.SecurityProfilesConfiguration{
+			AllowedSELinuxTypes: []string{"container_t", "system_u:system_r:container_t:s0"},
+		}, []string{securityProfilesField.Child("allowedSELinuxTypes").Index(1).String()}),
+	)
+
+	exportOIDCField := test.Child("exportOIDC")
+
+	DescribeTable("validateExportOIDC", func(exportOIDC *v1.ExportOIDCConfiguration, expectedFields []string) {
+		causes := validateExportOIDC(exportOIDCField, exportOIDC)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("accept a valid issuer", &v1.ExportOIDCConfiguration{
+			IssuerURL:     "https://sso.example.com/realms/kubevirt",
+			ClientID:      "export",
+			GroupBindings: []v1.ExportOIDCGroupBinding{{Group: "kubevirt-exporters", Namespaces: []string{"default"}}},
+		}, nil),
+		Entry("reject an issuer without https", &v1.ExportOIDCConfiguration{
+			IssuerURL:     "http://sso.example.com",
+			ClientID:      "export",
+			GroupBindings: []v1.ExportOIDCGroupBinding{{Group: "kubevirt-exporters", Namespaces: []string{"default"}}},
+		}, []string{exportOIDCField.Child("issuerURL").String()}),
+		Entry("reject an issuer with a query", &v1.ExportOIDCConfiguration{
+			IssuerURL:     "https://sso.example.com?realm=kubevirt",
+			ClientID:      "export",
+			GroupBindings: []v1.ExportOIDCGroupBinding{{Group: "kubevirt-exporters", Namespaces: []string{"default"}}},
+		}, []string{exportOIDCField.Child("issuerURL").String()}),
+		Entry("reject a missing client ID", &v1.ExportOIDCConfiguration{
+			IssuerURL:     "https://sso.example.com",
+			GroupBindings: []v1.ExportOIDCGroupBinding{{Group: "kubevirt-exporters", Namespaces: []string{"default"}}},
+		}, []string{exportOIDCField.Child("clientID").String()}),
+		Entry("reject missing group bindings", &v1.ExportOIDCConfiguration{
+			IssuerURL: "https://sso.example.com",
+			ClientID:  "export",
+		}, []string{exportOIDCField.Child("groupBindings").String()}),
+		Entry("reject a group binding without group", &v1.ExportOIDCConfiguration{
+			IssuerURL:     "https://sso.example.com",
+			ClientID:      "export",
+			GroupBindings: []v1.ExportOIDCGroupBinding{{Namespaces: []string{"default"}}},
+		}, []string{exportOIDCField.Child("groupBindings").Index(0).Child("group").String()}),
+		Entry("reject a group binding without namespaces", &v1.ExportOIDCConfiguration{
+			IssuerURL:     "https://sso.example.com",
+			ClientID:      "export",
+			GroupBindings: []v1.ExportOIDCGroupBinding{{Group: "kubevirt-exporters"}},
+		}, []string{exportOIDCField.Child("groupBindings").Index(0).Child("namespaces").String()}),
+		Entry("reject a group binding with an invalid namespace", &v1.ExportOIDCConfiguration{
+			IssuerURL:     "https://sso.example.com",
+			ClientID:      "export",
+			GroupBindings: []v1.ExportOIDCGroupBinding{{Group: "kubevirt-exporters", Namespaces: []string{"default", "Invalid_Namespace"}}},
+		}, []string{exportOIDCField.Child("groupBindings").Index(0).Child("namespaces").Index(1).String()}),
+	)
+
+	rateLimitsField := test.Child("subresourceRateLimits")
+
+	DescribeTable("validateSubresourceRateLimits", func(rateLimits *v1.SubresourceRateLimitsConfiguration, expectedFields []string) {
+		causes := validateSubresourceRateLimits(rateLimitsField, rateLimits)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for i, cause := range causes {
+			Expect(cause.Field).To(Equal(expectedFields[i]))
+		}
+	},
+		Entry("accept valid limits", &v1.SubresourceRateLimitsConfiguration{
+			PerUser:      &v1.TokenBucketRateLimiter{QPS: 0.5, Burst: 5},
+			PerNamespace: &v1.TokenBucketRateLimiter{QPS: 2, Burst: 20},
+		}, nil),
+		Entry("reject a per-user limit without QPS", &v1.SubresourceRateLimitsConfiguration{
+			PerUser: &v1.TokenBucketRateLimiter{Burst: 5},
+		}, []string{rateLimitsField.Child("perUser", "qps").String()}),
+		Entry("reject a per-namespace limit without burst", &v1.SubresourceRateLimitsConfiguration{
+			PerNamespace: &v1.TokenBucketRateLimiter{QPS: 2, Burst: -1},
+		}, []string{rateLimitsField.Child("perNamespace", "burst").String()}),
+	)
+
+	DescribeTable("validateStreamDrainSeconds", func(streamDrainSeconds int64, expectedCauses int) {
+		Expect(validateStreamDrainSeconds(test.Child("streamDrainSeconds"), streamDrainSeconds)).To(HaveLen(expectedCauses))
+	},
+		Entry("accept 0", int64(0), 0),
+		Entry("accept the maximum", int64(300), 0),
+		Entry("reject negative values", int64(-1), 1),
+		Entry("reject values above the maximum", int64(301), 1),
+	)
+
 	DescribeTable("test validateCustomizeComponents", func(cc v1.CustomizeComponents, expectedCauses int) {
 		causes := validateCustomizeComponents(cc)
 		Expect(causes).To(HaveLen(expectedCauses))
@@ -136,6 +357,61 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 				1,
 			),
 		)
+
+		Context("and FIPS mode", func() {
+			It("should reject a minTLSVersion which is not FIPS approved", func() {
+				causes := validateTLSConfiguration(&v1.TLSConfiguration{FIPSMode: true, MinTLSVersion: v1.VersionTLS11})
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("VersionTLS11 is not allowed when spec.configuration.tlsConfiguration.fipsMode is enabled"))
+				Expect(causes[0].Field).To(Equal("spec.configuration.tlsConfiguration.minTLSVersion"))
+			})
+
+			It("should only accept minTLSVersion = 1.3 with a FIPS 140 validated module", func() {
+				causes := validateTLSConfiguration(&v1.TLSConfiguration{FIPSMode: true, MinTLSVersion: v1.VersionTLS13})
+				if kvtls.FIPSModuleEnabled() {
+					Expect(causes).To(BeEmpty())
+					return
+				}
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("VersionTLS13 requires a FIPS 140 validated module when spec.configuration.tlsConfiguration.fipsMode is enabled"))
+				Expect(causes[0].Field).To(Equal("spec.configuration.tlsConfiguration.minTLSVersion"))
+			})
+
+			It("should reject ciphers which are not FIPS approved", func() {
+				causes := validateTLSConfiguration(&v1.TLSConfiguration{
+					FIPSMode:      true,
+					MinTLSVersion: v1.VersionTLS12,
+					Ciphers: []string{
+						tls.CipherSuiteName(tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256),
+						tls.CipherSuiteName(tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256),
+						"NOT_VALID_CIPHER",
+					},
+				})
+				Expect(causes).To(ConsistOf(
+					metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueNotSupported,
+						Message: "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 is not a FIPS approved cipher",
+						Field:   "spec.configuration.tlsConfiguration.ciphers#1",
+					},
+					metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueNotSupported,
+						Message: "NOT_VALID_CIPHER is not a valid cipher",
+						Field:   "spec.configuration.tlsConfiguration.ciphers#2",
+					},
+				))
+			})
+
+			DescribeTable("should accept", func(tlsConfiguration *v1.TLSConfiguration) {
+				Expect(validateTLSConfiguration(tlsConfiguration)).To(BeEmpty())
+			},
+				Entry("the default settings", &v1.TLSConfiguration{FIPSMode: true}),
+				Entry("FIPS approved ciphers", &v1.TLSConfiguration{
+					FIPSMode:      true,
+					MinTLSVersion: v1.VersionTLS12,
+					Ciphers:       []string{tls.CipherSuiteName(tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384)},
+				}),
+			)
+		})
 	})
 
 	Context("with AdditionalGuestMemoryOverheadRatio", func() {
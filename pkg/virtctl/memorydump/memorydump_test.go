@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -317,11 +318,11 @@ var _ = Describe("MemoryDump", func() {
 		var (
 			vmExportClient *kubevirtfake.Clientset
 			server         *httptest.Server
+			outputFileFlag string
 		)
 		const (
-			secretName     = "secret-test-vme"
-			vmexportName   = "export-testvm-testpvc"
-			outputFileFlag = "--output=out.dump.gz"
+			secretName   = "secret-test-vme"
+			vmexportName = "export-testvm-testpvc"
 		)
 
 		waitForMemoryDumpDefault := func(kubecli.KubevirtClient, string, string, time.Duration, time.Duration) (string, error) {
@@ -353,6 +354,7 @@ var _ = Describe("MemoryDump", func() {
 
 		BeforeEach(func() {
 			vmExportClient = kubevirtfake.NewSimpleClientset()
+			outputFileFlag = "--output=" + filepath.Join(GinkgoT().TempDir(), "out.dump.gz")
 
 			kubecli.MockKubevirtClientInstance.EXPECT().StorageV1().Return(coreClient.StorageV1()).AnyTimes()
 			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineExport(k8smetav1.NamespaceDefault).Return(vmExportClient.ExportV1beta1().VirtualMachineExports(k8smetav1.NamespaceDefault)).AnyTimes()
@@ -473,12 +475,12 @@ var _ = Describe("MemoryDump", func() {
 			utils.HandleVMExportCreate(vmExportClient, vme)
 			utils.HandleServiceGet(coreClient, fmt.Sprintf("virt-export-%s", vme.Name), 443)
 			utils.HandlePodList(coreClient, fmt.Sprintf("virt-export-pod-%s", vme.Name))
-			cmd := clientcmd.NewVirtctlCommand(commandAndArgs...)
+			cmd := clientcmd.NewVirtctlCommand(append(commandAndArgs, outputFileFlag)...)
 			Expect(cmd.Execute()).To(Succeed())
 		},
-			Entry("with default port-forward", []string{"memory-dump", "download", "testvm", outputFileFlag, "--port-forward"}),
-			Entry("with port-forward specifying local port", []string{"memory-dump", "download", "testvm", outputFileFlag, "--port-forward", "--local-port", "5432"}),
-			Entry("with port-forward specifying default number on local port", []string{"memory-dump", "download", "testvm", outputFileFlag, "--port-forward", "--local-port", "0"}),
+			Entry("with default port-forward", []string{"memory-dump", "download", "testvm", "--port-forward"}),
+			Entry("with port-forward specifying local port", []string{"memory-dump", "download", "testvm", "--port-forward", "--local-port", "5432"}),
+			Entry("with port-forward specifying default number on local port", []string{"memory-dump", "download", "testvm", "--port-forward", "--local-port", "0"}),
 		)
 
 		It("should fail download memory dump if not completed succesfully", func() {
@@ -1,6 +1,7 @@
 package version
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -18,6 +19,7 @@ import (
 var (
 	cmd        *cobra.Command
 	clientOnly bool
+	health     bool
 )
 
 const versionsNotAlignedWarnMessage = "You are using a client virtctl version that is different from the KubeVirt version running in the cluster\nClient Version: %s\nServer Version: %s\n"
@@ -34,13 +36,16 @@ func VersionCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 		},
 	}
 	cmd.Flags().BoolVarP(&clientOnly, "client", "c", clientOnly, "Client version only (no server required).")
+	cmd.Flags().BoolVar(&health, "health", false, "Print the aggregated health of the KubeVirt install as JSON and fail if it is not healthy.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func usage() string {
 	usage := "  # Print the client and server versions for the current context:\n"
-	usage += "  {{ProgramName}} version"
+	usage += "  {{ProgramName}} version\n\n"
+	usage += "  # Print the aggregated health of the KubeVirt install, e.g. to gate an upgrade:\n"
+	usage += "  {{ProgramName}} version --health"
 	return usage
 }
 
@@ -49,6 +54,10 @@ type Version struct {
 }
 
 func (v *Version) Run() error {
+	if health {
+		return v.printHealth()
+	}
+
 	cmd.Printf("Client Version: %s\n", fmt.Sprintf("%#v", version.Get()))
 
 	if !clientOnly {
@@ -68,6 +77,29 @@ func (v *Version) Run() error {
 	return nil
 }
 
+func (v *Version) printHealth() error {
+	virCli, err := kubecli.GetKubevirtClientFromClientConfig(v.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	kvHealth, err := virCli.ServerVersion().Health()
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(kvHealth, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.Println(string(out))
+
+	if !kvHealth.Healthy {
+		return fmt.Errorf("KubeVirt is not healthy")
+	}
+	return nil
+}
+
 func CheckClientServerVersion(clientConfig *clientcmd.ClientConfig) {
 	clientVersion := version.Get()
 	virCli, err := kubecli.GetKubevirtClientFromClientConfig(*clientConfig)
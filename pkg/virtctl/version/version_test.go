@@ -10,22 +10,25 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	virt_version "kubevirt.io/client-go/version"
 
 	"kubevirt.io/kubevirt/pkg/virtctl"
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
+	"kubevirt.io/kubevirt/tests/clientcmd"
 )
 
 var _ = Describe("Version", func() {
 
 	var ctrl *gomock.Controller
+	var serverVersionInterface *kubecli.MockServerVersionInterface
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
 		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
-		serverVersionInterface := kubecli.NewMockServerVersionInterface(ctrl)
+		serverVersionInterface = kubecli.NewMockServerVersionInterface(ctrl)
 		kubecli.MockKubevirtClientInstance.EXPECT().ServerVersion().Return(serverVersionInterface).AnyTimes()
 		serverVersionInterface.EXPECT().Get().Return(&virt_version.Info{
 			GitVersion:   "v0.46.1",
@@ -59,4 +62,36 @@ var _ = Describe("Version", func() {
 		})
 
 	})
+
+	Context("with --health", func() {
+		It("should print the health of the install", func() {
+			serverVersionInterface.EXPECT().Health().Return(&v1.KubeVirtHealth{
+				Healthy: true,
+				Ready:   true,
+				Phase:   v1.KubeVirtPhaseDeployed,
+			}, nil)
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("version", "--health")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring(`"healthy": true`))
+			Expect(string(out)).ToNot(ContainSubstring("Client Version"))
+		})
+
+		It("should fail if the install is not healthy", func() {
+			serverVersionInterface.EXPECT().Health().Return(&v1.KubeVirtHealth{
+				Webhooks: []v1.KubeVirtWebhookHealth{{Name: "virt-api-validator", Message: "webhook configuration not found"}},
+			}, nil)
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("version", "--health")()
+			Expect(err).To(MatchError("KubeVirt is not healthy"))
+			Expect(string(out)).To(ContainSubstring("webhook configuration not found"))
+		})
+
+		It("should fail if the health can not be fetched", func() {
+			serverVersionInterface.EXPECT().Health().Return(nil, fmt.Errorf("forbidden"))
+
+			_, err := clientcmd.NewRepeatableVirtctlCommandWithOut("version", "--health")()
+			Expect(err).To(MatchError("forbidden"))
+		})
+	})
 })
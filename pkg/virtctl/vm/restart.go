@@ -22,21 +22,36 @@ package vm
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/tools/clientcmd"
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
-const COMMAND_RESTART = "restart"
+const (
+	COMMAND_RESTART = "restart"
+
+	methodArg                 = "method"
+	restartMethodMigrateFirst = "migrate-first"
+
+	migrationWaitInterval = 2 * time.Second
+	migrationWaitTimeout  = 30 * time.Minute
+)
+
+var restartMethod string
 
 func NewRestartCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "restart (VM)",
 		Short:   "Restart a virtual machine.",
-		Example: usage(COMMAND_RESTART),
+		Example: usageRestart(),
 		Args:    templates.ExactArgs("restart", 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c := Command{command: COMMAND_RESTART, clientConfig: clientConfig}
@@ -46,10 +61,18 @@ func NewRestartCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd.Flags().BoolVar(&forceRestart, forceArg, false, "--force=false: Only used when grace-period=0. If true, immediately remove VMI pod from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation.")
 	cmd.Flags().Int64Var(&gracePeriod, gracePeriodArg, -1, "--grace-period=-1: Period of time in seconds given to the VMI to terminate gracefully. Can only be set to 0 when --force is true (force deletion). Currently only setting 0 is supported.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.Flags().StringVar(&restartMethod, methodArg, "", "--method=migrate-first: Live migrate the VM to another node first and restart the guest there with a soft reboot, instead of restarting the VM on a possibly unhealthy node.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
+func usageRestart() string {
+	return usage(COMMAND_RESTART) + `
+
+  # Live migrate a virtual machine called 'myvm' to another node and restart it there:
+  {{ProgramName}} restart myvm --method=migrate-first`
+}
+
 func (o *Command) restartRun(args []string, cmd *cobra.Command) error {
 	vmiName := args[0]
 
@@ -65,6 +88,17 @@ func (o *Command) restartRun(args []string, cmd *cobra.Command) error {
 		return fmt.Errorf("Must both use --force=true and set --grace-period.")
 	}
 
+	switch restartMethod {
+	case "":
+	case restartMethodMigrateFirst:
+		if forceRestart {
+			return fmt.Errorf("Can not use --force together with --%s=%s.", methodArg, restartMethodMigrateFirst)
+		}
+		return o.migrateFirstRestart(virtClient, namespace, vmiName, dryRunOption)
+	default:
+		return fmt.Errorf("Unsupported restart method %q, only %s is supported.", restartMethod, restartMethodMigrateFirst)
+	}
+
 	if forceRestart {
 		err = virtClient.VirtualMachine(namespace).ForceRestart(context.Background(), vmiName, &v1.RestartOptions{GracePeriodSeconds: &gracePeriod, DryRun: dryRunOption})
 		if err != nil {
@@ -81,3 +115,55 @@ func (o *Command) restartRun(args []string, cmd *cobra.Command) error {
 
 	return nil
 }
+
+// migrateFirstRestart live migrates the VM away from its current node, waits for the migration
+// to complete and then soft reboots the guest on the target node. A regular restart would let
+// the VMI be scheduled anywhere again, including the node it is meant to escape.
+func (o *Command) migrateFirstRestart(virtClient kubecli.KubevirtClient, namespace, vmName string, dryRunOption []string) error {
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(context.Background(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Error getting VirtualMachineInstance %v", err)
+	}
+	sourceNode := vmi.Status.NodeName
+	var previousMigrationUID types.UID
+	if vmi.Status.MigrationState != nil {
+		previousMigrationUID = vmi.Status.MigrationState.MigrationUID
+	}
+
+	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmName, &v1.MigrateOptions{DryRun: dryRunOption})
+	if err != nil {
+		return fmt.Errorf("Error migrating VirtualMachine %v", err)
+	}
+	if len(dryRunOption) > 0 {
+		fmt.Printf("VM %s was scheduled to migrate and %s\n", vmName, o.command)
+		return nil
+	}
+
+	fmt.Printf("Waiting for VM %s to migrate away from node %s...\n", vmName, sourceNode)
+	err = wait.PollImmediate(migrationWaitInterval, migrationWaitTimeout, func() (bool, error) {
+		vmi, err = virtClient.VirtualMachineInstance(namespace).Get(context.Background(), vmName, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+
+		migrationState := vmi.Status.MigrationState
+		if migrationState == nil || migrationState.MigrationUID == previousMigrationUID || !migrationState.Completed {
+			return false, nil
+		}
+		if migrationState.Failed {
+			return false, fmt.Errorf("Migration of VirtualMachine %s failed, it was not restarted", vmName)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error waiting for the migration of VirtualMachine %s: %v", vmName, err)
+	}
+
+	if err := virtClient.VirtualMachineInstance(namespace).SoftReboot(context.Background(), vmName); err != nil {
+		return fmt.Errorf("Error restarting VirtualMachine %s after migrating it to node %s: %v", vmName, vmi.Status.NodeName, err)
+	}
+
+	fmt.Printf("VM %s was migrated from node %s to node %s and scheduled to %s\n", vmName, sourceNode, vmi.Status.NodeName, o.command)
+
+	return nil
+}
@@ -28,6 +28,7 @@ import (
 
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/tests/clientcmd"
@@ -35,6 +36,7 @@ import (
 
 var _ = Describe("Restart command", func() {
 	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
 	var ctrl *gomock.Controller
 	const vmName = "testvm"
 
@@ -43,6 +45,7 @@ var _ = Describe("Restart command", func() {
 		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
 		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
 		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
 	})
 
 	It("should fail with missing input parameters", func() {
@@ -89,4 +92,66 @@ var _ = Describe("Restart command", func() {
 		cmd := clientcmd.NewRepeatableVirtctlCommand("restart", vmName, "--force", "--grace-period=0")
 		Expect(cmd()).To(Succeed())
 	})
+
+	Context("with --method=migrate-first", func() {
+		newVMI := func(nodeName string, migrationState *v1.VirtualMachineInstanceMigrationState) *v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMI(vmName)
+			vmi.Status.NodeName = nodeName
+			vmi.Status.MigrationState = migrationState
+			return vmi
+		}
+
+		BeforeEach(func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		})
+
+		It("should migrate the VM and then soft reboot it on the target node", func() {
+			previousMigration := &v1.VirtualMachineInstanceMigrationState{MigrationUID: "old", Completed: true}
+			gomock.InOrder(
+				vmiInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(newVMI("node01", previousMigration), nil),
+				vmInterface.EXPECT().Migrate(context.Background(), vmName, &v1.MigrateOptions{}).Return(nil),
+				vmiInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(
+					newVMI("node02", &v1.VirtualMachineInstanceMigrationState{MigrationUID: "new", Completed: true}), nil),
+				vmiInterface.EXPECT().SoftReboot(context.Background(), vmName).Return(nil),
+			)
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand("restart", vmName, "--method=migrate-first")
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should not restart the VM if the migration failed", func() {
+			gomock.InOrder(
+				vmiInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(newVMI("node01", nil), nil),
+				vmInterface.EXPECT().Migrate(context.Background(), vmName, &v1.MigrateOptions{}).Return(nil),
+				vmiInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(
+					newVMI("node01", &v1.VirtualMachineInstanceMigrationState{MigrationUID: "new", Completed: true, Failed: true}), nil),
+			)
+			vmiInterface.EXPECT().SoftReboot(gomock.Any(), gomock.Any()).Times(0)
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand("restart", vmName, "--method=migrate-first")
+			err := cmd()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Migration of VirtualMachine testvm failed"))
+		})
+
+		It("should only dry run the migration with --dry-run", func() {
+			vmiInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(newVMI("node01", nil), nil)
+			vmInterface.EXPECT().Migrate(context.Background(), vmName, &v1.MigrateOptions{DryRun: []string{k8smetav1.DryRunAll}}).Return(nil)
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand("restart", vmName, "--method=migrate-first", "--dry-run")
+			Expect(cmd()).To(Succeed())
+		})
+
+		It("should fail together with --force", func() {
+			cmd := clientcmd.NewRepeatableVirtctlCommand("restart", vmName, "--method=migrate-first", "--force", "--grace-period=0")
+			Expect(cmd()).To(MatchError("Can not use --force together with --method=migrate-first."))
+		})
+	})
+
+	It("should fail with an unsupported method", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		cmd := clientcmd.NewRepeatableVirtctlCommand("restart", vmName, "--method=unknown")
+		Expect(cmd()).To(MatchError(`Unsupported restart method "unknown", only migrate-first is supported.`))
+	})
 })
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -342,6 +343,123 @@ func (in *CPUTopology) DeepCopy() *CPUTopology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecast) DeepCopyInto(out *CapacityForecast) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]CapacityForecastNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Unplaceable != nil {
+		in, out := &in.Unplaceable, &out.Unplaceable
+		*out = make([]CapacityForecastUnplaceable, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecast.
+func (in *CapacityForecast) DeepCopy() *CapacityForecast {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecast)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CapacityForecast) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecastNode) DeepCopyInto(out *CapacityForecastNode) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Remaining != nil {
+		in, out := &in.Remaining, &out.Remaining
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecastNode.
+func (in *CapacityForecastNode) DeepCopy() *CapacityForecastNode {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecastNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecastOptions) DeepCopyInto(out *CapacityForecastOptions) {
+	*out = *in
+	if in.VirtualMachines != nil {
+		in, out := &in.VirtualMachines, &out.VirtualMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Instancetype != nil {
+		in, out := &in.Instancetype, &out.Instancetype
+		*out = new(InstancetypeMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preference != nil {
+		in, out := &in.Preference, &out.Preference
+		*out = new(PreferenceMatcher)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecastOptions.
+func (in *CapacityForecastOptions) DeepCopy() *CapacityForecastOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecastOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityForecastUnplaceable) DeepCopyInto(out *CapacityForecastUnplaceable) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityForecastUnplaceable.
+func (in *CapacityForecastUnplaceable) DeepCopy() *CapacityForecastUnplaceable {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityForecastUnplaceable)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertConfig) DeepCopyInto(out *CertConfig) {
 	*out = *in
@@ -384,6 +502,22 @@ func (in *Chassis) DeepCopy() *Chassis {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimRequest) DeepCopyInto(out *ClaimRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimRequest.
+func (in *ClaimRequest) DeepCopy() *ClaimRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientPassthroughDevices) DeepCopyInto(out *ClientPassthroughDevices) {
 	*out = *in
@@ -656,6 +790,37 @@ func (in *ContainerDiskSource) DeepCopy() *ContainerDiskSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrashLoopBackOffConfiguration) DeepCopyInto(out *CrashLoopBackOffConfiguration) {
+	*out = *in
+	if in.MaxDelaySeconds != nil {
+		in, out := &in.MaxDelaySeconds, &out.MaxDelaySeconds
+		*out = new(int)
+		**out = **in
+	}
+	if in.MinRunDurationSeconds != nil {
+		in, out := &in.MinRunDurationSeconds, &out.MinRunDurationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.RestartLimits != nil {
+		in, out := &in.RestartLimits, &out.RestartLimits
+		*out = make([]RestartLimit, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrashLoopBackOffConfiguration.
+func (in *CrashLoopBackOffConfiguration) DeepCopy() *CrashLoopBackOffConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CrashLoopBackOffConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomBlockSize) DeepCopyInto(out *CustomBlockSize) {
 	*out = *in
@@ -924,6 +1089,48 @@ func (in *DeveloperConfiguration) DeepCopy() *DeveloperConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceStatus) DeepCopyInto(out *DeviceStatus) {
+	*out = *in
+	if in.GPUStatuses != nil {
+		in, out := &in.GPUStatuses, &out.GPUStatuses
+		*out = make([]DeviceStatusInfo, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostDeviceStatuses != nil {
+		in, out := &in.HostDeviceStatuses, &out.HostDeviceStatuses
+		*out = make([]DeviceStatusInfo, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceStatus.
+func (in *DeviceStatus) DeepCopy() *DeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeviceStatusInfo) DeepCopyInto(out *DeviceStatusInfo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeviceStatusInfo.
+func (in *DeviceStatusInfo) DeepCopy() *DeviceStatusInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(DeviceStatusInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Devices) DeepCopyInto(out *Devices) {
 	*out = *in
@@ -1028,7 +1235,9 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 	if in.HostDevices != nil {
 		in, out := &in.HostDevices, &out.HostDevices
 		*out = make([]HostDevice, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.ClientPassthrough != nil {
 		in, out := &in.ClientPassthrough, &out.ClientPassthrough
@@ -1385,6 +1594,22 @@ func (in *EmptyDiskSource) DeepCopy() *EmptyDiskSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EphemeralStorage) DeepCopyInto(out *EphemeralStorage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EphemeralStorage.
+func (in *EphemeralStorage) DeepCopy() *EphemeralStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(EphemeralStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EphemeralVolumeSource) DeepCopyInto(out *EphemeralVolumeSource) {
 	*out = *in
@@ -1406,6 +1631,50 @@ func (in *EphemeralVolumeSource) DeepCopy() *EphemeralVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportOIDCConfiguration) DeepCopyInto(out *ExportOIDCConfiguration) {
+	*out = *in
+	if in.GroupBindings != nil {
+		in, out := &in.GroupBindings, &out.GroupBindings
+		*out = make([]ExportOIDCGroupBinding, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportOIDCConfiguration.
+func (in *ExportOIDCConfiguration) DeepCopy() *ExportOIDCConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportOIDCConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportOIDCGroupBinding) DeepCopyInto(out *ExportOIDCGroupBinding) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportOIDCGroupBinding.
+func (in *ExportOIDCGroupBinding) DeepCopy() *ExportOIDCGroupBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportOIDCGroupBinding)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureAPIC) DeepCopyInto(out *FeatureAPIC) {
 	*out = *in
@@ -1712,6 +1981,38 @@ func (in *Firmware) DeepCopy() *Firmware {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirmwareImage) DeepCopyInto(out *FirmwareImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirmwareImage.
+func (in *FirmwareImage) DeepCopy() *FirmwareImage {
+	if in == nil {
+		return nil
+	}
+	out := new(FirmwareImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirmwareImageStatus) DeepCopyInto(out *FirmwareImageStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirmwareImageStatus.
+func (in *FirmwareImageStatus) DeepCopy() *FirmwareImageStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FirmwareImageStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Flags) DeepCopyInto(out *Flags) {
 	*out = *in
@@ -1778,6 +2079,11 @@ func (in *GPU) DeepCopyInto(out *GPU) {
 		*out = new(VGPUOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = new(ClaimRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -1839,6 +2145,65 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestNUMACell) DeepCopyInto(out *GuestNUMACell) {
+	*out = *in
+	if in.CPUs != nil {
+		in, out := &in.CPUs, &out.CPUs
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.HostNode != nil {
+		in, out := &in.HostNode, &out.HostNode
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestNUMACell.
+func (in *GuestNUMACell) DeepCopy() *GuestNUMACell {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestNUMACell)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestNUMAStatus) DeepCopyInto(out *GuestNUMAStatus) {
+	*out = *in
+	if in.Cells != nil {
+		in, out := &in.Cells, &out.Cells
+		*out = make([]GuestNUMACell, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestNUMAStatus.
+func (in *GuestNUMAStatus) DeepCopy() *GuestNUMAStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestNUMAStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -1899,6 +2264,11 @@ func (in *Handler) DeepCopy() *Handler {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = new(ClaimRequest)
+		**out = **in
+	}
 	return
 }
 
@@ -2103,6 +2473,32 @@ func (in *InstancetypeMatcher) DeepCopy() *InstancetypeMatcher {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstancetypeUpdateStrategy) DeepCopyInto(out *InstancetypeUpdateStrategy) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]InstancetypeUpdateMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstancetypeUpdateStrategy.
+func (in *InstancetypeUpdateStrategy) DeepCopy() *InstancetypeUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(InstancetypeUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Interface) DeepCopyInto(out *Interface) {
 	*out = *in
@@ -2313,14 +2709,40 @@ func (in *KVMTimer) DeepCopy() *KVMTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelArgsSource) DeepCopyInto(out *KernelArgsSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelArgsSource.
+func (in *KernelArgsSource) DeepCopy() *KernelArgsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelArgsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelBoot) DeepCopyInto(out *KernelBoot) {
 	*out = *in
+	if in.KernelArgsFrom != nil {
+		in, out := &in.KernelArgsFrom, &out.KernelArgsFrom
+		*out = new(KernelArgsSource)
+		**out = **in
+	}
 	if in.Container != nil {
 		in, out := &in.Container, &out.Container
 		*out = new(KernelBootContainer)
 		**out = **in
 	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(KernelBootVolume)
+		**out = **in
+	}
 	return
 }
 
@@ -2376,6 +2798,22 @@ func (in *KernelBootStatus) DeepCopy() *KernelBootStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KernelBootVolume) DeepCopyInto(out *KernelBootVolume) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KernelBootVolume.
+func (in *KernelBootVolume) DeepCopy() *KernelBootVolume {
+	if in == nil {
+		return nil
+	}
+	out := new(KernelBootVolume)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KernelInfo) DeepCopyInto(out *KernelInfo) {
 	*out = *in
@@ -2431,12 +2869,28 @@ func (in *KubeVirtCertificateRotateStrategy) DeepCopyInto(out *KubeVirtCertifica
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtCertificateRotateStrategy.
-func (in *KubeVirtCertificateRotateStrategy) DeepCopy() *KubeVirtCertificateRotateStrategy {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtCertificateRotateStrategy.
+func (in *KubeVirtCertificateRotateStrategy) DeepCopy() *KubeVirtCertificateRotateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtCertificateRotateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtComponentHealth) DeepCopyInto(out *KubeVirtComponentHealth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtComponentHealth.
+func (in *KubeVirtComponentHealth) DeepCopy() *KubeVirtComponentHealth {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeVirtCertificateRotateStrategy)
+	out := new(KubeVirtComponentHealth)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2601,6 +3055,46 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(VMRolloutStrategy)
 		**out = **in
 	}
+	if in.CrashLoopBackOff != nil {
+		in, out := &in.CrashLoopBackOff, &out.CrashLoopBackOff
+		*out = new(CrashLoopBackOffConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FirmwareImages != nil {
+		in, out := &in.FirmwareImages, &out.FirmwareImages
+		*out = make([]FirmwareImage, len(*in))
+		copy(*out, *in)
+	}
+	if in.InstancetypeUpdateStrategy != nil {
+		in, out := &in.InstancetypeUpdateStrategy, &out.InstancetypeUpdateStrategy
+		*out = new(InstancetypeUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ToolsDisk != nil {
+		in, out := &in.ToolsDisk, &out.ToolsDisk
+		*out = new(ToolsDiskConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityProfiles != nil {
+		in, out := &in.SecurityProfiles, &out.SecurityProfiles
+		*out = new(SecurityProfilesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportOIDC != nil {
+		in, out := &in.ExportOIDC, &out.ExportOIDC
+		*out = new(ExportOIDCConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SubresourceRateLimits != nil {
+		in, out := &in.SubresourceRateLimits, &out.SubresourceRateLimits
+		*out = new(SubresourceRateLimitsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StreamDrainSeconds != nil {
+		in, out := &in.StreamDrainSeconds, &out.StreamDrainSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -2614,6 +3108,70 @@ func (in *KubeVirtConfiguration) DeepCopy() *KubeVirtConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtFIPSStatus) DeepCopyInto(out *KubeVirtFIPSStatus) {
+	*out = *in
+	if in.Ciphers != nil {
+		in, out := &in.Ciphers, &out.Ciphers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtFIPSStatus.
+func (in *KubeVirtFIPSStatus) DeepCopy() *KubeVirtFIPSStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtFIPSStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtHealth) DeepCopyInto(out *KubeVirtHealth) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]KubeVirtComponentHealth, len(*in))
+		copy(*out, *in)
+	}
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]KubeVirtWebhookHealth, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]KubeVirtNodeHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Migrations = in.Migrations
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtHealth.
+func (in *KubeVirtHealth) DeepCopy() *KubeVirtHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeVirtHealth) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtList) DeepCopyInto(out *KubeVirtList) {
 	*out = *in
@@ -2647,6 +3205,42 @@ func (in *KubeVirtList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtMigrationsHealth) DeepCopyInto(out *KubeVirtMigrationsHealth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtMigrationsHealth.
+func (in *KubeVirtMigrationsHealth) DeepCopy() *KubeVirtMigrationsHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtMigrationsHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtNodeHealth) DeepCopyInto(out *KubeVirtNodeHealth) {
+	*out = *in
+	if in.LastHeartbeat != nil {
+		in, out := &in.LastHeartbeat, &out.LastHeartbeat
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtNodeHealth.
+func (in *KubeVirtNodeHealth) DeepCopy() *KubeVirtNodeHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtNodeHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtSelfSignConfiguration) DeepCopyInto(out *KubeVirtSelfSignConfiguration) {
 	*out = *in
@@ -2743,6 +3337,11 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.FIPS != nil {
+		in, out := &in.FIPS, &out.FIPS
+		*out = new(KubeVirtFIPSStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Generations != nil {
 		in, out := &in.Generations, &out.Generations
 		*out = make([]GenerationStatus, len(*in))
@@ -2761,6 +3360,22 @@ func (in *KubeVirtStatus) DeepCopy() *KubeVirtStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtWebhookHealth) DeepCopyInto(out *KubeVirtWebhookHealth) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtWebhookHealth.
+func (in *KubeVirtWebhookHealth) DeepCopy() *KubeVirtWebhookHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtWebhookHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpdateStrategy) {
 	*out = *in
@@ -3577,6 +4192,48 @@ func (in *PodNetwork) DeepCopy() *PodNetwork {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodOverlay) DeepCopyInto(out *PodOverlay) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]corev1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RuntimeClassName != nil {
+		in, out := &in.RuntimeClassName, &out.RuntimeClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodOverlay.
+func (in *PodOverlay) DeepCopy() *PodOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(PodOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Port) DeepCopyInto(out *Port) {
 	*out = *in
@@ -3850,6 +4507,22 @@ func (in *ResourceRequirements) DeepCopy() *ResourceRequirements {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestartLimit) DeepCopyInto(out *RestartLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartLimit.
+func (in *RestartLimit) DeepCopy() *RestartLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(RestartLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestartOptions) DeepCopyInto(out *RestartOptions) {
 	*out = *in
@@ -4182,6 +4855,48 @@ func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityProfile) DeepCopyInto(out *SecurityProfile) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityProfile.
+func (in *SecurityProfile) DeepCopy() *SecurityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityProfilesConfiguration) DeepCopyInto(out *SecurityProfilesConfiguration) {
+	*out = *in
+	if in.AllowedSeccompLocalhostProfiles != nil {
+		in, out := &in.AllowedSeccompLocalhostProfiles, &out.AllowedSeccompLocalhostProfiles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowedSELinuxTypes != nil {
+		in, out := &in.AllowedSELinuxTypes, &out.AllowedSELinuxTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityProfilesConfiguration.
+func (in *SecurityProfilesConfiguration) DeepCopy() *SecurityProfilesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityProfilesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountVolumeSource) DeepCopyInto(out *ServiceAccountVolumeSource) {
 	*out = *in
@@ -4198,6 +4913,37 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShutdownPolicy) DeepCopyInto(out *ShutdownPolicy) {
+	*out = *in
+	if in.ACPIRetries != nil {
+		in, out := &in.ACPIRetries, &out.ACPIRetries
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ACPIRetryIntervalSeconds != nil {
+		in, out := &in.ACPIRetryIntervalSeconds, &out.ACPIRetryIntervalSeconds
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ForceOffTimeoutSeconds != nil {
+		in, out := &in.ForceOffTimeoutSeconds, &out.ForceOffTimeoutSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShutdownPolicy.
+func (in *ShutdownPolicy) DeepCopy() *ShutdownPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ShutdownPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundDevice) DeepCopyInto(out *SoundDevice) {
 	*out = *in
@@ -4289,6 +5035,32 @@ func (in *StorageMigratedVolumeInfo) DeepCopy() *StorageMigratedVolumeInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubresourceRateLimitsConfiguration) DeepCopyInto(out *SubresourceRateLimitsConfiguration) {
+	*out = *in
+	if in.PerUser != nil {
+		in, out := &in.PerUser, &out.PerUser
+		*out = new(TokenBucketRateLimiter)
+		**out = **in
+	}
+	if in.PerNamespace != nil {
+		in, out := &in.PerNamespace, &out.PerNamespace
+		*out = new(TokenBucketRateLimiter)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubresourceRateLimitsConfiguration.
+func (in *SubresourceRateLimitsConfiguration) DeepCopy() *SubresourceRateLimitsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SubresourceRateLimitsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SupportContainerResources) DeepCopyInto(out *SupportContainerResources) {
 	*out = *in
@@ -4457,6 +5229,27 @@ func (in *TokenBucketRateLimiter) DeepCopy() *TokenBucketRateLimiter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ToolsDiskConfiguration) DeepCopyInto(out *ToolsDiskConfiguration) {
+	*out = *in
+	if in.NamespaceLabelSelector != nil {
+		in, out := &in.NamespaceLabelSelector, &out.NamespaceLabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ToolsDiskConfiguration.
+func (in *ToolsDiskConfiguration) DeepCopy() *ToolsDiskConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ToolsDiskConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TopologyHints) DeepCopyInto(out *TopologyHints) {
 	*out = *in
@@ -5500,6 +6293,16 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PodOverlay != nil {
+		in, out := &in.PodOverlay, &out.PodOverlay
+		*out = new(PodOverlay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityProfile != nil {
+		in, out := &in.SecurityProfile, &out.SecurityProfile
+		*out = new(SecurityProfile)
+		**out = **in
+	}
 	if in.EvictionStrategy != nil {
 		in, out := &in.EvictionStrategy, &out.EvictionStrategy
 		*out = new(EvictionStrategy)
@@ -5515,6 +6318,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(int64)
 		**out = **in
 	}
+	if in.ShutdownPolicy != nil {
+		in, out := &in.ShutdownPolicy, &out.ShutdownPolicy
+		*out = new(ShutdownPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
 		*out = make([]Volume, len(*in))
@@ -5522,6 +6330,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.EphemeralStorage != nil {
+		in, out := &in.EphemeralStorage, &out.EphemeralStorage
+		*out = new(EphemeralStorage)
+		**out = **in
+	}
 	if in.LivenessProbe != nil {
 		in, out := &in.LivenessProbe, &out.LivenessProbe
 		*out = new(Probe)
@@ -5551,6 +6364,13 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ResourceClaims != nil {
+		in, out := &in.ResourceClaims, &out.ResourceClaims
+		*out = make([]corev1.PodResourceClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5618,6 +6438,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(KernelBootStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FirmwareImageStatus != nil {
+		in, out := &in.FirmwareImageStatus, &out.FirmwareImageStatus
+		*out = new(FirmwareImageStatus)
+		**out = **in
+	}
 	if in.TopologyHints != nil {
 		in, out := &in.TopologyHints, &out.TopologyHints
 		*out = new(TopologyHints)
@@ -5643,6 +6468,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(MemoryStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GuestNUMA != nil {
+		in, out := &in.GuestNUMA, &out.GuestNUMA
+		*out = new(GuestNUMAStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MigratedVolumes != nil {
 		in, out := &in.MigratedVolumes, &out.MigratedVolumes
 		*out = make([]StorageMigratedVolumeInfo, len(*in))
@@ -5650,6 +6480,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DeviceStatus != nil {
+		in, out := &in.DeviceStatus, &out.DeviceStatus
+		*out = new(DeviceStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
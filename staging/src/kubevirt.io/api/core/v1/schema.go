@@ -279,8 +279,38 @@ type KernelBootContainer struct {
 type KernelBoot struct {
 	// Arguments to be passed to the kernel at boot time
 	KernelArgs string `json:"kernelArgs,omitempty"`
+	// KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.
+	// The arguments are read on every boot, so they can be changed without modifying the VMI.
+	// Mutually exclusive with kernelArgs.
+	//+optional
+	KernelArgsFrom *KernelArgsSource `json:"kernelArgsFrom,omitempty"`
 	// Container defines the container that containes kernel artifacts
 	Container *KernelBootContainer `json:"container,omitempty"`
+	// Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.
+	// Mutually exclusive with container.
+	//+optional
+	Volume *KernelBootVolume `json:"volume,omitempty"`
+}
+
+// If set, the VM will be booted from the kernel / initrd found in the referenced volume.
+type KernelBootVolume struct {
+	// Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI
+	// which contains the kernel artifacts. The volume must not be used by a disk.
+	Name string `json:"name"`
+	// The fully-qualified path to the kernel image inside the volume
+	//+optional
+	KernelPath string `json:"kernelPath,omitempty"`
+	// The fully-qualified path to the ramdisk image inside the volume
+	//+optional
+	InitrdPath string `json:"initrdPath,omitempty"`
+}
+
+// KernelArgsSource references the kernel arguments stored in a ConfigMap volume
+type KernelArgsSource struct {
+	// VolumeName is the name of the ConfigMap volume of the VMI
+	VolumeName string `json:"volumeName"`
+	// Key within the ConfigMap which holds the kernel arguments
+	Key string `json:"key"`
 }
 
 type ResourceRequirements struct {
@@ -429,6 +459,11 @@ type Firmware struct {
 	KernelBoot *KernelBoot `json:"kernelBoot,omitempty"`
 	// Information that can be set in the ACPI table
 	ACPI *ACPI `json:"acpi,omitempty"`
+	// ImageName selects a firmware image from spec.configuration.firmwareImages of the
+	// KubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.
+	// The image is pinned when the VMI is created and kept across migrations.
+	// +optional
+	ImageName string `json:"imageName,omitempty"`
 }
 
 type ACPI struct {
@@ -546,8 +581,20 @@ type TPMDevice struct {
 	// Persistent indicates the state of the TPM device should be kept accross reboots
 	// Defaults to false
 	Persistent *bool `json:"persistent,omitempty"`
+	// Version of the emulated TPM, one of 1.2 or 2.0.
+	// TPM 1.2 is meant for legacy guests which don't support TPM 2.0.
+	// Defaults to 2.0
+	// +optional
+	Version TPMVersion `json:"version,omitempty"`
 }
 
+type TPMVersion string
+
+const (
+	TPMVersion12 TPMVersion = "1.2"
+	TPMVersion20 TPMVersion = "2.0"
+)
+
 type InputBus string
 
 const (
@@ -586,12 +633,27 @@ type DownwardMetrics struct{}
 
 type GPU struct {
 	// Name of the GPU device as exposed by a device plugin
-	Name              string       `json:"name"`
-	DeviceName        string       `json:"deviceName"`
+	Name string `json:"name"`
+	// DeviceName is the resource name of the GPU exposed by a device plugin.
+	// Either DeviceName or Claim has to be set.
+	// +optional
+	DeviceName        string       `json:"deviceName,omitempty"`
 	VirtualGPUOptions *VGPUOptions `json:"virtualGPUOptions,omitempty"`
 	// If specified, the virtual network interface address and its tag will be provided to the guest via config drive
 	// +optional
 	Tag string `json:"tag,omitempty"`
+	// Claim references the ResourceClaim of the VMI the GPU is allocated from
+	// through Dynamic Resource Allocation.
+	// Either DeviceName or Claim has to be set.
+	// +optional
+	Claim *ClaimRequest `json:"claim,omitempty"`
+}
+
+// ClaimRequest references a device allocated through Dynamic Resource Allocation.
+// The devices referencing the same claim are assigned the devices allocated for it in order.
+type ClaimRequest struct {
+	// ClaimName is the name of the entry in spec.resourceClaims the device is allocated from.
+	ClaimName string `json:"claimName"`
 }
 
 type VGPUOptions struct {
@@ -611,11 +673,18 @@ type VGPUDisplayOptions struct {
 
 type HostDevice struct {
 	Name string `json:"name"`
-	// DeviceName is the resource name of the host device exposed by a device plugin
-	DeviceName string `json:"deviceName"`
+	// DeviceName is the resource name of the host device exposed by a device plugin.
+	// Either DeviceName or Claim has to be set.
+	// +optional
+	DeviceName string `json:"deviceName,omitempty"`
 	// If specified, the virtual network interface address and its tag will be provided to the guest via config drive
 	// +optional
 	Tag string `json:"tag,omitempty"`
+	// Claim references the ResourceClaim of the VMI the host device is allocated from
+	// through Dynamic Resource Allocation.
+	// Either DeviceName or Claim has to be set.
+	// +optional
+	Claim *ClaimRequest `json:"claim,omitempty"`
 }
 
 type Disk struct {
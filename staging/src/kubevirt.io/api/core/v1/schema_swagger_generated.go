@@ -140,9 +140,28 @@ func (KernelBootContainer) SwaggerDoc() map[string]string {
 
 func (KernelBoot) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":           "Represents the firmware blob used to assist in the kernel boot process.\nUsed for setting the kernel, initrd and command line arguments",
-		"kernelArgs": "Arguments to be passed to the kernel at boot time",
-		"container":  "Container defines the container that containes kernel artifacts",
+		"":               "Represents the firmware blob used to assist in the kernel boot process.\nUsed for setting the kernel, initrd and command line arguments",
+		"kernelArgs":     "Arguments to be passed to the kernel at boot time",
+		"kernelArgsFrom": "KernelArgsFrom references a key of a ConfigMap volume whose content is passed to the kernel at boot time.\nThe arguments are read on every boot, so they can be changed without modifying the VMI.\nMutually exclusive with kernelArgs.\n+optional",
+		"container":      "Container defines the container that containes kernel artifacts",
+		"volume":         "Volume defines the ConfigMap or PersistentVolumeClaim volume that contains kernel artifacts.\nMutually exclusive with container.\n+optional",
+	}
+}
+
+func (KernelBootVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "If set, the VM will be booted from the kernel / initrd found in the referenced volume.",
+		"name":       "Name of the ConfigMap, PersistentVolumeClaim or DataVolume volume of the VMI\nwhich contains the kernel artifacts. The volume must not be used by a disk.",
+		"kernelPath": "The fully-qualified path to the kernel image inside the volume\n+optional",
+		"initrdPath": "The fully-qualified path to the ramdisk image inside the volume\n+optional",
+	}
+}
+
+func (KernelArgsSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "KernelArgsSource references the kernel arguments stored in a ConfigMap volume",
+		"volumeName": "VolumeName is the name of the ConfigMap volume of the VMI",
+		"key":        "Key within the ConfigMap which holds the kernel arguments",
 	}
 }
 
@@ -234,6 +253,7 @@ func (Firmware) SwaggerDoc() map[string]string {
 		"serial":     "The system-serial-number in SMBIOS",
 		"kernelBoot": "Settings to set the kernel for booting.\n+optional",
 		"acpi":       "Information that can be set in the ACPI table",
+		"imageName":  "ImageName selects a firmware image from spec.configuration.firmwareImages of the\nKubeVirt CR. Its OVMF/SeaBIOS binaries are used instead of the ones shipped with virt-launcher.\nThe image is pinned when the VMI is created and kept across migrations.\n+optional",
 	}
 }
 
@@ -288,6 +308,7 @@ func (SoundDevice) SwaggerDoc() map[string]string {
 func (TPMDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"persistent": "Persistent indicates the state of the TPM device should be kept accross reboots\nDefaults to false",
+		"version":    "Version of the emulated TPM, one of 1.2 or 2.0.\nTPM 1.2 is meant for legacy guests which don't support TPM 2.0.\nDefaults to 2.0\n+optional",
 	}
 }
 
@@ -316,8 +337,17 @@ func (DownwardMetrics) SwaggerDoc() map[string]string {
 
 func (GPU) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"name": "Name of the GPU device as exposed by a device plugin",
-		"tag":  "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"name":       "Name of the GPU device as exposed by a device plugin",
+		"deviceName": "DeviceName is the resource name of the GPU exposed by a device plugin.\nEither DeviceName or Claim has to be set.\n+optional",
+		"tag":        "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"claim":      "Claim references the ResourceClaim of the VMI the GPU is allocated from\nthrough Dynamic Resource Allocation.\nEither DeviceName or Claim has to be set.\n+optional",
+	}
+}
+
+func (ClaimRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "ClaimRequest references a device allocated through Dynamic Resource Allocation.\nThe devices referencing the same claim are assigned the devices allocated for it in order.",
+		"claimName": "ClaimName is the name of the entry in spec.resourceClaims the device is allocated from.",
 	}
 }
 
@@ -334,8 +364,9 @@ func (VGPUDisplayOptions) SwaggerDoc() map[string]string {
 
 func (HostDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"deviceName": "DeviceName is the resource name of the host device exposed by a device plugin",
+		"deviceName": "DeviceName is the resource name of the host device exposed by a device plugin.\nEither DeviceName or Claim has to be set.\n+optional",
 		"tag":        "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"claim":      "Claim references the ResourceClaim of the VMI the host device is allocated from\nthrough Dynamic Resource Allocation.\nEither DeviceName or Claim has to be set.\n+optional",
 	}
 }
 
@@ -32,12 +32,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 const DefaultGracePeriodSeconds int64 = 30
 
+const DefaultACPIRetryIntervalSeconds int64 = 5
+
 // VirtualMachineInstance is *the* VirtualMachineInstance Definition. It represents a virtual machine in the runtime environment of kubernetes.
 //
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -109,6 +112,13 @@ type VirtualMachineInstanceSpec struct {
 	// +listMapKey=topologyKey
 	// +listMapKey=whenUnsatisfiable
 	TopologySpreadConstraints []k8sv1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty" patchStrategy:"merge" patchMergeKey:"topologyKey"`
+	// PodOverlay adds a safelisted set of fields to the virt-launcher pod of the VirtualMachineInstance.
+	// +optional
+	PodOverlay *PodOverlay `json:"podOverlay,omitempty"`
+	// SecurityProfile selects a stricter seccomp profile and SELinux type for the virt-launcher compute container.
+	// Only the profiles and types allowed in the KubeVirt configuration can be selected.
+	// +optional
+	SecurityProfile *SecurityProfile `json:"securityProfile,omitempty"`
 	// EvictionStrategy describes the strategy to follow when a node drain occurs.
 	// The possible options are:
 	// - "None": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.
@@ -123,9 +133,15 @@ type VirtualMachineInstanceSpec struct {
 	StartStrategy *StartStrategy `json:"startStrategy,omitempty"`
 	// Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.
 	TerminationGracePeriodSeconds *int64 `json:"terminationGracePeriodSeconds,omitempty"`
+	// ShutdownPolicy controls how the guest is asked to shut down before it is forcefully powered off.
+	// +optional
+	ShutdownPolicy *ShutdownPolicy `json:"shutdownPolicy,omitempty"`
 	// List of volumes that can be mounted by disks belonging to the vmi.
 	// +kubebuilder:validation:MaxItems:=256
 	Volumes []Volume `json:"volumes,omitempty"`
+	// EphemeralStorage configures the node-local storage backing containerDisk, ephemeral and emptyDisk volumes.
+	// +optional
+	EphemeralStorage *EphemeralStorage `json:"ephemeralStorage,omitempty"`
 	// Periodic probe of VirtualMachineInstance liveness.
 	// VirtualmachineInstances will be stopped if the probe fails.
 	// Cannot be updated.
@@ -170,6 +186,12 @@ type VirtualMachineInstanceSpec struct {
 	AccessCredentials []AccessCredential `json:"accessCredentials,omitempty"`
 	// Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components
 	Architecture string `json:"architecture,omitempty"`
+	// ResourceClaims are the ResourceClaims which have to be allocated and reserved before the virt-launcher pod
+	// of the VMI is allowed to start. GPUs and host devices reference them to consume the allocated devices.
+	// Requires the DynamicResourceAllocation feature gate of Kubernetes.
+	// +listType=atomic
+	// +optional
+	ResourceClaims []k8sv1.PodResourceClaim `json:"resourceClaims,omitempty"`
 }
 
 func (vmiSpec *VirtualMachineInstanceSpec) UnmarshalJSON(data []byte) error {
@@ -257,6 +279,10 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	KernelBootStatus *KernelBootStatus `json:"kernelBootStatus,omitempty"`
 
+	// FirmwareImageStatus contains the firmware image the VMI was pinned to at creation
+	// +optional
+	FirmwareImageStatus *FirmwareImageStatus `json:"firmwareImageStatus,omitempty"`
+
 	// FSFreezeStatus is the state of the fs of the guest
 	// it can be either frozen or thawed
 	// +optional
@@ -295,10 +321,70 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	Memory *MemoryStatus `json:"memory,omitempty"`
 
+	// GuestNUMA shows the NUMA topology presented to the guest, if it has one.
+	// +optional
+	GuestNUMA *GuestNUMAStatus `json:"guestNUMA,omitempty"`
+
 	// MigratedVolumes lists the source and destination volumes during the volume migration
 	// +listType=atomic
 	// +optional
 	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+
+	// DeviceStatus reflects the devices allocated through resource claims for the GPUs and host devices
+	// +optional
+	DeviceStatus *DeviceStatus `json:"deviceStatus,omitempty"`
+}
+
+// DeviceStatus reflects the devices allocated through resource claims for the GPUs and host devices of a VMI
+type DeviceStatus struct {
+	// GPUStatuses reflect the devices allocated for the GPUs
+	// +listType=atomic
+	// +optional
+	GPUStatuses []DeviceStatusInfo `json:"gpuStatuses,omitempty"`
+	// HostDeviceStatuses reflect the devices allocated for the host devices
+	// +listType=atomic
+	// +optional
+	HostDeviceStatuses []DeviceStatusInfo `json:"hostDeviceStatuses,omitempty"`
+}
+
+// DeviceStatusInfo reflects the device allocated through a resource claim for a GPU or a host device
+type DeviceStatusInfo struct {
+	// Name is the name of the GPU or host device in the VMI spec
+	Name string `json:"name"`
+	// ResourceClaimName is the name of the ResourceClaim the device is allocated from
+	ResourceClaimName string `json:"resourceClaimName"`
+	// DeviceName is the name of the allocated device in the ResourceSlice of its driver
+	DeviceName string `json:"deviceName"`
+	// PCIAddress is the PCI address of the allocated device on the node
+	PCIAddress string `json:"pciAddress"`
+}
+
+// GuestNUMAStatus shows the NUMA topology presented to the guest through its ACPI tables
+type GuestNUMAStatus struct {
+	// Cells are the NUMA nodes of the guest
+	// +listType=atomic
+	Cells []GuestNUMACell `json:"cells"`
+}
+
+// GuestNUMACell describes a NUMA node of the guest
+type GuestNUMACell struct {
+	// ID is the id of the NUMA node in the guest
+	ID uint32 `json:"id"`
+	// CPUs are the vCPUs of the NUMA node
+	// +listType=atomic
+	// +optional
+	CPUs []uint32 `json:"cpus,omitempty"`
+	// Memory is the amount of memory of the NUMA node
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+	// HostNode is the host NUMA node the memory of the NUMA node is allocated from
+	// +optional
+	HostNode *uint32 `json:"hostNode,omitempty"`
+	// Distances are the distances from the NUMA node to all NUMA nodes of the guest, ordered by their id,
+	// as reported in the ACPI SLIT
+	// +listType=atomic
+	// +optional
+	Distances []uint32 `json:"distances,omitempty"`
 }
 
 // StorageMigratedVolumeInfo tracks the information about the source and destination volumes during the volume migration
@@ -391,6 +477,24 @@ type KernelBootStatus struct {
 	InitrdInfo *InitrdInfo `json:"initrdInfo,omitempty"`
 }
 
+// FirmwareImageStatus contains info about the firmware image used by the VMI
+type FirmwareImageStatus struct {
+	// Name of the firmware image in the KubeVirt configuration
+	Name string `json:"name"`
+	// Image is the container image which was selected when the VMI was created.
+	// It stays the same for the lifetime of the VMI, also across migrations.
+	Image string `json:"image"`
+	// ImagePullPolicy of the firmware image container
+	// +optional
+	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Path is the directory inside the image containing the firmware binaries
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Checksum of the firmware binaries
+	// +optional
+	Checksum uint32 `json:"checksum,omitempty"`
+}
+
 // DomainMemoryDumpInfo represents the memory dump information
 type DomainMemoryDumpInfo struct {
 	// StartTimestamp is the time when the memory dump started
@@ -532,6 +636,70 @@ func (v *VirtualMachineInstance) IsHighPerformanceVMI() bool {
 
 type VirtualMachineInstanceConditionType string
 
+// ShutdownPolicy describes how the guest is asked to shut down before it is forcefully powered off
+type ShutdownPolicy struct {
+	// ACPIRetries is the number of times the ACPI power button event is sent again
+	// after the first one was not honored by the guest.
+	// If not set, the event is sent again until the guest is forcefully powered off.
+	// +optional
+	ACPIRetries *uint32 `json:"acpiRetries,omitempty"`
+	// ACPIRetryIntervalSeconds is the time to wait between two ACPI power button events.
+	// Defaults to 5
+	// +optional
+	ACPIRetryIntervalSeconds *uint32 `json:"acpiRetryIntervalSeconds,omitempty"`
+	// GuestAgentFallback requests the guest agent to shut down the guest once all
+	// ACPI retries are exhausted. Requires acpiRetries to be set and a connected guest agent.
+	// +optional
+	GuestAgentFallback bool `json:"guestAgentFallback,omitempty"`
+	// ForceOffTimeoutSeconds is the time after the first shutdown signal after which the guest
+	// is forcefully powered off. It must not exceed terminationGracePeriodSeconds.
+	// Defaults to terminationGracePeriodSeconds
+	// +optional
+	ForceOffTimeoutSeconds *int64 `json:"forceOffTimeoutSeconds,omitempty"`
+}
+
+// EphemeralStorage configures the node-local storage of a VirtualMachineInstance
+type EphemeralStorage struct {
+	// Encrypted enables the encryption of the qcow2 overlays of containerDisk and ephemeral volumes and of emptyDisk
+	// volumes. The key is generated per VirtualMachineInstance, is only kept in memory and is discarded once the
+	// VirtualMachineInstance stops.
+	// +optional
+	Encrypted bool `json:"encrypted,omitempty"`
+}
+
+// PodOverlay describes additions to the virt-launcher pod of a VirtualMachineInstance
+type PodOverlay struct {
+	// Labels are added to the virt-launcher pod only, without being set on the VirtualMachineInstance.
+	// Labels in the kubevirt.io domain are reserved and not allowed.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Tolerations are added to the tolerations of the VirtualMachineInstance.
+	// +optional
+	// +listType=atomic
+	Tolerations []k8sv1.Toleration `json:"tolerations,omitempty"`
+	// TopologySpreadConstraints are merged into the topology spread constraints of the VirtualMachineInstance.
+	// A constraint with the same topologyKey and whenUnsatisfiable as one of the VirtualMachineInstance replaces it.
+	// +optional
+	// +listType=atomic
+	TopologySpreadConstraints []k8sv1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// RuntimeClassName sets the runtime class of the virt-launcher pod.
+	// It has to match the default runtime class of the cluster if one is configured.
+	// +optional
+	RuntimeClassName *string `json:"runtimeClassName,omitempty"`
+}
+
+// SecurityProfile describes the security settings of the virt-launcher compute container of a VirtualMachineInstance
+type SecurityProfile struct {
+	// SeccompLocalhostProfile is the seccomp profile of the compute container, a path relative to the seccomp
+	// profile root of the kubelet. It has to be listed in securityProfiles.allowedSeccompLocalhostProfiles.
+	// +optional
+	SeccompLocalhostProfile string `json:"seccompLocalhostProfile,omitempty"`
+	// SELinuxType is the SELinux type of the compute container.
+	// It has to be listed in securityProfiles.allowedSELinuxTypes.
+	// +optional
+	SELinuxType string `json:"selinuxType,omitempty"`
+}
+
 // These are valid conditions of VMIs.
 const (
 	// Provisioning means, a VMI depends on DataVolumes which are in Pending/WaitForFirstConsumer status,
@@ -572,6 +740,17 @@ const (
 
 	// Summarizes that all the DataVolumes attached to the VMI are Ready or not
 	VirtualMachineInstanceDataVolumesReady VirtualMachineInstanceConditionType = "DataVolumesReady"
+
+	// Reflects which method was used last to stop the guest. It is reported as false once the guest had to be forcefully powered off.
+	VirtualMachineInstanceGracefulShutdown VirtualMachineInstanceConditionType = "GracefulShutdown"
+
+	// Reflects whether all hotplugged volumes are attached to the VMI. It is reported as false with the step
+	// of the hotplug pipeline a volume is waiting for or failing at as reason.
+	VirtualMachineInstanceHotplugVolumesReady VirtualMachineInstanceConditionType = "HotplugVolumesReady"
+
+	// Reflects whether the passthrough GPUs of a VMI with dedicated CPUs share a host NUMA node with its vCPUs.
+	// It is only reported when it is false, with the kind of misalignment as reason.
+	VirtualMachineInstanceGPUNUMAAligned VirtualMachineInstanceConditionType = "GPUNUMAAligned"
 )
 
 // These are valid reasons for VMI conditions.
@@ -600,6 +779,24 @@ const (
 	VirtualMachineInstanceReasonNotAllDVsReady = "NotAllDVsReady"
 	// Reason means that all of the VMI's DVs are bound and not running
 	VirtualMachineInstanceReasonAllDVsReady = "AllDVsReady"
+	// Reason means that the guest was asked to shut down by an ACPI power button event
+	VirtualMachineInstanceReasonACPIShutdown = "ACPI"
+	// Reason means that the guest was asked to shut down by the guest agent
+	VirtualMachineInstanceReasonGuestAgentShutdown = "GuestAgent"
+	// Reason means that the guest was forcefully powered off
+	VirtualMachineInstanceReasonForceOffShutdown = "ForceOff"
+	// Reason means that the attachment pod of a hotplugged volume is not running yet
+	VirtualMachineInstanceReasonHotplugAttachmentPodNotReady = "HotplugAttachmentPodNotReady"
+	// Reason means that a hotplugged volume is not yet mounted into the virt-launcher pod
+	VirtualMachineInstanceReasonHotplugVolumeNotMounted = "HotplugVolumeNotMounted"
+	// Reason means that mounting a hotplugged volume into the virt-launcher pod failed
+	VirtualMachineInstanceReasonHotplugVolumeMountFailed = "HotplugVolumeMountFailed"
+	// Reason means that a hotplugged volume is mounted but not yet attached to the domain
+	VirtualMachineInstanceReasonHotplugVolumeNotAttached = "HotplugVolumeNotAttached"
+	// Reason means that a passthrough GPU is on a host NUMA node none of the dedicated CPUs belong to
+	VirtualMachineInstanceReasonGPUNUMAMisaligned = "GPUNUMAMisaligned"
+	// Reason means that a passthrough GPU shares a host NUMA node with the dedicated CPUs, but not with a guest NUMA node
+	VirtualMachineInstanceReasonGPUGuestNUMAMisaligned = "GPUGuestNUMAMisaligned"
 )
 
 const (
@@ -888,6 +1085,9 @@ const (
 	// This annotation indicates to abort any migration due to an automated
 	// workload update. It should only be used for testing purposes.
 	WorkloadUpdateMigrationAbortionAnnotation string = "kubevirt.io/testWorkloadUpdateMigrationAbortion"
+	// This annotation indicates that a VirtualMachine is being moved onto a new
+	// instancetype or preference revision. Its value is the update method in use.
+	InstancetypeUpdateAnnotation string = "kubevirt.io/instancetypeUpdate"
 	// This label declares whether a particular node is available for
 	// scheduling virtual machine instances on it. Used on Node.
 	NodeSchedulable string = "kubevirt.io/schedulable"
@@ -1580,6 +1780,9 @@ const (
 	VirtualMachineStatusTerminating VirtualMachinePrintableStatus = "Terminating"
 	// VirtualMachineStatusCrashLoopBackOff indicates that the virtual machine is currently in a crash loop waiting to be retried.
 	VirtualMachineStatusCrashLoopBackOff VirtualMachinePrintableStatus = "CrashLoopBackOff"
+	// VirtualMachineStatusRestartLimitReached indicates that the virtual machine failed to start as often as the
+	// restart limit allows and is not restarted until a start or restart is requested.
+	VirtualMachineStatusRestartLimitReached VirtualMachinePrintableStatus = "RestartLimitReached"
 	// VirtualMachineStatusMigrating indicates that the virtual machine is in the process of being migrated
 	// to another host.
 	VirtualMachineStatusMigrating VirtualMachinePrintableStatus = "Migrating"
@@ -2068,10 +2271,27 @@ type KubeVirtStatus struct {
 	OutdatedVirtualMachineInstanceWorkloads *int                `json:"outdatedVirtualMachineInstanceWorkloads,omitempty" optional:"true"`
 	ObservedGeneration                      *int64              `json:"observedGeneration,omitempty"`
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
+	// FIPS reports the FIPS profile the KubeVirt components enforce on their TLS connections
+	FIPS *KubeVirtFIPSStatus `json:"fips,omitempty" optional:"true"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
 }
 
+// KubeVirtFIPSStatus reports the FIPS profile of the KubeVirt components
+type KubeVirtFIPSStatus struct {
+	// Enabled reports whether the TLS stacks are restricted to the FIPS 140 approved settings
+	Enabled bool `json:"enabled"`
+	// ValidatedModule reports whether the cryptography of the KubeVirt components is provided by a FIPS 140 validated module, they are only FIPS compliant if it is true as well as Enabled
+	ValidatedModule bool `json:"validatedModule"`
+	// MinTLSVersion is the minimum TLS version accepted by the KubeVirt components
+	// +optional
+	MinTLSVersion TLSProtocolVersion `json:"minTLSVersion,omitempty"`
+	// Ciphers are the TLS 1.2 cipher suites accepted by the KubeVirt components
+	// +optional
+	// +listType=atomic
+	Ciphers []string `json:"ciphers,omitempty"`
+}
+
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
 type KubeVirtPhase string
 
@@ -2492,6 +2712,51 @@ type KubeVirtConfiguration struct {
 	// +nullable
 	// +kubebuilder:validation:Enum=Stage;LiveUpdate
 	VMRolloutStrategy *VMRolloutStrategy `json:"vmRolloutStrategy,omitempty"`
+
+	// CrashLoopBackOff configures how VirtualMachines whose guests keep failing are restarted
+	// +optional
+	CrashLoopBackOff *CrashLoopBackOffConfiguration `json:"crashLoopBackOff,omitempty"`
+
+	// FirmwareImages is the set of firmware images VMIs can select with
+	// spec.domain.firmware.imageName instead of using the firmware shipped with virt-launcher.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	FirmwareImages []FirmwareImage `json:"firmwareImages,omitempty"`
+
+	// InstancetypeUpdateStrategy defines if and how running VirtualMachines are moved onto
+	// new revisions of the instancetypes and preferences they reference.
+	// When unset, VirtualMachines stay on the revisions captured when they were first started.
+	// +optional
+	InstancetypeUpdateStrategy *InstancetypeUpdateStrategy `json:"instancetypeUpdateStrategy,omitempty"`
+
+	// ToolsDisk attaches a read-only disk, provided by a containerDisk image, to every VMI
+	// created in the selected namespaces, e.g. to ship monitoring agents or guest drivers.
+	// Changes only affect VMIs created afterwards.
+	// +optional
+	ToolsDisk *ToolsDiskConfiguration `json:"toolsDisk,omitempty"`
+
+	// SecurityProfiles lists the seccomp profiles and SELinux types VirtualMachineInstances
+	// may select for their virt-launcher compute container.
+	// +optional
+	SecurityProfiles *SecurityProfilesConfiguration `json:"securityProfiles,omitempty"`
+
+	// ExportOIDC lets the VirtualMachineExport servers accept OIDC ID tokens as bearer tokens,
+	// in addition to the generated export token.
+	// +optional
+	ExportOIDC *ExportOIDCConfiguration `json:"exportOIDC,omitempty"`
+
+	// SubresourceRateLimits limits how often a single user, and all users of a namespace together,
+	// may call the console, vnc, expand-spec and memorydump subresources.
+	// +optional
+	SubresourceRateLimits *SubresourceRateLimitsConfiguration `json:"subresourceRateLimits,omitempty"`
+
+	// StreamDrainSeconds is how long virt-api and virt-handler keep serving the open console, VNC,
+	// USB redirection, VSOCK and port-forward streams while they shut down, e.g. during an update.
+	// New streams are refused meanwhile, so that clients reconnect to another instance.
+	// Defaults to 0, which closes the open streams right away. The maximum is 300.
+	// +optional
+	StreamDrainSeconds *int64 `json:"streamDrainSeconds,omitempty"`
 }
 
 type VMRolloutStrategy string
@@ -2608,6 +2873,11 @@ type TLSConfiguration struct {
 	MinTLSVersion TLSProtocolVersion `json:"minTLSVersion,omitempty"`
 	// +listType=set
 	Ciphers []string `json:"ciphers,omitempty"`
+	// FIPSMode restricts the TLS stacks of the KubeVirt components to the FIPS 140 approved
+	// protocol versions, cipher suites and key exchange curves.
+	// MinTLSVersion and Ciphers have to be FIPS compliant when it is enabled.
+	// +optional
+	FIPSMode bool `json:"fipsMode,omitempty"`
 }
 
 // MigrationConfiguration holds migration options.
@@ -3009,6 +3279,156 @@ type LiveUpdateConfiguration struct {
 	MaxGuest *resource.Quantity `json:"maxGuest,omitempty"`
 }
 
+// CrashLoopBackOffConfiguration holds the settings virt-controller uses to back off
+// from restarting VirtualMachines whose VMIs keep failing shortly after being started.
+type CrashLoopBackOffConfiguration struct {
+	// MaxDelaySeconds is the upper bound of the exponential delay between two consecutive
+	// restarts of a failing VirtualMachine.
+	// Defaults to 300
+	// +optional
+	MaxDelaySeconds *int `json:"maxDelaySeconds,omitempty"`
+	// MinRunDurationSeconds is the time a VMI has to stay in the Running phase before
+	// it is considered to be started successfully. VMIs which terminate earlier are
+	// counted as start failures.
+	// Defaults to 0, which only counts VMIs never reaching the Running phase as start failures.
+	// +optional
+	MinRunDurationSeconds *int64 `json:"minRunDurationSeconds,omitempty"`
+	// RestartLimits caps the number of consecutive start failures per RunStrategy.
+	// Once the limit is reached the VirtualMachine is not restarted anymore until
+	// a new start, stop or restart request is issued.
+	// +optional
+	// +listType=map
+	// +listMapKey=runStrategy
+	RestartLimits []RestartLimit `json:"restartLimits,omitempty"`
+}
+
+type InstancetypeUpdateMethod string
+
+const (
+	// InstancetypeUpdateMethodLiveMigrate applies the new revision through the VM rollout strategy.
+	// Changes which can be live updated, like CPU sockets or guest memory, are applied through a
+	// live migration when VMRolloutStrategy is LiveUpdate.
+	InstancetypeUpdateMethodLiveMigrate InstancetypeUpdateMethod = "LiveMigrate"
+	// InstancetypeUpdateMethodRestart restarts VirtualMachines to apply the new revision.
+	InstancetypeUpdateMethodRestart InstancetypeUpdateMethod = "Restart"
+)
+
+// InstancetypeUpdateStrategy defines how VirtualMachines referencing an updated instancetype
+// or preference are rolled onto the new revision.
+type InstancetypeUpdateStrategy struct {
+	// Methods defines the methods that can be used to apply a new revision to running VirtualMachines.
+	// When both LiveMigrate and Restart are listed, only VirtualMachines whose changes can't be
+	// applied live are restarted. Once a method is listed, VirtualMachines that are not running are
+	// moved onto the new revision as well.
+	//
+	// An empty list keeps VirtualMachines on their captured revisions.
+	//
+	// +listType=atomic
+	// +optional
+	Methods []InstancetypeUpdateMethod `json:"methods,omitempty"`
+
+	// MaxUnavailable is the maximum number of running VirtualMachines which are updated at the same time.
+	// Value can be an absolute number (ex: 5) or a percentage of the running VirtualMachines
+	// referencing an outdated revision (ex: 10%). Absolute number is calculated from percentage
+	// by rounding down, with a minimum of 1.
+	//
+	// Defaults to 1
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// FirmwareImage describes a container image providing OVMF and SeaBIOS binaries.
+// The binaries are expected to use the file names of the virt-launcher image
+// (e.g. OVMF_CODE.fd, OVMF_VARS.fd, OVMF_CODE.secboot.fd, bios.bin).
+type FirmwareImage struct {
+	// Name is used by VMIs to reference the firmware image
+	Name string `json:"name"`
+	// Image is the container image containing the firmware binaries
+	Image string `json:"image"`
+	// Image pull policy.
+	// One of Always, Never, IfNotPresent.
+	// Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+	// +optional
+	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Path is the absolute directory inside the image containing the firmware binaries.
+	// Defaults to /usr/share/OVMF
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// ToolsDiskConfiguration describes a containerDisk image which is attached as a read-only CD-ROM.
+type ToolsDiskConfiguration struct {
+	// Image is the containerDisk image providing the disk
+	Image string `json:"image"`
+	// Image pull policy.
+	// One of Always, Never, IfNotPresent.
+	// Defaults to Always if :latest tag is specified, or IfNotPresent otherwise.
+	// +optional
+	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// NamespaceLabelSelector selects the namespaces whose VMIs get the disk attached.
+	// When unset, the disk is attached to the VMIs of all namespaces.
+	// +optional
+	NamespaceLabelSelector *metav1.LabelSelector `json:"namespaceLabelSelector,omitempty"`
+}
+
+// SecurityProfilesConfiguration holds the safelist of the security profiles VirtualMachineInstances can select.
+type SecurityProfilesConfiguration struct {
+	// AllowedSeccompLocalhostProfiles are the localhost seccomp profiles which can be selected,
+	// as paths relative to the seccomp profile root of the kubelet. The profiles have to exist on the nodes.
+	// +optional
+	// +listType=set
+	AllowedSeccompLocalhostProfiles []string `json:"allowedSeccompLocalhostProfiles,omitempty"`
+	// AllowedSELinuxTypes are the SELinux types which can be selected.
+	// +optional
+	// +listType=set
+	AllowedSELinuxTypes []string `json:"allowedSELinuxTypes,omitempty"`
+}
+
+// ExportOIDCConfiguration describes the OIDC issuer whose ID tokens are accepted by the VirtualMachineExport servers.
+type ExportOIDCConfiguration struct {
+	// IssuerURL is the https URL of the issuer, it has to serve the OIDC discovery document.
+	IssuerURL string `json:"issuerURL"`
+	// ClientID is the audience the ID tokens have to be issued for.
+	ClientID string `json:"clientID"`
+	// GroupsClaim is the name of the claim holding the groups of the user. Defaults to groups
+	// +optional
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// GroupBindings limit the accepted ID tokens to the members of the groups bound to the namespace of the export.
+	// +listType=atomic
+	GroupBindings []ExportOIDCGroupBinding `json:"groupBindings"`
+}
+
+// ExportOIDCGroupBinding binds an OIDC group to the namespaces whose VirtualMachineExports its members can download.
+type ExportOIDCGroupBinding struct {
+	// Group is the name of the group in the groups claim.
+	Group string `json:"group"`
+	// Namespaces are the namespaces whose VirtualMachineExports the members of the group can download.
+	// +listType=set
+	Namespaces []string `json:"namespaces"`
+}
+
+// SubresourceRateLimitsConfiguration holds the per-tenant rate limits of the expensive subresources.
+// Requests exceeding a limit are rejected with 429 Too Many Requests and a Retry-After header.
+type SubresourceRateLimitsConfiguration struct {
+	// PerUser limits the requests of every user across all namespaces.
+	// +optional
+	PerUser *TokenBucketRateLimiter `json:"perUser,omitempty"`
+	// PerNamespace limits the requests of all users within a namespace together.
+	// +optional
+	PerNamespace *TokenBucketRateLimiter `json:"perNamespace,omitempty"`
+}
+
+// RestartLimit defines the maximum number of consecutive start failures
+// tolerated for VirtualMachines using the given RunStrategy.
+type RestartLimit struct {
+	// RunStrategy the limit applies to. Only Always and RerunOnFailure are supported.
+	RunStrategy VirtualMachineRunStrategy `json:"runStrategy"`
+	// MaxConsecutiveFailures is the number of consecutive start failures after which
+	// the VirtualMachine is no longer restarted.
+	MaxConsecutiveFailures int `json:"maxConsecutiveFailures"`
+}
+
 type LiveUpdateMemory struct {
 	// MaxGuest defines the maximum amount memory that can be allocated for the VM.
 	// +optional
@@ -3060,3 +3480,148 @@ type SEVSecretOptions struct {
 	// Base64 encoded encrypted launch secret.
 	Secret string `json:"secret,omitempty"`
 }
+
+// CapacityForecastOptions describes the VirtualMachines to forecast the placement of.
+// Either VirtualMachines or Instancetype has to be set.
+type CapacityForecastOptions struct {
+	// VirtualMachines are the names of VirtualMachines in the namespace of the request to place.
+	// VirtualMachines which already have a VirtualMachineInstance are ignored, since they
+	// consume their capacity already.
+	// +optional
+	// +listType=set
+	VirtualMachines []string `json:"virtualMachines,omitempty"`
+	// Instancetype references the instancetype to place Replicas VirtualMachines of.
+	// +optional
+	Instancetype *InstancetypeMatcher `json:"instancetype,omitempty"`
+	// Preference references the preference applied to the VirtualMachines of the instancetype.
+	// +optional
+	Preference *PreferenceMatcher `json:"preference,omitempty"`
+	// Replicas is the number of VirtualMachines of the instancetype to place. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
+// CapacityForecast reports whether the cluster can place the requested VirtualMachines at once.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type CapacityForecast struct {
+	metav1.TypeMeta `json:",inline"`
+	// Requested is the number of VirtualMachines to place.
+	Requested int32 `json:"requested"`
+	// Placeable is the number of the requested VirtualMachines which fit into the cluster.
+	Placeable int32 `json:"placeable"`
+	// Nodes lists the nodes the placeable VirtualMachines would be placed on.
+	// +optional
+	// +listType=atomic
+	Nodes []CapacityForecastNode `json:"nodes,omitempty"`
+	// Unplaceable lists the VirtualMachines which don't fit into the cluster.
+	// +optional
+	// +listType=atomic
+	Unplaceable []CapacityForecastUnplaceable `json:"unplaceable,omitempty"`
+}
+
+// CapacityForecastNode reports the VirtualMachines which would be placed on a node.
+type CapacityForecastNode struct {
+	// Name of the node.
+	Name string `json:"name"`
+	// VirtualMachines placed on the node.
+	// +listType=atomic
+	VirtualMachines []string `json:"virtualMachines"`
+	// Remaining is the allocatable capacity of the node left after the placement.
+	// +optional
+	Remaining k8sv1.ResourceList `json:"remaining,omitempty"`
+}
+
+// CapacityForecastUnplaceable reports why a VirtualMachine doesn't fit into the cluster.
+type CapacityForecastUnplaceable struct {
+	// Name of the VirtualMachine.
+	Name string `json:"name"`
+	// Reason the VirtualMachine doesn't fit, in the format of the scheduler.
+	Reason string `json:"reason"`
+}
+
+// KubeVirtHealth aggregates the health and readiness of the KubeVirt install.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KubeVirtHealth struct {
+	metav1.TypeMeta `json:",inline"`
+	// Healthy is true when all components are available, all webhooks are reachable and the
+	// virt-handlers of all nodes send heartbeats.
+	Healthy bool `json:"healthy"`
+	// Ready is true when KubeVirt is deployed at its target version and all components are
+	// rolled out, e.g. to gate upgrades on.
+	Ready bool `json:"ready"`
+	// Phase of the KubeVirt install.
+	// +optional
+	Phase KubeVirtPhase `json:"phase,omitempty"`
+	// ObservedKubeVirtVersion is the version KubeVirt is deployed at.
+	// +optional
+	ObservedKubeVirtVersion string `json:"observedKubeVirtVersion,omitempty"`
+	// TargetKubeVirtVersion is the version KubeVirt is deployed to.
+	// +optional
+	TargetKubeVirtVersion string `json:"targetKubeVirtVersion,omitempty"`
+	// Components reports the rollout of the KubeVirt components.
+	// +optional
+	// +listType=atomic
+	Components []KubeVirtComponentHealth `json:"components,omitempty"`
+	// Webhooks reports whether the KubeVirt webhooks are reachable.
+	// +optional
+	// +listType=atomic
+	Webhooks []KubeVirtWebhookHealth `json:"webhooks,omitempty"`
+	// Nodes reports the virt-handler heartbeats of the nodes.
+	// +optional
+	// +listType=atomic
+	Nodes []KubeVirtNodeHealth `json:"nodes,omitempty"`
+	// Migrations summarizes the outstanding migrations.
+	Migrations KubeVirtMigrationsHealth `json:"migrations"`
+}
+
+// KubeVirtComponentHealth reports the rollout of a KubeVirt component.
+type KubeVirtComponentHealth struct {
+	// Name of the component.
+	Name string `json:"name"`
+	// Desired is the number of pods the component should run.
+	Desired int32 `json:"desired"`
+	// Updated is the number of pods running the current version of the component.
+	Updated int32 `json:"updated"`
+	// Available is the number of available pods of the component.
+	Available int32 `json:"available"`
+	// RolledOut is true when all pods of the component are updated and available.
+	RolledOut bool `json:"rolledOut"`
+	// Message explains why the component is not rolled out.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// KubeVirtWebhookHealth reports whether a KubeVirt webhook is reachable.
+type KubeVirtWebhookHealth struct {
+	// Name of the webhook configuration.
+	Name string `json:"name"`
+	// Reachable is true when the webhook configuration exists and the services it calls have ready endpoints.
+	Reachable bool `json:"reachable"`
+	// Message explains why the webhook is not reachable.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// KubeVirtNodeHealth reports the virt-handler heartbeat of a node.
+type KubeVirtNodeHealth struct {
+	// Name of the node.
+	Name string `json:"name"`
+	// Schedulable is true when the node is schedulable for VirtualMachineInstances.
+	Schedulable bool `json:"schedulable"`
+	// LastHeartbeat is the time of the last virt-handler heartbeat.
+	// +optional
+	// +nullable
+	LastHeartbeat *metav1.Time `json:"lastHeartbeat,omitempty"`
+	// Responsive is true when virt-handler sent a heartbeat within the last five minutes.
+	Responsive bool `json:"responsive"`
+}
+
+// KubeVirtMigrationsHealth summarizes the outstanding migrations.
+type KubeVirtMigrationsHealth struct {
+	// Pending is the number of migrations which wait to be started.
+	Pending int32 `json:"pending"`
+	// Running is the number of migrations which are in progress.
+	Running int32 `json:"running"`
+}
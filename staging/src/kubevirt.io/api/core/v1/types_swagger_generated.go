@@ -26,10 +26,14 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"schedulerName":                 "If specified, the VMI will be dispatched by specified scheduler.\nIf not specified, the VMI will be dispatched by default scheduler.\n+optional",
 		"tolerations":                   "If toleration is specified, obey all the toleration rules.",
 		"topologySpreadConstraints":     "TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology\ndomains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.\n+optional\n+patchMergeKey=topologyKey\n+patchStrategy=merge\n+listType=map\n+listMapKey=topologyKey\n+listMapKey=whenUnsatisfiable",
+		"podOverlay":                    "PodOverlay adds a safelisted set of fields to the virt-launcher pod of the VirtualMachineInstance.\n+optional",
+		"securityProfile":               "SecurityProfile selects a stricter seccomp profile and SELinux type for the virt-launcher compute container.\nOnly the profiles and types allowed in the KubeVirt configuration can be selected.\n+optional",
 		"evictionStrategy":              "EvictionStrategy describes the strategy to follow when a node drain occurs.\nThe possible options are:\n- \"None\": No action will be taken, according to the specified 'RunStrategy' the VirtualMachine will be restarted or shutdown.\n- \"LiveMigrate\": the VirtualMachineInstance will be migrated instead of being shutdown.\n- \"LiveMigrateIfPossible\": the same as \"LiveMigrate\" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as \"None\".\n- \"External\": the VirtualMachineInstance will be protected by a PDB and `vmi.Status.EvacuationNodeName` will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.\n+optional",
 		"startStrategy":                 "StartStrategy can be set to \"Paused\" if Virtual Machine should be started in paused state.\n\n+optional",
 		"terminationGracePeriodSeconds": "Grace period observed after signalling a VirtualMachineInstance to stop after which the VirtualMachineInstance is force terminated.",
+		"shutdownPolicy":                "ShutdownPolicy controls how the guest is asked to shut down before it is forcefully powered off.\n+optional",
 		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
+		"ephemeralStorage":              "EphemeralStorage configures the node-local storage backing containerDisk, ephemeral and emptyDisk volumes.\n+optional",
 		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"hostname":                      "Specifies the hostname of the vmi\nIf not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.\n+optional",
@@ -39,6 +43,7 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
+		"resourceClaims":                "ResourceClaims are the ResourceClaims which have to be allocated and reserved before the virt-launcher pod\nof the VMI is allowed to start. GPUs and host devices reference them to consume the allocated devices.\nRequires the DynamicResourceAllocation feature gate of Kubernetes.\n+listType=atomic\n+optional",
 	}
 }
 
@@ -73,6 +78,7 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"activePods":                    "ActivePods is a mapping of pod UID to node name.\nIt is possible for multiple pods to be running for a single VMI during migration.",
 		"volumeStatus":                  "VolumeStatus contains the statuses of all the volumes\n+optional\n+listType=atomic",
 		"kernelBootStatus":              "KernelBootStatus contains info about the kernelBootContainer\n+optional",
+		"firmwareImageStatus":           "FirmwareImageStatus contains the firmware image the VMI was pinned to at creation\n+optional",
 		"fsFreezeStatus":                "FSFreezeStatus is the state of the fs of the guest\nit can be either frozen or thawed\n+optional",
 		"topologyHints":                 "+optional",
 		"virtualMachineRevisionName":    "VirtualMachineRevisionName is used to get the vm revision of the vmi when doing\nan online vm snapshot\n+optional",
@@ -82,7 +88,45 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
+		"guestNUMA":                     "GuestNUMA shows the NUMA topology presented to the guest, if it has one.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+		"deviceStatus":                  "DeviceStatus reflects the devices allocated through resource claims for the GPUs and host devices\n+optional",
+	}
+}
+
+func (DeviceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "DeviceStatus reflects the devices allocated through resource claims for the GPUs and host devices of a VMI",
+		"gpuStatuses":        "GPUStatuses reflect the devices allocated for the GPUs\n+listType=atomic\n+optional",
+		"hostDeviceStatuses": "HostDeviceStatuses reflect the devices allocated for the host devices\n+listType=atomic\n+optional",
+	}
+}
+
+func (DeviceStatusInfo) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "DeviceStatusInfo reflects the device allocated through a resource claim for a GPU or a host device",
+		"name":              "Name is the name of the GPU or host device in the VMI spec",
+		"resourceClaimName": "ResourceClaimName is the name of the ResourceClaim the device is allocated from",
+		"deviceName":        "DeviceName is the name of the allocated device in the ResourceSlice of its driver",
+		"pciAddress":        "PCIAddress is the PCI address of the allocated device on the node",
+	}
+}
+
+func (GuestNUMAStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "GuestNUMAStatus shows the NUMA topology presented to the guest through its ACPI tables",
+		"cells": "Cells are the NUMA nodes of the guest\n+listType=atomic",
+	}
+}
+
+func (GuestNUMACell) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "GuestNUMACell describes a NUMA node of the guest",
+		"id":        "ID is the id of the NUMA node in the guest",
+		"cpus":      "CPUs are the vCPUs of the NUMA node\n+listType=atomic\n+optional",
+		"memory":    "Memory is the amount of memory of the NUMA node\n+optional",
+		"hostNode":  "HostNode is the host NUMA node the memory of the NUMA node is allocated from\n+optional",
+		"distances": "Distances are the distances from the NUMA node to all NUMA nodes of the guest, ordered by their id,\nas reported in the ACPI SLIT\n+listType=atomic\n+optional",
 	}
 }
 
@@ -146,6 +190,17 @@ func (KernelBootStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (FirmwareImageStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "FirmwareImageStatus contains info about the firmware image used by the VMI",
+		"name":            "Name of the firmware image in the KubeVirt configuration",
+		"image":           "Image is the container image which was selected when the VMI was created.\nIt stays the same for the lifetime of the VMI, also across migrations.",
+		"imagePullPolicy": "ImagePullPolicy of the firmware image container\n+optional",
+		"path":            "Path is the directory inside the image containing the firmware binaries\n+optional",
+		"checksum":        "Checksum of the firmware binaries\n+optional",
+	}
+}
+
 func (DomainMemoryDumpInfo) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "DomainMemoryDumpInfo represents the memory dump information",
@@ -171,6 +226,41 @@ func (ContainerDiskInfo) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ShutdownPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "ShutdownPolicy describes how the guest is asked to shut down before it is forcefully powered off",
+		"acpiRetries":              "ACPIRetries is the number of times the ACPI power button event is sent again\nafter the first one was not honored by the guest.\nIf not set, the event is sent again until the guest is forcefully powered off.\n+optional",
+		"acpiRetryIntervalSeconds": "ACPIRetryIntervalSeconds is the time to wait between two ACPI power button events.\nDefaults to 5\n+optional",
+		"guestAgentFallback":       "GuestAgentFallback requests the guest agent to shut down the guest once all\nACPI retries are exhausted. Requires acpiRetries to be set and a connected guest agent.\n+optional",
+		"forceOffTimeoutSeconds":   "ForceOffTimeoutSeconds is the time after the first shutdown signal after which the guest\nis forcefully powered off. It must not exceed terminationGracePeriodSeconds.\nDefaults to terminationGracePeriodSeconds\n+optional",
+	}
+}
+
+func (EphemeralStorage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "EphemeralStorage configures the node-local storage of a VirtualMachineInstance",
+		"encrypted": "Encrypted enables the encryption of the qcow2 overlays of containerDisk and ephemeral volumes and of emptyDisk\nvolumes. The key is generated per VirtualMachineInstance, is only kept in memory and is discarded once the\nVirtualMachineInstance stops.\n+optional",
+	}
+}
+
+func (PodOverlay) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "PodOverlay describes additions to the virt-launcher pod of a VirtualMachineInstance",
+		"labels":                    "Labels are added to the virt-launcher pod only, without being set on the VirtualMachineInstance.\nLabels in the kubevirt.io domain are reserved and not allowed.\n+optional",
+		"tolerations":               "Tolerations are added to the tolerations of the VirtualMachineInstance.\n+optional\n+listType=atomic",
+		"topologySpreadConstraints": "TopologySpreadConstraints are merged into the topology spread constraints of the VirtualMachineInstance.\nA constraint with the same topologyKey and whenUnsatisfiable as one of the VirtualMachineInstance replaces it.\n+optional\n+listType=atomic",
+		"runtimeClassName":          "RuntimeClassName sets the runtime class of the virt-launcher pod.\nIt has to match the default runtime class of the cluster if one is configured.\n+optional",
+	}
+}
+
+func (SecurityProfile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "SecurityProfile describes the security settings of the virt-launcher compute container of a VirtualMachineInstance",
+		"seccompLocalhostProfile": "SeccompLocalhostProfile is the seccomp profile of the compute container, a path relative to the seccomp\nprofile root of the kubelet. It has to be listed in securityProfiles.allowedSeccompLocalhostProfiles.\n+optional",
+		"selinuxType":             "SELinuxType is the SELinux type of the compute container.\nIt has to be listed in securityProfiles.allowedSELinuxTypes.\n+optional",
+	}
+}
+
 func (VirtualMachineInstanceCondition) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"lastProbeTime":      "+nullable",
@@ -556,10 +646,21 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"fips":        "FIPS reports the FIPS profile the KubeVirt components enforce on their TLS connections",
 		"generations": "+listType=atomic",
 	}
 }
 
+func (KubeVirtFIPSStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "KubeVirtFIPSStatus reports the FIPS profile of the KubeVirt components",
+		"enabled":         "Enabled reports whether the TLS stacks are restricted to the FIPS 140 approved settings",
+		"validatedModule": "ValidatedModule reports whether the cryptography of the KubeVirt components is provided by a FIPS 140 validated module, they are only FIPS compliant if it is true as well as Enabled",
+		"minTLSVersion":   "MinTLSVersion is the minimum TLS version accepted by the KubeVirt components\n+optional",
+		"ciphers":         "Ciphers are the TLS 1.2 cipher suites accepted by the KubeVirt components\n+optional\n+listType=atomic",
+	}
+}
+
 func (KubeVirtCondition) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                   "KubeVirtCondition represents a condition of a KubeVirt deployment",
@@ -754,6 +855,14 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"autoCPULimitNamespaceLabelSelector": "When set, AutoCPULimitNamespaceLabelSelector will set a CPU limit on virt-launcher for VMIs running inside\nnamespaces that match the label selector.\nThe CPU limit will equal the number of requested vCPUs.\nThis setting does not apply to VMIs with dedicated CPUs.",
 		"liveUpdateConfiguration":            "LiveUpdateConfiguration holds defaults for live update features",
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how changes to a VM object propagate to its VMI\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
+		"crashLoopBackOff":                   "CrashLoopBackOff configures how VirtualMachines whose guests keep failing are restarted\n+optional",
+		"firmwareImages":                     "FirmwareImages is the set of firmware images VMIs can select with\nspec.domain.firmware.imageName instead of using the firmware shipped with virt-launcher.\n+optional\n+listType=map\n+listMapKey=name",
+		"instancetypeUpdateStrategy":         "InstancetypeUpdateStrategy defines if and how running VirtualMachines are moved onto\nnew revisions of the instancetypes and preferences they reference.\nWhen unset, VirtualMachines stay on the revisions captured when they were first started.\n+optional",
+		"toolsDisk":                          "ToolsDisk attaches a read-only disk, provided by a containerDisk image, to every VMI\ncreated in the selected namespaces, e.g. to ship monitoring agents or guest drivers.\nChanges only affect VMIs created afterwards.\n+optional",
+		"securityProfiles":                   "SecurityProfiles lists the seccomp profiles and SELinux types VirtualMachineInstances\nmay select for their virt-launcher compute container.\n+optional",
+		"exportOIDC":                         "ExportOIDC lets the VirtualMachineExport servers accept OIDC ID tokens as bearer tokens,\nin addition to the generated export token.\n+optional",
+		"subresourceRateLimits":              "SubresourceRateLimits limits how often a single user, and all users of a namespace together,\nmay call the console, vnc, expand-spec and memorydump subresources.\n+optional",
+		"streamDrainSeconds":                 "StreamDrainSeconds is how long virt-api and virt-handler keep serving the open console, VNC,\nUSB redirection, VSOCK and port-forward streams while they shut down, e.g. during an update.\nNew streams are refused meanwhile, so that clients reconnect to another instance.\nDefaults to 0, which closes the open streams right away. The maximum is 300.\n+optional",
 	}
 }
 
@@ -815,6 +924,7 @@ func (TLSConfiguration) SwaggerDoc() map[string]string {
 		"":              "TLSConfiguration holds TLS options",
 		"minTLSVersion": "MinTLSVersion is a way to specify the minimum protocol version that is acceptable for TLS connections.\nProtocol versions are based on the following most common TLS configurations:\n\n  https://ssl-config.mozilla.org/\n\nNote that SSLv3.0 is not a supported protocol version due to well known\nvulnerabilities such as POODLE: https://en.wikipedia.org/wiki/POODLE\n+kubebuilder:validation:Enum=VersionTLS10;VersionTLS11;VersionTLS12;VersionTLS13",
 		"ciphers":       "+listType=set",
+		"fipsMode":      "FIPSMode restricts the TLS stacks of the KubeVirt components to the FIPS 140 approved\nprotocol versions, cipher suites and key exchange curves.\nMinTLSVersion and Ciphers have to be FIPS compliant when it is enabled.\n+optional",
 	}
 }
 
@@ -1005,6 +1115,84 @@ func (LiveUpdateConfiguration) SwaggerDoc() map[string]string {
 	}
 }
 
+func (CrashLoopBackOffConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "CrashLoopBackOffConfiguration holds the settings virt-controller uses to back off\nfrom restarting VirtualMachines whose VMIs keep failing shortly after being started.",
+		"maxDelaySeconds":       "MaxDelaySeconds is the upper bound of the exponential delay between two consecutive\nrestarts of a failing VirtualMachine.\nDefaults to 300\n+optional",
+		"minRunDurationSeconds": "MinRunDurationSeconds is the time a VMI has to stay in the Running phase before\nit is considered to be started successfully. VMIs which terminate earlier are\ncounted as start failures.\nDefaults to 0, which only counts VMIs never reaching the Running phase as start failures.\n+optional",
+		"restartLimits":         "RestartLimits caps the number of consecutive start failures per RunStrategy.\nOnce the limit is reached the VirtualMachine is not restarted anymore until\na new start, stop or restart request is issued.\n+optional\n+listType=map\n+listMapKey=runStrategy",
+	}
+}
+
+func (InstancetypeUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "InstancetypeUpdateStrategy defines how VirtualMachines referencing an updated instancetype\nor preference are rolled onto the new revision.",
+		"methods":        "Methods defines the methods that can be used to apply a new revision to running VirtualMachines.\nWhen both LiveMigrate and Restart are listed, only VirtualMachines whose changes can't be\napplied live are restarted. Once a method is listed, VirtualMachines that are not running are\nmoved onto the new revision as well.\n\nAn empty list keeps VirtualMachines on their captured revisions.\n\n+listType=atomic\n+optional",
+		"maxUnavailable": "MaxUnavailable is the maximum number of running VirtualMachines which are updated at the same time.\nValue can be an absolute number (ex: 5) or a percentage of the running VirtualMachines\nreferencing an outdated revision (ex: 10%). Absolute number is calculated from percentage\nby rounding down, with a minimum of 1.\n\nDefaults to 1\n\n+optional",
+	}
+}
+
+func (FirmwareImage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "FirmwareImage describes a container image providing OVMF and SeaBIOS binaries.\nThe binaries are expected to use the file names of the virt-launcher image\n(e.g. OVMF_CODE.fd, OVMF_VARS.fd, OVMF_CODE.secboot.fd, bios.bin).",
+		"name":            "Name is used by VMIs to reference the firmware image",
+		"image":           "Image is the container image containing the firmware binaries",
+		"imagePullPolicy": "Image pull policy.\nOne of Always, Never, IfNotPresent.\nDefaults to Always if :latest tag is specified, or IfNotPresent otherwise.\n+optional",
+		"path":            "Path is the absolute directory inside the image containing the firmware binaries.\nDefaults to /usr/share/OVMF\n+optional",
+	}
+}
+
+func (ToolsDiskConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "ToolsDiskConfiguration describes a containerDisk image which is attached as a read-only CD-ROM.",
+		"image":                  "Image is the containerDisk image providing the disk",
+		"imagePullPolicy":        "Image pull policy.\nOne of Always, Never, IfNotPresent.\nDefaults to Always if :latest tag is specified, or IfNotPresent otherwise.\n+optional",
+		"namespaceLabelSelector": "NamespaceLabelSelector selects the namespaces whose VMIs get the disk attached.\nWhen unset, the disk is attached to the VMIs of all namespaces.\n+optional",
+	}
+}
+
+func (SecurityProfilesConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                                "SecurityProfilesConfiguration holds the safelist of the security profiles VirtualMachineInstances can select.",
+		"allowedSeccompLocalhostProfiles": "AllowedSeccompLocalhostProfiles are the localhost seccomp profiles which can be selected,\nas paths relative to the seccomp profile root of the kubelet. The profiles have to exist on the nodes.\n+optional\n+listType=set",
+		"allowedSELinuxTypes":             "AllowedSELinuxTypes are the SELinux types which can be selected.\n+optional\n+listType=set",
+	}
+}
+
+func (ExportOIDCConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "ExportOIDCConfiguration describes the OIDC issuer whose ID tokens are accepted by the VirtualMachineExport servers.",
+		"issuerURL":     "IssuerURL is the https URL of the issuer, it has to serve the OIDC discovery document.",
+		"clientID":      "ClientID is the audience the ID tokens have to be issued for.",
+		"groupsClaim":   "GroupsClaim is the name of the claim holding the groups of the user. Defaults to groups\n+optional",
+		"groupBindings": "GroupBindings limit the accepted ID tokens to the members of the groups bound to the namespace of the export.\n+listType=atomic",
+	}
+}
+
+func (ExportOIDCGroupBinding) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "ExportOIDCGroupBinding binds an OIDC group to the namespaces whose VirtualMachineExports its members can download.",
+		"group":      "Group is the name of the group in the groups claim.",
+		"namespaces": "Namespaces are the namespaces whose VirtualMachineExports the members of the group can download.\n+listType=set",
+	}
+}
+
+func (SubresourceRateLimitsConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "SubresourceRateLimitsConfiguration holds the per-tenant rate limits of the expensive subresources.\nRequests exceeding a limit are rejected with 429 Too Many Requests and a Retry-After header.",
+		"perUser":      "PerUser limits the requests of every user across all namespaces.\n+optional",
+		"perNamespace": "PerNamespace limits the requests of all users within a namespace together.\n+optional",
+	}
+}
+
+func (RestartLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "RestartLimit defines the maximum number of consecutive start failures\ntolerated for VirtualMachines using the given RunStrategy.",
+		"runStrategy":            "RunStrategy the limit applies to. Only Always and RerunOnFailure are supported.",
+		"maxConsecutiveFailures": "MaxConsecutiveFailures is the number of consecutive start failures after which\nthe VirtualMachine is no longer restarted.",
+	}
+}
+
 func (LiveUpdateMemory) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"maxGuest": "MaxGuest defines the maximum amount memory that can be allocated for the VM.\n+optional",
@@ -1046,3 +1234,94 @@ func (SEVSecretOptions) SwaggerDoc() map[string]string {
 		"secret": "Base64 encoded encrypted launch secret.",
 	}
 }
+
+func (CapacityForecastOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "CapacityForecastOptions describes the VirtualMachines to forecast the placement of.\nEither VirtualMachines or Instancetype has to be set.",
+		"virtualMachines": "VirtualMachines are the names of VirtualMachines in the namespace of the request to place.\nVirtualMachines which already have a VirtualMachineInstance are ignored, since they\nconsume their capacity already.\n+optional\n+listType=set",
+		"instancetype":    "Instancetype references the instancetype to place Replicas VirtualMachines of.\n+optional",
+		"preference":      "Preference references the preference applied to the VirtualMachines of the instancetype.\n+optional",
+		"replicas":        "Replicas is the number of VirtualMachines of the instancetype to place. Defaults to 1.\n+optional",
+	}
+}
+
+func (CapacityForecast) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "CapacityForecast reports whether the cluster can place the requested VirtualMachines at once.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"requested":   "Requested is the number of VirtualMachines to place.",
+		"placeable":   "Placeable is the number of the requested VirtualMachines which fit into the cluster.",
+		"nodes":       "Nodes lists the nodes the placeable VirtualMachines would be placed on.\n+optional\n+listType=atomic",
+		"unplaceable": "Unplaceable lists the VirtualMachines which don't fit into the cluster.\n+optional\n+listType=atomic",
+	}
+}
+
+func (CapacityForecastNode) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "CapacityForecastNode reports the VirtualMachines which would be placed on a node.",
+		"name":            "Name of the node.",
+		"virtualMachines": "VirtualMachines placed on the node.\n+listType=atomic",
+		"remaining":       "Remaining is the allocatable capacity of the node left after the placement.\n+optional",
+	}
+}
+
+func (CapacityForecastUnplaceable) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "CapacityForecastUnplaceable reports why a VirtualMachine doesn't fit into the cluster.",
+		"name":   "Name of the VirtualMachine.",
+		"reason": "Reason the VirtualMachine doesn't fit, in the format of the scheduler.",
+	}
+}
+
+func (KubeVirtHealth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "KubeVirtHealth aggregates the health and readiness of the KubeVirt install.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"healthy":                 "Healthy is true when all components are available, all webhooks are reachable and the\nvirt-handlers of all nodes send heartbeats.",
+		"ready":                   "Ready is true when KubeVirt is deployed at its target version and all components are\nrolled out, e.g. to gate upgrades on.",
+		"phase":                   "Phase of the KubeVirt install.\n+optional",
+		"observedKubeVirtVersion": "ObservedKubeVirtVersion is the version KubeVirt is deployed at.\n+optional",
+		"targetKubeVirtVersion":   "TargetKubeVirtVersion is the version KubeVirt is deployed to.\n+optional",
+		"components":              "Components reports the rollout of the KubeVirt components.\n+optional\n+listType=atomic",
+		"webhooks":                "Webhooks reports whether the KubeVirt webhooks are reachable.\n+optional\n+listType=atomic",
+		"nodes":                   "Nodes reports the virt-handler heartbeats of the nodes.\n+optional\n+listType=atomic",
+		"migrations":              "Migrations summarizes the outstanding migrations.",
+	}
+}
+
+func (KubeVirtComponentHealth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "KubeVirtComponentHealth reports the rollout of a KubeVirt component.",
+		"name":      "Name of the component.",
+		"desired":   "Desired is the number of pods the component should run.",
+		"updated":   "Updated is the number of pods running the current version of the component.",
+		"available": "Available is the number of available pods of the component.",
+		"rolledOut": "RolledOut is true when all pods of the component are updated and available.",
+		"message":   "Message explains why the component is not rolled out.\n+optional",
+	}
+}
+
+func (KubeVirtWebhookHealth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "KubeVirtWebhookHealth reports whether a KubeVirt webhook is reachable.",
+		"name":      "Name of the webhook configuration.",
+		"reachable": "Reachable is true when the webhook configuration exists and the services it calls have ready endpoints.",
+		"message":   "Message explains why the webhook is not reachable.\n+optional",
+	}
+}
+
+func (KubeVirtNodeHealth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "KubeVirtNodeHealth reports the virt-handler heartbeat of a node.",
+		"name":          "Name of the node.",
+		"schedulable":   "Schedulable is true when the node is schedulable for VirtualMachineInstances.",
+		"lastHeartbeat": "LastHeartbeat is the time of the last virt-handler heartbeat.\n+optional\n+nullable",
+		"responsive":    "Responsive is true when virt-handler sent a heartbeat within the last five minutes.",
+	}
+}
+
+func (KubeVirtMigrationsHealth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "KubeVirtMigrationsHealth summarizes the outstanding migrations.",
+		"pending": "Pending is the number of migrations which wait to be started.",
+		"running": "Running is the number of migrations which are in progress.",
+	}
+}
@@ -2146,6 +2146,17 @@ func (_mr *_MockServerVersionInterfaceRecorder) Get() *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Get")
 }
 
+func (_m *MockServerVersionInterface) Health() (*v121.KubeVirtHealth, error) {
+	ret := _m.ctrl.Call(_m, "Health")
+	ret0, _ := ret[0].(*v121.KubeVirtHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockServerVersionInterfaceRecorder) Health() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "Health")
+}
+
 // Mock of ExpandSpecInterface interface
 type MockExpandSpecInterface struct {
 	ctrl     *gomock.Controller
@@ -255,6 +255,7 @@ type KubeVirtInterface interface {
 
 type ServerVersionInterface interface {
 	Get() (*version.Info, error)
+	Health() (*v1.KubeVirtHealth, error)
 }
 
 type ExpandSpecInterface interface {
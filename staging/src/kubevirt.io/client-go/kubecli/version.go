@@ -51,7 +51,24 @@ type ServerVersion struct {
 }
 
 func (v *ServerVersion) Get() (*version.Info, error) {
+	var serverInfo version.Info
+	if err := v.getPreferred(v.resource, &serverInfo); err != nil {
+		return nil, err
+	}
+	return &serverInfo, nil
+}
 
+// Health returns the aggregated health and readiness of the KubeVirt install
+func (v *ServerVersion) Health() (*v1.KubeVirtHealth, error) {
+	var health v1.KubeVirtHealth
+	if err := v.getPreferred("health", &health); err != nil {
+		return nil, err
+	}
+	return &health, nil
+}
+
+// getPreferred decodes the resource of the preferred version of the subresources API into into
+func (v *ServerVersion) getPreferred(resource string, into interface{}) error {
 	var group metav1.APIGroup
 	// First, find out which version to query
 	uri := ApiGroupName
@@ -60,29 +77,28 @@ func (v *ServerVersion) Get() (*version.Info, error) {
 		connErr, isConnectionErr := err.(*url.Error)
 
 		if isConnectionErr {
-			return nil, connErr.Err
+			return connErr.Err
 		}
 
-		return nil, err
+		return err
 	} else if err = json.Unmarshal(data, &group); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Now, query the preferred version
-	uri = fmt.Sprintf("/apis/%s/version", group.PreferredVersion.GroupVersion)
-	var serverInfo version.Info
+	uri = fmt.Sprintf("/apis/%s/%s", group.PreferredVersion.GroupVersion, resource)
 
 	result = v.restClient.Get().AbsPath(uri).Do(context.Background())
 	if data, err := result.Raw(); err != nil {
 		connErr, isConnectionErr := err.(*url.Error)
 
 		if isConnectionErr {
-			return nil, connErr.Err
+			return connErr.Err
 		}
 
-		return nil, err
-	} else if err = json.Unmarshal(data, &serverInfo); err != nil {
-		return nil, err
+		return err
+	} else if err = json.Unmarshal(data, into); err != nil {
+		return err
 	}
-	return &serverInfo, nil
+	return nil
 }